@@ -0,0 +1,28 @@
+data "netapp-ontap_cluster_health_data_source" "cluster" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  severities = ["emergency", "alert"]
+  since = "2024-03-01T00:00:00Z"
+}
+
+resource "netapp-ontap_storage_volume_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "vol1"
+  svm_name = "svm1"
+  aggregates = [
+    {
+      name = "aggr1"
+    },
+  ]
+  space = {
+    size = 20
+    size_unit = "gb"
+  }
+
+  lifecycle {
+    precondition {
+      condition = data.netapp-ontap_cluster_health_data_source.cluster.healthy
+      error_message = "cluster is not healthy: ${join(", ", [for a in data.netapp-ontap_cluster_health_data_source.cluster.alerts : a.alert_id if !a.acknowledged])}"
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "netapp-ontap_cluster_metrocluster_data_source" "cluster_metrocluster" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+}
+
+# fail the plan when MetroCluster is not ready for a switchover
+check "metrocluster_switchover_ready" {
+  assert {
+    condition = data.netapp-ontap_cluster_metrocluster_data_source.cluster_metrocluster.switchover_ready
+    error_message = "MetroCluster is not ready for a switchover"
+  }
+}
@@ -0,0 +1,4 @@
+data "netapp-ontap_cluster_metrocluster_dr_groups_data_source" "cluster_metrocluster_dr_groups" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+}
@@ -0,0 +1,8 @@
+data "netapp-ontap_cluster_metrocluster_operations_data_source" "cluster_metrocluster_operations" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    type = "check"
+    state = "failed"
+  }
+}
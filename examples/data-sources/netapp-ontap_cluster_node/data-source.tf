@@ -0,0 +1,5 @@
+data "netapp-ontap_cluster_node_data_source" "cluster_node" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "ontap_cluster_1-01"
+}
@@ -0,0 +1,7 @@
+data "netapp-ontap_cluster_nodes_data_source" "cluster_nodes" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    name = "ontap_cluster_1-*"
+  }
+}
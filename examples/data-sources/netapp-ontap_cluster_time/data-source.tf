@@ -0,0 +1,12 @@
+data "netapp-ontap_cluster_time_data_source" "cluster_time" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+}
+
+# fail the plan when a node is not synchronized with an NTP server
+check "cluster_time_synchronized" {
+  assert {
+    condition = data.netapp-ontap_cluster_time_data_source.cluster_time.ntp_synchronized
+    error_message = "not every node is synchronized with an NTP server"
+  }
+}
@@ -0,0 +1,6 @@
+data "netapp-ontap_networking_broadcast_domain_data_source" "networking_broadcast_domain" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "Default"
+  ipspace = "Default"
+}
@@ -0,0 +1,7 @@
+data "netapp-ontap_networking_broadcast_domains_data_source" "networking_broadcast_domains" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    ipspace = "Default"
+  }
+}
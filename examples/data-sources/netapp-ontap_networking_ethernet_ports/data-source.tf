@@ -0,0 +1,8 @@
+data "netapp-ontap_networking_ethernet_ports_data_source" "networking_ethernet_ports" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    node_name = "swenjun-vsim1"
+    type      = "physical"
+  }
+}
@@ -0,0 +1,9 @@
+data "netapp-ontap_protocols_locks_data_source" "protocols_locks" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    volume_name = "vol1"
+    path = "/vol1/app/*"
+  }
+}
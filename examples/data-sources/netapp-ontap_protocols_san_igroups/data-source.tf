@@ -0,0 +1,8 @@
+data "netapp-ontap_protocols_san_igroups_data_source" "protocols_san_igroups" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    protocol = "iscsi"
+  }
+}
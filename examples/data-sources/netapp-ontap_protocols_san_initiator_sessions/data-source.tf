@@ -0,0 +1,13 @@
+data "netapp-ontap_protocols_san_initiator_sessions_data_source" "svm1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+}
+
+# verify that the hosts of the igroup are logged in after the LUNs are mapped
+check "esx_hosts_logged_in" {
+  assert {
+    condition = length(setsubtract(["iqn.1998-01.com.vmware:esx1", "iqn.1998-01.com.vmware:esx2"], data.netapp-ontap_protocols_san_initiator_sessions_data_source.svm1.initiators)) == 0
+    error_message = "some ESX hosts are not logged in to svm1"
+  }
+}
@@ -0,0 +1,13 @@
+data "netapp-ontap_rest_query_data_source" "qtrees" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  api = "storage/qtrees"
+  query = {
+    "svm.name" = "svm1"
+  }
+  fields = ["name", "volume.name", "security_style"]
+}
+
+output "qtree_names" {
+  value = [for qtree in jsondecode(data.netapp-ontap_rest_query_data_source.qtrees.records) : qtree.name]
+}
@@ -0,0 +1,16 @@
+data "netapp-ontap_security_certificates_data_source" "security_certificates" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    type     = "server"
+    svm_name = "svm1"
+  }
+}
+
+# server certificates expiring within 30 days
+output "expiring_certificates" {
+  value = [
+    for cert in data.netapp-ontap_security_certificates_data_source.security_certificates.security_certificates :
+    cert.name if timecmp(cert.expiry_time, timeadd(timestamp(), "720h")) < 0
+  ]
+}
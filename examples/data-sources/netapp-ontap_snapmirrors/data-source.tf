@@ -5,3 +5,19 @@ data "netapp-ontap_snapmirrors_data_source" "snapmirrors" {
     "destination_path" = "snapmirror_dest_svm*"
   }
 }
+
+# unhealthy relationships, with their lag time and the reasons
+data "netapp-ontap_snapmirrors_data_source" "unhealthy_snapmirrors" {
+  cx_profile_name = "cluster4"
+  filter = {
+    healthy = false
+  }
+}
+
+# compliance check, every relationship transferred within the last hour
+check "snapmirrors_lag" {
+  assert {
+    condition = alltrue([for s in data.netapp-ontap_snapmirrors_data_source.snapmirrors.snapmirrors : coalesce(s.lag_time_seconds, 86400) <= 3600])
+    error_message = "lagging relationships: ${join(", ", [for s in data.netapp-ontap_snapmirrors_data_source.snapmirrors.snapmirrors : s.destination.path if coalesce(s.lag_time_seconds, 86400) > 3600])}"
+  }
+}
@@ -0,0 +1,8 @@
+data "netapp-ontap_storage_aggregate_spares_data_source" "storage_aggregate_spares" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    node_name = "ontap_cluster_1-01"
+    disk_class = "capacity"
+  }
+}
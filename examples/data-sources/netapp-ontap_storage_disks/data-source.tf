@@ -0,0 +1,7 @@
+data "netapp-ontap_storage_disks_data_source" "storage_disks" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    container_type = "unassigned"
+  }
+}
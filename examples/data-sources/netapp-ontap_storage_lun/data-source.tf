@@ -0,0 +1,6 @@
+data "netapp-ontap_storage_lun_data_source" "storage_lun" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "/vol/vol1/lun1"
+  svm_name = "svm1"
+}
@@ -0,0 +1,6 @@
+data "netapp-ontap_storage_lun_metrics_data_source" "lun1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "/vol/vol1/lun1"
+  svm_name = "svm1"
+}
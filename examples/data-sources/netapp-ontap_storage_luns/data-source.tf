@@ -0,0 +1,9 @@
+data "netapp-ontap_storage_luns_data_source" "storage_luns" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    volume_name = "vol1"
+    name = "/vol/vol1/db*"
+  }
+}
@@ -0,0 +1,20 @@
+data "netapp-ontap_storage_volume_compliance_gaps_data_source" "svm1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+}
+
+check "svm1_compliance" {
+  assert {
+    condition = length(data.netapp-ontap_storage_volume_compliance_gaps_data_source.svm1.volumes_without_arp) == 0
+    error_message = "ARP is not enabled on ${join(", ", data.netapp-ontap_storage_volume_compliance_gaps_data_source.svm1.volumes_without_arp)}"
+  }
+  assert {
+    condition = length(data.netapp-ontap_storage_volume_compliance_gaps_data_source.svm1.volumes_without_vscan) == 0
+    error_message = "vscan does not cover ${join(", ", data.netapp-ontap_storage_volume_compliance_gaps_data_source.svm1.volumes_without_vscan)}"
+  }
+  assert {
+    condition = data.netapp-ontap_storage_volume_compliance_gaps_data_source.svm1.audit_enabled
+    error_message = "NAS auditing is not enabled on svm1"
+  }
+}
@@ -0,0 +1,11 @@
+data "netapp-ontap_storage_volume_metrics_data_source" "vol1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "vol1"
+  svm_name = "svm1"
+  interval = "1d"
+}
+
+output "vol1_average_latency" {
+  value = sum(concat([0], [for m in data.netapp-ontap_storage_volume_metrics_data_source.vol1.metrics : m.latency.total if m.status == "ok"])) / max(1, length([for m in data.netapp-ontap_storage_volume_metrics_data_source.vol1.metrics : m if m.status == "ok"]))
+}
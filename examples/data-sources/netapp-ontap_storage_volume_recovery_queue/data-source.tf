@@ -0,0 +1,5 @@
+data "netapp-ontap_storage_volume_recovery_queue_data_source" "deleted_volumes" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+}
@@ -7,3 +7,15 @@ data "netapp-ontap_storage_volume_snapshots_data_source" "storage_volume_snapsho
     volume_name ="ansibleVolume12"
    }
 }
+
+data "netapp-ontap_storage_volume_snapshots_data_source" "owned_by_alice" {
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name ="ansibleSVM"
+    volume_name ="ansibleVolume12"
+    tags = {
+      owner = "alice"
+      ticket = "*"
+    }
+   }
+}
@@ -0,0 +1,13 @@
+data "netapp-ontap_storage_volume_space_data_source" "vol1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "vol1"
+  svm_name = "svm1"
+}
+
+check "vol1_capacity" {
+  assert {
+    condition = data.netapp-ontap_storage_volume_space_data_source.vol1.used_percent < 90
+    error_message = "vol1 is ${data.netapp-ontap_storage_volume_space_data_source.vol1.used_percent}% full"
+  }
+}
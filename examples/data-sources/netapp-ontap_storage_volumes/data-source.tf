@@ -2,6 +2,9 @@ data "netapp-ontap_storage_volumes_data_source" "storage_volumes" {
   # required to know which system to interface with
   cx_profile_name = "cluster4"
   filter = {
+    name = "vol_*|data_*"
     svm_name = "svm*"
+    state = "online"
+    tiering_policy = "none"
   }
 }
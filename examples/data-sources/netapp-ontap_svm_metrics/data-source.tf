@@ -0,0 +1,6 @@
+data "netapp-ontap_svm_metrics_data_source" "svm1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  protocol = "iscsi"
+}
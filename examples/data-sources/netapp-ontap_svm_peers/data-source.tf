@@ -0,0 +1,9 @@
+data "netapp-ontap_svm_peers_data_source" "svm_peers" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name      = "svm1"
+    peer_svm_name = "svm1_dr"
+    state         = "peered"
+  }
+}
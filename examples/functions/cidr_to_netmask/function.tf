@@ -0,0 +1,4 @@
+# result: "255.255.240.0"
+output "netmask" {
+  value = provider::netapp-ontap::cidr_to_netmask("10.0.0.0/20")
+}
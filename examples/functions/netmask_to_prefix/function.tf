@@ -0,0 +1,4 @@
+# result: 24
+output "prefix_length" {
+  value = provider::netapp-ontap::netmask_to_prefix("255.255.255.0")
+}
@@ -0,0 +1,15 @@
+locals {
+  # result: { clients_match = ["10.0.0.0/8"], protocols = ["nfs3", "nfs4"], ro_rule = ["sys"], rw_rule = ["never"], superuser = ["none"] }
+  rule = provider::netapp-ontap::nfs_ro(["10.1.2.0/24", "10.0.0.0/8"])
+}
+
+resource "netapp-ontap_protocols_nfs_export_policy_rule_resource" "example" {
+  cx_profile_name    = "cluster4"
+  svm_name           = "svm0"
+  export_policy_name = "default"
+  clients_match      = local.rule.clients_match
+  protocols          = local.rule.protocols
+  ro_rule            = local.rule.ro_rule
+  rw_rule            = local.rule.rw_rule
+  superuser          = local.rule.superuser
+}
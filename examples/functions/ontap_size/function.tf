@@ -0,0 +1,4 @@
+# result: 536870912000
+output "volume_size" {
+  value = provider::netapp-ontap::ontap_size("500GiB")
+}
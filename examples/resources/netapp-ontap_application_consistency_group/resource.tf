@@ -0,0 +1,9 @@
+resource "netapp-ontap_application_consistency_group_resource" "oracle_db1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "oracle_db1"
+  volumes = ["db1_data", "db1_logs"]
+  snapshot_policy = "default"
+  qos_policy = "db_qos"
+}
@@ -0,0 +1,10 @@
+resource "netapp-ontap_cluster_resource" "cluster" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "cluster4"
+  location = "datacenter 1, rack 12"
+  contact = "storage-team@example.com"
+  dns_domains = ["example.com"]
+  name_servers = ["10.10.10.10", "10.10.10.11"]
+  timezone = "Etc/UTC"
+}
@@ -0,0 +1,12 @@
+resource "netapp-ontap_cluster_service_processor_resource" "service_processor" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node_name = "ontap_cluster_1-01"
+  dhcp_enabled = false
+  ipv4_interface = {
+    address = "10.10.10.20"
+    netmask = "255.255.240.0"
+    gateway = "10.10.10.1"
+  }
+  ssh_allowed_addresses = ["10.10.0.0/16"]
+}
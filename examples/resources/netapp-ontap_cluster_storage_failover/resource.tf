@@ -0,0 +1,8 @@
+resource "netapp-ontap_cluster_storage_failover_resource" "storage_failover" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node_name = "ontap_cluster_1-01"
+  enabled = true
+  auto_giveback = true
+  hwassist = true
+}
@@ -0,0 +1,15 @@
+resource "netapp-ontap_cluster_web_resource" "cluster" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  http_enabled = false
+  csrf_protection_enabled = true
+  certificate_name = "cluster4_server_cert"
+}
+
+resource "netapp-ontap_cluster_web_resource" "svm" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  certificate_name = "svm1_server_cert"
+  client_enabled = true
+}
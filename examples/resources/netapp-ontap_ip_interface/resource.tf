@@ -12,3 +12,20 @@ resource "netapp-ontap_networking_ip_interface_resource" "ip_interface" {
     home_node = "ontap_cluster_1-01"
   }
 }
+
+# node management interface, cluster scoped
+resource "netapp-ontap_networking_ip_interface_resource" "node_mgmt" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "ontap_cluster_1-01_mgmt1"
+  ipspace = "Default"
+  service_policy = "default-management"
+  ip = {
+    address = "10.10.10.11"
+    netmask = 20
+    }
+  location = {
+    home_port = "e0M"
+    home_node = "ontap_cluster_1-01"
+  }
+}
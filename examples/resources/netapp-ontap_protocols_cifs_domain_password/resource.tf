@@ -0,0 +1,10 @@
+resource "netapp-ontap_protocols_cifs_domain_password_resource" "cifs_domain_password" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  schedule_enabled = true
+  schedule_weekly_interval = 4
+  schedule_randomized_interval = 120
+  # change the password now, and each time this value changes
+  reset_trigger = "2024-01-15"
+}
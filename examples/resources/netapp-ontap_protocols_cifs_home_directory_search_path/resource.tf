@@ -0,0 +1,15 @@
+resource "netapp-ontap_protocols_cifs_home_directory_search_path_resource" "home1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  path = "/home1"
+}
+
+resource "netapp-ontap_protocols_cifs_home_directory_search_path_resource" "home2" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  path = "/home2"
+  index = 2
+  depends_on = [netapp-ontap_protocols_cifs_home_directory_search_path_resource.home1]
+}
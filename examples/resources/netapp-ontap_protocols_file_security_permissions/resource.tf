@@ -0,0 +1,25 @@
+resource "netapp-ontap_protocols_file_security_permissions_resource" "share1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  path = "/vol1/share1"
+  owner = "BUILTIN\\Administrators"
+  propagation_mode = "propagate"
+  acls = [
+    {
+      user = "DOMAIN\\share1_admins"
+      access = "access_allow"
+      rights = "full_control"
+    },
+    {
+      user = "DOMAIN\\share1_users"
+      access = "access_allow"
+      rights = "modify"
+      apply_to = {
+        files = true
+        sub_folders = true
+        this_folder = false
+      }
+    },
+  ]
+}
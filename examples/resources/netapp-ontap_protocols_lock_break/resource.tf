@@ -0,0 +1,10 @@
+resource "netapp-ontap_protocols_lock_break_resource" "stuck_lock" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  volume_name = "vol1"
+  path = "/vol1/app/db.lck"
+  client_address = "10.10.10.20"
+  # fail rather than break more locks than expected
+  max_locks = 1
+}
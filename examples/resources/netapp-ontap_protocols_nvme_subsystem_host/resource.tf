@@ -0,0 +1,13 @@
+resource "netapp-ontap_protocols_nvme_subsystem_host_resource" "host1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  subsystem_name = "linux_hosts"
+  nqn = "nqn.2014-08.org.nvmexpress:uuid:0b37a4d2-6a1e-4c3b-9f3e-5c1d2e6f7a80"
+  dh_hmac_chap = {
+    host_secret_key = var.nvme_host_secret_key
+    controller_secret_key = var.nvme_controller_secret_key
+    hash_function = "sha_512"
+    group_size = "4096_bit"
+  }
+}
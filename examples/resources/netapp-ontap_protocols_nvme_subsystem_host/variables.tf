@@ -0,0 +1,19 @@
+# Terraform will prompt for values, unless a tfvars file is present.
+variable "username" {
+    type = string
+}
+variable "password" {
+    type = string
+    sensitive = true
+}
+variable "validate_certs" {
+    type = bool
+}
+variable "nvme_host_secret_key" {
+    type = string
+    sensitive = true
+}
+variable "nvme_controller_secret_key" {
+    type = string
+    sensitive = true
+}
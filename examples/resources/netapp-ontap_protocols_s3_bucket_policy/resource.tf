@@ -0,0 +1,28 @@
+resource "netapp-ontap_protocols_s3_bucket_policy_resource" "bucket1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  bucket_name = "bucket1"
+  statements = [
+    {
+      sid = "ReadOnlyFromLab"
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      principals = ["user1", "nasgroup/readers"]
+      resources = ["bucket1", "bucket1/*"]
+      conditions = [
+        {
+          operator = "ip_address"
+          source_ips = ["10.0.0.0/24"]
+        },
+      ]
+    },
+    {
+      sid = "FullAccessForOwner"
+      effect = "allow"
+      actions = ["*"]
+      principals = ["owner1"]
+      resources = ["bucket1", "bucket1/*"]
+    },
+  ]
+}
@@ -0,0 +1,24 @@
+resource "netapp-ontap_protocols_s3_group_resource" "readers" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "bucket1_readers"
+  comment = "users with read only access to bucket1"
+  users = ["user1", "user2"]
+  # the policies apply to all the users of the group
+  policies = [netapp-ontap_protocols_s3_policy_resource.read_only.name]
+}
+
+resource "netapp-ontap_protocols_s3_policy_resource" "read_only" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "ReadOnlyBucket1"
+  statements = [
+    {
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      resources = ["bucket1", "bucket1/*"]
+    },
+  ]
+}
@@ -0,0 +1,21 @@
+resource "netapp-ontap_protocols_s3_policy_resource" "read_only" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "ReadOnlyBucket1"
+  comment = "read only access to bucket1"
+  statements = [
+    {
+      sid = "ReadBucket1"
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      resources = ["bucket1", "bucket1/*"]
+    },
+    {
+      sid = "NoDelete"
+      effect = "deny"
+      actions = ["DeleteObject"]
+      resources = ["*"]
+    },
+  ]
+}
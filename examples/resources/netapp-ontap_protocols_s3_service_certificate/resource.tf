@@ -0,0 +1,7 @@
+resource "netapp-ontap_protocols_s3_service_certificate_resource" "svm1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  # install the new certificate for the SVM first, then change the name to rotate it
+  certificate_name = "svm1_s3_2025"
+}
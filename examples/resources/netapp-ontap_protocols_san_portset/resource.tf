@@ -0,0 +1,9 @@
+resource "netapp-ontap_protocols_san_portset_resource" "portset" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "fabric_a"
+  protocol = "iscsi"
+  interfaces = ["iscsi_lif_1a", "iscsi_lif_2a"]
+  igroups = ["esx_cluster1"]
+}
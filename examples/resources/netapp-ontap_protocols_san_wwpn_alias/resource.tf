@@ -0,0 +1,7 @@
+resource "netapp-ontap_protocols_san_wwpn_alias_resource" "esx1_hba0" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  alias = "esx1_hba0"
+  wwpn = "20:00:00:25:b5:00:a0:01"
+}
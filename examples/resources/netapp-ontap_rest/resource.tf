@@ -0,0 +1,18 @@
+resource "netapp-ontap_rest_resource" "qtree" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  api = "storage/qtrees"
+  body = jsonencode({
+    name = "qtree1"
+    svm = { name = "svm1" }
+    volume = { name = "vol1" }
+    security_style = "unix"
+  })
+  # svm and volume can only be set on create
+  update_body = jsonencode({
+    security_style = "unix"
+  })
+  # qtrees are identified by the volume uuid and the qtree id
+  id_attribute = "id"
+  read_api = "storage/qtrees/${var.vol1_uuid}/{id}"
+}
@@ -0,0 +1,7 @@
+resource "netapp-ontap_security_audit_resource" "audit" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  cli = true
+  http = true
+  ontapi = false
+}
@@ -0,0 +1,9 @@
+resource "netapp-ontap_security_audit_destination_resource" "syslog" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  address = "syslog.example.com"
+  port = 6514
+  protocol = "tcp_encrypted"
+  facility = "local0"
+  verify_server = true
+}
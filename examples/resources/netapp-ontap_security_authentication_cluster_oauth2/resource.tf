@@ -0,0 +1,6 @@
+resource "netapp-ontap_security_authentication_cluster_oauth2_resource" "oauth2" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  # at least one authorization server must be configured before enabling OAuth 2.0
+  enabled = true
+}
@@ -0,0 +1,12 @@
+resource "netapp-ontap_security_authentication_cluster_oauth2_client_resource" "auth0" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "auth0"
+  issuer = "https://examplelab.customer.com"
+  audience = "https://ontap"
+  jwks = {
+    provider_uri = "https://examplelab.customer.com/.well-known/jwks.json"
+    refresh_interval = "PT2H"
+  }
+  remote_user_claim = "sub"
+}
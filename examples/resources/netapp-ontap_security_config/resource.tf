@@ -0,0 +1,6 @@
+resource "netapp-ontap_security_config_resource" "security_config" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  fips_enabled = true
+  tls_protocol_versions = ["tls1.3", "tls1.2"]
+}
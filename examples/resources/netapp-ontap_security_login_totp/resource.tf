@@ -0,0 +1,12 @@
+resource "netapp-ontap_security_login_totp_resource" "storage_admin" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  account_name = "storage_admin"
+  comment = "MFA for storage administrators"
+  enforce_for_ssh = true
+}
+
+output "storage_admin_totp_url" {
+  value = netapp-ontap_security_login_totp_resource.storage_admin.install_url
+  sensitive = true
+}
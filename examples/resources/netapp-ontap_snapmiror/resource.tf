@@ -7,4 +7,15 @@ resource "netapp-ontap_snapmirror_resource" "snapmirror_async" {
   destination_endpoint = {
     path = "snapmirror_dest_svm:snap_dest"
   }
-}
\ No newline at end of file
+}
+# SnapMirror Cloud relationship, to back up a volume to an object store
+resource "netapp-ontap_snapmirror_resource" "snapmirror_cloud" {
+  cx_profile_name = "cluster1"
+  source_endpoint = {
+    path = "snapmirror_source_svm:snap"
+  }
+  destination_endpoint = {
+    path = "objstore1:/objstore/snap_backup"
+  }
+  policy = "CloudBackupDefault"
+}
@@ -0,0 +1,6 @@
+resource "netapp-ontap_snapmirror_release_resource" "decommission" {
+  # required to know which system to interface with, this is the source cluster
+  cx_profile_name = "cluster4"
+  source_path = "svm1:vol1"
+  destination_paths = ["svm2:vol1_dest", "svm3:vol1_vault"]
+}
@@ -0,0 +1,14 @@
+data "netapp-ontap_storage_disks_data_source" "unassigned" {
+  cx_profile_name = "cluster4"
+  filter = {
+    container_type = "unassigned"
+    name = "2.1.*"
+  }
+}
+
+resource "netapp-ontap_storage_disk_assignment_resource" "new_shelf" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node_name = "ontap_cluster_1-01"
+  disk_names = [for disk in data.netapp-ontap_storage_disks_data_source.unassigned.disks : disk.name]
+}
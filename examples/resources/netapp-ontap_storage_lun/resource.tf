@@ -0,0 +1,30 @@
+resource "netapp-ontap_storage_lun_resource" "lun1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "/vol/vol1/lun1"
+  os_type = "linux"
+  size = 10737418240
+  comment = "database LUN"
+}
+
+resource "netapp-ontap_storage_lun_resource" "lun2" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "/vol/vol1/lun2"
+  os_type = "vmware"
+  # reducing the size requires allow_shrink
+  size = 5368709120
+  allow_shrink = true
+  state = "offline"
+}
+
+# dev/test copy of lun1, cloned from a snapshot
+resource "netapp-ontap_storage_lun_resource" "lun1_clone" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "/vol/vol1/lun1_dev"
+  clone_source = "/vol/vol1/.snapshot/daily.2024-01-15_0010/lun1"
+}
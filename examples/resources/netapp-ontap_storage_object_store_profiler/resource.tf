@@ -0,0 +1,12 @@
+resource "netapp-ontap_storage_object_store_profiler_resource" "s3_target" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  object_store_name = "s3_target"
+  node_name = "ontap_cluster_1-01"
+  # run the profiler again each time this value changes
+  trigger = "2024-01-15"
+}
+
+output "object_store_profiler_results" {
+  value = netapp-ontap_storage_object_store_profiler_resource.s3_target.results
+}
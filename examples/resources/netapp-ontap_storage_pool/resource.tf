@@ -0,0 +1,17 @@
+resource "netapp-ontap_storage_pool_resource" "storage_pool" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "sp1"
+  node_names = ["ontap_cluster_1-01", "ontap_cluster_1-02"]
+  disk_count = 4
+  spare_allocation_units = [
+    {
+      node_name = "ontap_cluster_1-01"
+      count = 3
+    },
+    {
+      node_name = "ontap_cluster_1-02"
+      count = 1
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+resource "netapp-ontap_storage_snaplock_compliance_clock_resource" "compliance_clock" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node_name = "ontap_cluster_1-01"
+}
@@ -0,0 +1,8 @@
+resource "netapp-ontap_storage_snaplock_file_retention_resource" "invoice" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  volume_name = "worm_vol"
+  path = "finance/2025/invoice_0042.pdf"
+  retention_time = "2032-12-31T23:59:59Z"
+}
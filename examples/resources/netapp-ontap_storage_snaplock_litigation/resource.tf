@@ -0,0 +1,8 @@
+resource "netapp-ontap_storage_snaplock_litigation_resource" "legal_hold" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "case_2026_042"
+  svm_name = "svm1"
+  volume_name = "worm_vol"
+  path = "/finance/2025"
+}
@@ -12,3 +12,45 @@ resource "netapp-ontap_storage_volume_resource" "example" {
     size_unit = "mb"
   }
 }
+
+# SnapLock enterprise volume, files are committed to WORM state after 4 hours and retained for 7 years
+resource "netapp-ontap_storage_volume_resource" "worm" {
+  cx_profile_name = "cluster5"
+  name = "vol_worm"
+  svm_name = "svm2"
+  aggregates = [
+    {
+      name = "aggr_snaplock"
+    },
+  ]
+  snaplock = {
+    type = "enterprise"
+    autocommit_period = "PT4H"
+    retention = {
+      default = "P7Y"
+      minimum = "P1Y"
+      maximum = "P10Y"
+    }
+  }
+  space = {
+    size = 100
+    size_unit = "gb"
+  }
+}
+
+# Volume kept 3 days in the recovery queue after it is deleted
+resource "netapp-ontap_storage_volume_resource" "scratch" {
+  cx_profile_name = "cluster5"
+  name = "vol_scratch"
+  svm_name = "svm2"
+  aggregates = [
+    {
+      name = "aggr2"
+    },
+  ]
+  space = {
+    size = 10
+    size_unit = "gb"
+  }
+  recovery_queue_retention_hours = 72
+}
@@ -0,0 +1,21 @@
+resource "netapp-ontap_storage_volume_directory_resource" "projects" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  volume_name = "vol1"
+  path = "projects"
+  unix_permissions = 755
+  owner_id = 0
+  group_id = 0
+}
+
+resource "netapp-ontap_storage_volume_directory_resource" "project1" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  volume_name = "vol1"
+  path = "${netapp-ontap_storage_volume_directory_resource.projects.path}/project1"
+  unix_permissions = 770
+  owner_id = 1001
+  group_id = 2001
+}
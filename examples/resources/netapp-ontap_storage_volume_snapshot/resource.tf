@@ -3,4 +3,10 @@ resource "netapp-ontap_storage_volume_snapshot_resource" "example" {
   name = "snaptest"
   volume_name =  "carchi_test_root"
   svm_name = "carchi-test"
+  comment = "nightly"
+  tags = {
+    owner = "alice"
+    purpose = "backup"
+    ticket = "CHG123"
+  }
 }
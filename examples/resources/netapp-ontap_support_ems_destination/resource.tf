@@ -0,0 +1,21 @@
+resource "netapp-ontap_support_ems_destination_resource" "syslog" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "syslog-server"
+  type = "syslog"
+  destination = "10.10.10.10"
+  filters = ["critical-events"]
+  syslog = {
+    port = 514
+    transport = "udp_unencrypted"
+  }
+}
+
+resource "netapp-ontap_support_ems_destination_resource" "webhook" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "webhook"
+  type = "rest_api"
+  destination = "https://alerts.example.com/ontap"
+  filters = ["critical-events"]
+}
@@ -0,0 +1,17 @@
+resource "netapp-ontap_support_ems_filter_resource" "ems_filter" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "critical-events"
+  rules = [
+    {
+      type = "exclude"
+      name_pattern = "callhome.*"
+      severities = "*"
+    },
+    {
+      type = "include"
+      name_pattern = "*"
+      severities = "emergency,alert,error"
+    },
+  ]
+}
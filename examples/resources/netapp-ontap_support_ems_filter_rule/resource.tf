@@ -0,0 +1,8 @@
+resource "netapp-ontap_support_ems_filter_rule_resource" "ems_filter_rule" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter_name = "critical-events"
+  type = "include"
+  name_pattern = "wafl.*"
+  severities = "emergency,alert,error"
+}
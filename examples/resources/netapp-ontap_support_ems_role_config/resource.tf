@@ -0,0 +1,7 @@
+resource "netapp-ontap_support_ems_role_config_resource" "ems_role_config" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  role_name = "storage-team"
+  filter_name = "storage-events"
+  limit_access_to_global_configs = true
+}
@@ -0,0 +1,12 @@
+resource "netapp-ontap_support_performance_archive_resource" "after_upgrade" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node_name = "ontap_cluster_1-01"
+  message = "case 2009876543 after upgrade"
+  # start a new collection each time this value changes
+  trigger = "2024-01-15"
+}
+
+output "collection_id" {
+  value = netapp-ontap_support_performance_archive_resource.after_upgrade.collection_id
+}
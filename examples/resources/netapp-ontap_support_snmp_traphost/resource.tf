@@ -0,0 +1,19 @@
+resource "netapp-ontap_support_snmp_traphost_resource" "snmpv3" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  host = "10.10.10.10"
+  usm_user = {
+    name = "traps"
+    authentication_protocol = "sha2_256"
+    authentication_password = var.snmp_auth_password
+    privacy_protocol = "aes128"
+    privacy_password = var.snmp_priv_password
+  }
+}
+
+resource "netapp-ontap_support_snmp_traphost_resource" "snmpv2c" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  host = "monitoring.example.com"
+  community_name = "public"
+}
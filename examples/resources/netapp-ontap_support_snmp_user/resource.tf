@@ -0,0 +1,10 @@
+resource "netapp-ontap_support_snmp_user_resource" "poller" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "poller"
+  authentication_protocol = "sha2_256"
+  authentication_password = var.snmp_auth_password
+  privacy_protocol = "aes128"
+  privacy_password = var.snmp_priv_password
+  comment = "read-only monitoring"
+}
@@ -0,0 +1,19 @@
+# Terraform will prompt for values, unless a tfvars file is present.
+variable "username" {
+    type = string
+}
+variable "password" {
+    type = string
+    sensitive = true
+}
+variable "validate_certs" {
+    type = bool
+}
+variable "snmp_auth_password" {
+    type = string
+    sensitive = true
+}
+variable "snmp_priv_password" {
+    type = string
+    sensitive = true
+}
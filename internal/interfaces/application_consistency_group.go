@@ -0,0 +1,111 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ConsistencyGroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ConsistencyGroupGetDataModelONTAP struct {
+	Name           string              `mapstructure:"name"`
+	UUID           string              `mapstructure:"uuid"`
+	SVM            SvmDataModelONTAP   `mapstructure:"svm"`
+	Volumes        []NameDataModel     `mapstructure:"volumes"`
+	Luns           []NameDataModel     `mapstructure:"luns"`
+	SnapshotPolicy NameDataModel       `mapstructure:"snapshot_policy"`
+	QOS            ConsistencyGroupQOS `mapstructure:"qos"`
+}
+
+// ConsistencyGroupQOS describes the QoS policy applied to the consistency group.
+type ConsistencyGroupQOS struct {
+	Policy NameDataModel `mapstructure:"policy"`
+}
+
+// ConsistencyGroupResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ConsistencyGroupResourceBodyDataModelONTAP struct {
+	Name           string                   `mapstructure:"name,omitempty"`
+	SVM            *SvmDataModelONTAP       `mapstructure:"svm,omitempty"`
+	Volumes        []map[string]interface{} `mapstructure:"volumes,omitempty"`
+	SnapshotPolicy map[string]string        `mapstructure:"snapshot_policy,omitempty"`
+	QOS            map[string]interface{}   `mapstructure:"qos,omitempty"`
+}
+
+// ConsistencyGroupVolumes returns the volumes body to add existing volumes to, or remove them from, a consistency group.
+// action is add or remove.
+func ConsistencyGroupVolumes(names []string, action string) []map[string]interface{} {
+	volumes := make([]map[string]interface{}, len(names))
+	for index, name := range names {
+		volumes[index] = map[string]interface{}{
+			"name":                 name,
+			"provisioning_options": map[string]string{"action": action},
+		}
+	}
+	return volumes
+}
+
+// GetConsistencyGroupByName to get consistency group info
+func GetConsistencyGroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ConsistencyGroupGetDataModelONTAP, error) {
+	api := "application/consistency-groups"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "svm.name", "svm.uuid", "volumes.name", "volumes.uuid", "luns.name", "luns.uuid", "snapshot_policy.name", "qos.policy.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no consistency group %s found in svm %s", name, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading consistency group info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ConsistencyGroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read consistency group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateConsistencyGroup to create a consistency group, ONTAP runs a job so the group is read back by name
+func CreateConsistencyGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ConsistencyGroupResourceBodyDataModelONTAP) error {
+	api := "application/consistency-groups"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding consistency group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating consistency group", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create consistency group: %#v", body))
+	return nil
+}
+
+// UpdateConsistencyGroup to update a consistency group
+func UpdateConsistencyGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ConsistencyGroupResourceBodyDataModelONTAP, uuid string) error {
+	api := "application/consistency-groups/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding consistency group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating consistency group", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteConsistencyGroup to delete a consistency group, the volumes and LUNs are left in place
+func DeleteConsistencyGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "application/consistency-groups"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting consistency group", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
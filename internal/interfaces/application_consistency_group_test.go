@@ -0,0 +1,135 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var consistencyGroupRecord = ConsistencyGroupGetDataModelONTAP{
+	Name:           "oracle_db1",
+	UUID:           "4f0a8c2e-1234-11ee-8d3c-005056b3f0a7",
+	SVM:            SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+	Volumes:        []NameDataModel{{Name: "db1_data", UUID: "vol-uuid1"}, {Name: "db1_logs", UUID: "vol-uuid2"}},
+	Luns:           []NameDataModel{{Name: "/vol/db1_data/lun1", UUID: "lun-uuid1"}},
+	SnapshotPolicy: NameDataModel{Name: "default"},
+	QOS:            ConsistencyGroupQOS{Policy: NameDataModel{Name: "db_qos"}},
+}
+
+var badConsistencyGroupRecord = struct{ Name int }{123}
+
+func TestGetConsistencyGroupByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(consistencyGroupRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badConsistencyGroupRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "application/consistency-groups", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "application/consistency-groups", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "application/consistency-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "application/consistency-groups", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ConsistencyGroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &consistencyGroupRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetConsistencyGroupByName(errorHandler, *r, "oracle_db1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetConsistencyGroupByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetConsistencyGroupByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateConsistencyGroup(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "application/consistency-groups/4f0a8c2e-1234-11ee-8d3c-005056b3f0a7"
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := ConsistencyGroupResourceBodyDataModelONTAP{Volumes: ConsistencyGroupVolumes([]string{"db1_archive"}, "add")}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateConsistencyGroup(errorHandler, *r, body, "4f0a8c2e-1234-11ee-8d3c-005056b3f0a7")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateConsistencyGroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConsistencyGroupVolumes(t *testing.T) {
+	want := []map[string]interface{}{
+		{"name": "db1_data", "provisioning_options": map[string]string{"action": "remove"}},
+	}
+	if got := ConsistencyGroupVolumes([]string{"db1_data"}, "remove"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConsistencyGroupVolumes() = %v, want %v", got, want)
+	}
+}
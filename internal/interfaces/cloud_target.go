@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// CloudTargetGetDataModelONTAP describes the GET record data model using go types for mapping.
+type CloudTargetGetDataModelONTAP struct {
+	Name         string `mapstructure:"name"`
+	UUID         string `mapstructure:"uuid"`
+	ProviderType string `mapstructure:"provider_type"`
+	Server       string `mapstructure:"server"`
+	Container    string `mapstructure:"container"`
+}
+
+// GetCloudTargetByName to get a cloud target (FabricPool object store) by name
+func GetCloudTargetByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*CloudTargetGetDataModelONTAP, error) {
+	api := "cloud/targets"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "uuid", "provider_type", "server", "container"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no cloud target found with name %s", name)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cloud target info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP CloudTargetGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cloud target: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/zapiclient"
 )
 
 // ClusterGetDataModelONTAP describes the GET record data model using go types for mapping.
@@ -40,11 +41,9 @@ type ClusterNodeGetDataModelONTAP struct {
 }
 
 // GetCluster to get cluster info
+// The record is cached per connection profile, as it is read by most resources to check the ONTAP version
 func GetCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*ClusterGetDataModelONTAP, error) {
-	statusCode, response, err := r.GetNilOrOneRecord("cluster", nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET cluster")
-	}
+	statusCode, response, err := getCachedClusterRecord(r)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error reading cluster info", fmt.Sprintf("error on GET cluster: %s, statusCode %d", err, statusCode))
 	}
@@ -57,6 +56,22 @@ func GetCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*Clu
 	return &dataONTAP, nil
 }
 
+// getCachedClusterRecord returns the cached GET cluster record, reading it if needed, without reporting errors
+func getCachedClusterRecord(r restclient.RestClient) (int, map[string]interface{}, error) {
+	if response := r.GetCachedClusterRecord(); response != nil {
+		return 200, response, nil
+	}
+	statusCode, response, err := r.GetNilOrOneRecord("cluster", nil, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET cluster")
+	}
+	if err != nil {
+		return statusCode, nil, err
+	}
+	r.SetCachedClusterRecord(response)
+	return statusCode, response, nil
+}
+
 // GetClusterNodes to get cluster nodes info
 func GetClusterNodes(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]ClusterNodeGetDataModelONTAP, error) {
 
@@ -80,3 +95,148 @@ func GetClusterNodes(errorHandler *utils.ErrorHandler, r restclient.RestClient)
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster data source NODES: %#v", nodes))
 	return nodes, nil
 }
+
+type zapiSystemVersion struct {
+	Version      string `xml:"version"`
+	VersionTuple struct {
+		Generation int `xml:"generation"`
+		Major      int `xml:"major"`
+		Minor      int `xml:"minor"`
+	} `xml:"version-tuple>system-version-tuple"`
+}
+
+type zapiClusterIdentity struct {
+	ClusterName string `xml:"attributes>cluster-identity-info>cluster-name"`
+}
+
+type zapiNodes struct {
+	Nodes []struct {
+		Node string `xml:"node"`
+	} `xml:"attributes-list>node-details-info"`
+}
+
+type zapiIterArgs struct {
+	MaxRecords int `xml:"max-records"`
+}
+
+type zapiNetInterfaceArgs struct {
+	MaxRecords int    `xml:"max-records"`
+	Role       string `xml:"query>net-interface-info>role"`
+}
+
+type zapiNetInterfaces struct {
+	Interfaces []struct {
+		Address  string `xml:"address"`
+		HomeNode string `xml:"home-node"`
+	} `xml:"attributes-list>net-interface-info"`
+}
+
+// GetClusterZAPI to get cluster info using ZAPI, for clusters without the REST API
+func GetClusterZAPI(errorHandler *utils.ErrorHandler, z *zapiclient.ZapiClient) (*ClusterGetDataModelONTAP, error) {
+	var version zapiSystemVersion
+	if err := z.Invoke("system-get-version", nil, &version); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster info", fmt.Sprintf("error on ZAPI system-get-version: %s", err))
+	}
+	var identity zapiClusterIdentity
+	if err := z.Invoke("cluster-identity-get", nil, &identity); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster info", fmt.Sprintf("error on ZAPI cluster-identity-get: %s", err))
+	}
+	dataONTAP := ClusterGetDataModelONTAP{
+		Name: identity.ClusterName,
+		Version: versionModelONTAP{
+			Full:       version.Version,
+			Generation: version.VersionTuple.Generation,
+			Major:      version.VersionTuple.Major,
+			Minor:      version.VersionTuple.Minor,
+		},
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster data source using ZAPI: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetClusterNodesZAPI to get cluster nodes info using ZAPI, for clusters without the REST API
+func GetClusterNodesZAPI(errorHandler *utils.ErrorHandler, z *zapiclient.ZapiClient) ([]ClusterNodeGetDataModelONTAP, error) {
+	var nodeRecords zapiNodes
+	if err := z.Invoke("system-node-get-iter", zapiIterArgs{MaxRecords: 1000}, &nodeRecords); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster nodes info", fmt.Sprintf("error on ZAPI system-node-get-iter: %s", err))
+	}
+	var interfaceRecords zapiNetInterfaces
+	args := zapiNetInterfaceArgs{MaxRecords: 1000, Role: "node_mgmt"}
+	if err := z.Invoke("net-interface-get-iter", args, &interfaceRecords); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster nodes info", fmt.Sprintf("error on ZAPI net-interface-get-iter: %s", err))
+	}
+	nodes := []ClusterNodeGetDataModelONTAP{}
+	for _, record := range nodeRecords.Nodes {
+		node := ClusterNodeGetDataModelONTAP{Name: record.Node}
+		for _, netInterface := range interfaceRecords.Interfaces {
+			if netInterface.HomeNode == record.Node {
+				node.ManagementInterfaces = append(node.ManagementInterfaces, mgmtInterface{IP: ipAddress{Address: netInterface.Address}})
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster data source NODES using ZAPI: %#v", nodes))
+	return nodes, nil
+}
+
+// ClusterIdentityGetDataModelONTAP describes the GET record data model for the cluster identity settings.
+type ClusterIdentityGetDataModelONTAP struct {
+	Name        string          `mapstructure:"name"`
+	Location    string          `mapstructure:"location"`
+	Contact     string          `mapstructure:"contact"`
+	DNSDomains  []string        `mapstructure:"dns_domains"`
+	NameServers []string        `mapstructure:"name_servers"`
+	Timezone    ClusterTimezone `mapstructure:"timezone"`
+}
+
+// ClusterTimezone describes the time zone of the cluster, such as Etc/UTC.
+type ClusterTimezone struct {
+	Name string `mapstructure:"name"`
+}
+
+// ClusterResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ClusterResourceBodyDataModelONTAP struct {
+	Name        string           `mapstructure:"name,omitempty"`
+	Location    *string          `mapstructure:"location,omitempty"`
+	Contact     *string          `mapstructure:"contact,omitempty"`
+	DNSDomains  []string         `mapstructure:"dns_domains,omitempty"`
+	NameServers []string         `mapstructure:"name_servers,omitempty"`
+	Timezone    *ClusterTimezone `mapstructure:"timezone,omitempty"`
+}
+
+// GetClusterIdentity to get the cluster identity settings, the record is not cached as these settings can be changed
+func GetClusterIdentity(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*ClusterIdentityGetDataModelONTAP, error) {
+	query := r.NewQuery()
+	query.Fields([]string{"name", "location", "contact", "dns_domains", "name_servers", "timezone"})
+	statusCode, response, err := r.GetNilOrOneRecord("cluster", query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET cluster")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster info", fmt.Sprintf("error on GET cluster: %s, statusCode %d", err, statusCode))
+	}
+
+	var dataONTAP ClusterIdentityGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET cluster", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster identity: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateCluster to change the cluster settings, the cached cluster record is cleared as the name may change
+func UpdateCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ClusterResourceBodyDataModelONTAP) error {
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding cluster body", fmt.Sprintf("error on encoding cluster body: %s, body: %#v", err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod("cluster", nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating cluster", fmt.Sprintf("error on PATCH cluster: %s, statusCode %d", err, statusCode))
+	}
+	r.SetCachedClusterRecord(nil)
+	return nil
+}
@@ -0,0 +1,49 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// System health alerts are not exposed by the public REST API, the CLI passthrough is used instead.
+const healthAlertAPI = "private/cli/system/health/alert"
+
+// ClusterHealthAlertGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ClusterHealthAlertGetDataModelONTAP struct {
+	Node              string `mapstructure:"node"`
+	Monitor           string `mapstructure:"monitor"`
+	AlertID           string `mapstructure:"alert_id"`
+	AlertingResource  string `mapstructure:"alerting_resource"`
+	PerceivedSeverity string `mapstructure:"perceived_severity"`
+	ProbableCause     string `mapstructure:"probable_cause"`
+	PossibleEffect    string `mapstructure:"possible_effect"`
+	CorrectiveActions string `mapstructure:"corrective_actions"`
+	Acknowledge       bool   `mapstructure:"acknowledge"`
+}
+
+// GetClusterHealthAlerts to get the active system health alerts of all the nodes
+func GetClusterHealthAlerts(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]ClusterHealthAlertGetDataModelONTAP, error) {
+	api := healthAlertAPI
+	query := r.NewQuery()
+	query.Fields([]string{"node", "monitor", "alert_id", "alerting_resource", "perceived_severity", "probable_cause", "possible_effect", "corrective_actions", "acknowledge"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading system health alerts", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ClusterHealthAlertGetDataModelONTAP
+	for _, info := range response {
+		var record ClusterHealthAlertGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read system health alerts: %#v", dataONTAP))
+	return dataONTAP, nil
+}
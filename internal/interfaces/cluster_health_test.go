@@ -0,0 +1,84 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var clusterHealthAlertRecord = ClusterHealthAlertGetDataModelONTAP{
+	Node:              "node1",
+	Monitor:           "node-connect",
+	AlertID:           "DualPathToDiskShelf_Alert",
+	AlertingResource:  "50:05:0c:c1:02:00:0f:02",
+	PerceivedSeverity: "major",
+	ProbableCause:     "Connection establishment error",
+	PossibleEffect:    "Access to storage shelf 50:05:0c:c1:02:00:0f:02 will be lost with a single hardware component failure",
+	CorrectiveActions: "1. Halt controller node1 and all controllers attached to disk shelf 50:05:0c:c1:02:00:0f:02.",
+	Acknowledge:       false,
+}
+
+func TestGetClusterHealthAlerts(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(clusterHealthAlertRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"acknowledge": "maybe"}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: healthAlertAPI, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: healthAlertAPI, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: healthAlertAPI, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: healthAlertAPI, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []ClusterHealthAlertGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: []ClusterHealthAlertGetDataModelONTAP{clusterHealthAlertRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetClusterHealthAlerts(errorHandler, *r)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterHealthAlerts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterHealthAlerts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"fmt"
+	"path"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -9,8 +11,21 @@ import (
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
+// JobGetDataModelONTAP describes the GET record data model using go types for mapping.
+type JobGetDataModelONTAP struct {
+	UUID        string `mapstructure:"uuid"`
+	Description string `mapstructure:"description"`
+	State       string `mapstructure:"state"`
+	Message     string `mapstructure:"message"`
+	Code        int    `mapstructure:"code"`
+	Error       struct {
+		Code    string `mapstructure:"code"`
+		Message string `mapstructure:"message"`
+	} `mapstructure:"error"`
+}
+
 // GetJobByID returns the job state given the job uuid.
-func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (interface{}, error) {
+func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*JobGetDataModelONTAP, error) {
 	api := "cluster/jobs/" + uuid
 	statusCode, record, err := r.GetNilOrOneRecord(api, nil, nil)
 	if err == nil && record == nil {
@@ -20,7 +35,7 @@ func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid
 		return nil, errorHandler.MakeAndReportError("error reading job info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
 
 	}
-	var job jobModel
+	var job JobGetDataModelONTAP
 	if err := mapstructure.Decode(record, &job); err != nil {
 		return nil, errorHandler.MakeAndReportError("error decoding job info", fmt.Sprintf("error: %s, statusCode %d, record %#v", err, statusCode, record))
 	}
@@ -28,6 +43,63 @@ func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid
 	return &job, nil
 }
 
-type jobModel struct {
-	State string `tfsdk:"state"`
+// WaitForJob polls a job every interval seconds until it completes, and reports an error if it fails or is still running after timeout seconds.
+func WaitForJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, timeout int, interval int) error {
+	for timeRemaining := timeout; ; timeRemaining -= interval {
+		job, err := GetJobByID(errorHandler, r, uuid)
+		if err != nil {
+			return err
+		}
+		switch job.State {
+		case "success":
+			return nil
+		case "queued", "running", "paused":
+		default:
+			message := job.Error.Message
+			if message == "" {
+				message = job.Message
+			}
+			return errorHandler.MakeAndReportError("job failed",
+				fmt.Sprintf("job %s (%s) ended in state %s, error code: %s, message: %s", uuid, job.Description, job.State, job.Error.Code, message))
+		}
+		if timeRemaining <= 0 {
+			return errorHandler.MakeAndReportError("error waiting for job",
+				fmt.Sprintf("timed out after %d seconds waiting for job %s (%s), last state: %s", timeout, uuid, job.Description, job.State))
+		}
+		time.Sleep(time.Duration(interval) * time.Second)
+	}
+}
+
+// WaitForJobs follows the job links in a POST, PATCH, or DELETE response, and waits for each job to complete.
+func WaitForJobs(errorHandler *utils.ErrorHandler, r restclient.RestClient, response restclient.RestResponse, timeout int, interval int) error {
+	jobs := response.Jobs
+	if response.Job != nil {
+		jobs = append([]map[string]interface{}{response.Job}, jobs...)
+	}
+	for _, job := range jobs {
+		uuid := getJobUUID(job)
+		if uuid == "" {
+			return errorHandler.MakeAndReportError("error reading job info", fmt.Sprintf("no uuid or link in job %#v", job))
+		}
+		if err := WaitForJob(errorHandler, r, uuid, timeout, interval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getJobUUID returns the job uuid, or extracts it from the self link, eg /api/cluster/jobs/<uuid>
+func getJobUUID(job map[string]interface{}) string {
+	if uuid, ok := job["uuid"].(string); ok && uuid != "" {
+		return uuid
+	}
+	var links struct {
+		Self struct {
+			Href string `mapstructure:"href"`
+		} `mapstructure:"self"`
+	}
+	if err := mapstructure.Decode(job["_links"], &links); err != nil || links.Self.Href == "" {
+		return ""
+	}
+	return path.Base(links.Self.Href)
 }
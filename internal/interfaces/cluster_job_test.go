@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestWaitForJobs(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "POST /api/storage/volumes"}}}
+	}
+	failedJob := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": "failure", "error": map[string]any{"code": "917927", "message": "volume already exists"}}}}
+	genericError := errors.New("generic error for UT")
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobLink := restclient.RestResponse{Job: map[string]any{"_links": map[string]any{"self": map[string]any{"href": "/api/cluster/jobs/job-uuid"}}}}
+	jobs := restclient.RestResponse{Jobs: []map[string]any{{"uuid": "job-uuid"}, {"uuid": "job-uuid2"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_job": {},
+		"test_success": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_success_link": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_success_jobs": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid2", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_failure": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: failedJob, Err: nil},
+		},
+		"test_timeout": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("running"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		response  restclient.RestResponse
+		timeout   int
+		wantErr   bool
+	}{
+		{name: "test_no_job", responses: responses["test_no_job"], response: restclient.RestResponse{}, timeout: 10, wantErr: false},
+		{name: "test_success", responses: responses["test_success"], response: job, timeout: 10, wantErr: false},
+		{name: "test_success_link", responses: responses["test_success_link"], response: jobLink, timeout: 10, wantErr: false},
+		{name: "test_success_jobs", responses: responses["test_success_jobs"], response: jobs, timeout: 10, wantErr: false},
+		{name: "test_failure", responses: responses["test_failure"], response: job, timeout: 10, wantErr: true},
+		{name: "test_timeout", responses: responses["test_timeout"], response: job, timeout: 0, wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], response: job, timeout: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			// poll without sleeping
+			err = WaitForJobs(errorHandler, *r, tt.response, tt.timeout, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForJobs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
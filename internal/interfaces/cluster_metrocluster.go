@@ -0,0 +1,141 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// MetroclusterGetDataModelONTAP describes the GET record data model using go types for mapping.
+type MetroclusterGetDataModelONTAP struct {
+	ConfigurationType string           `mapstructure:"configuration_type"`
+	Local             MetroclusterSite `mapstructure:"local"`
+	Remote            MetroclusterSite `mapstructure:"remote"`
+}
+
+// MetroclusterSite describes the state of one of the MetroCluster sites.
+type MetroclusterSite struct {
+	Cluster                 NameDataModel `mapstructure:"cluster"`
+	ConfigurationState      string        `mapstructure:"configuration_state"`
+	Mode                    string        `mapstructure:"mode"`
+	PeriodicCheckEnabled    bool          `mapstructure:"periodic_check_enabled"`
+	PartnerClusterReachable bool          `mapstructure:"partner_cluster_reachable"`
+}
+
+// MetroclusterDrGroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type MetroclusterDrGroupGetDataModelONTAP struct {
+	ID             int                  `mapstructure:"id"`
+	PartnerCluster NameDataModel        `mapstructure:"partner_cluster"`
+	DrPairs        []MetroclusterDrPair `mapstructure:"dr_pairs"`
+}
+
+// MetroclusterDrPair describes a node and its DR partner.
+type MetroclusterDrPair struct {
+	Node    NameDataModel `mapstructure:"node"`
+	Partner NameDataModel `mapstructure:"partner"`
+}
+
+// MetroclusterOperationGetDataModelONTAP describes the GET record data model using go types for mapping.
+type MetroclusterOperationGetDataModelONTAP struct {
+	UUID      string   `mapstructure:"uuid"`
+	Type      string   `mapstructure:"type"`
+	State     string   `mapstructure:"state"`
+	StartTime string   `mapstructure:"start_time"`
+	EndTime   string   `mapstructure:"end_time"`
+	Errors    []string `mapstructure:"errors"`
+}
+
+// MetroclusterOperationDataSourceFilterModel describes the data source filter model.
+type MetroclusterOperationDataSourceFilterModel struct {
+	Type  string `mapstructure:"type,omitempty"`
+	State string `mapstructure:"state,omitempty"`
+}
+
+// IsReadyForSwitchover returns true when MetroCluster is configured, both sites are in normal mode and the partner cluster is reachable
+func (m MetroclusterGetDataModelONTAP) IsReadyForSwitchover() bool {
+	return m.Local.ConfigurationState == "configured" && m.Local.Mode == "normal" && m.Remote.Mode == "normal" && m.Local.PartnerClusterReachable
+}
+
+// GetMetrocluster to get the MetroCluster configuration state
+func GetMetrocluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*MetroclusterGetDataModelONTAP, error) {
+	api := "cluster/metrocluster"
+	query := r.NewQuery()
+	query.Fields([]string{"configuration_type", "local", "remote"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading metrocluster info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP MetroclusterGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read metrocluster data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListMetroclusterDrGroups to get the MetroCluster DR groups
+func GetListMetroclusterDrGroups(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]MetroclusterDrGroupGetDataModelONTAP, error) {
+	api := "cluster/metrocluster/dr-groups"
+	query := r.NewQuery()
+	query.Fields([]string{"id", "partner_cluster", "dr_pairs"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading metrocluster dr groups info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []MetroclusterDrGroupGetDataModelONTAP
+	for _, info := range response {
+		var record MetroclusterDrGroupGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read metrocluster dr groups data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// GetListMetroclusterOperations to get the MetroCluster operations matching a filter
+func GetListMetroclusterOperations(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *MetroclusterOperationDataSourceFilterModel) ([]MetroclusterOperationGetDataModelONTAP, error) {
+	api := "cluster/metrocluster/operations"
+	query := r.NewQuery()
+	query.Fields([]string{"uuid", "type", "state", "start_time", "end_time", "errors"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding metrocluster operation filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading metrocluster operations info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []MetroclusterOperationGetDataModelONTAP
+	for _, info := range response {
+		var record MetroclusterOperationGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read metrocluster operations data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
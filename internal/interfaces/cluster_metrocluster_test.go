@@ -0,0 +1,238 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var metroclusterRecord = MetroclusterGetDataModelONTAP{
+	ConfigurationType: "fc",
+	Local: MetroclusterSite{
+		Cluster:                 NameDataModel{Name: "cluster1", UUID: "cluster1-uuid"},
+		ConfigurationState:      "configured",
+		Mode:                    "normal",
+		PeriodicCheckEnabled:    true,
+		PartnerClusterReachable: true,
+	},
+	Remote: MetroclusterSite{
+		Cluster:            NameDataModel{Name: "cluster2", UUID: "cluster2-uuid"},
+		ConfigurationState: "configured",
+		Mode:               "normal",
+	},
+}
+
+var metroclusterDrGroupRecord = MetroclusterDrGroupGetDataModelONTAP{
+	ID:             1,
+	PartnerCluster: NameDataModel{Name: "cluster2", UUID: "cluster2-uuid"},
+	DrPairs: []MetroclusterDrPair{
+		{Node: NameDataModel{Name: "node1"}, Partner: NameDataModel{Name: "node3"}},
+	},
+}
+
+var metroclusterOperationRecord = MetroclusterOperationGetDataModelONTAP{
+	UUID:      "operation-uuid",
+	Type:      "check",
+	State:     "successful",
+	StartTime: "2023-11-20T10:00:00-05:00",
+	EndTime:   "2023-11-20T10:05:00-05:00",
+}
+
+var badMetroclusterRecord = struct{ Local int }{123}
+
+func TestGetMetrocluster(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(metroclusterRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badMetroclusterRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *MetroclusterGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &metroclusterRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetMetrocluster(errorHandler, *r)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMetrocluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetMetrocluster() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetroclusterIsReadyForSwitchover(t *testing.T) {
+	switchedOver := metroclusterRecord
+	switchedOver.Local.Mode = "switchover"
+	unreachable := metroclusterRecord
+	unreachable.Local.PartnerClusterReachable = false
+	tests := []struct {
+		name   string
+		record MetroclusterGetDataModelONTAP
+		want   bool
+	}{
+		{name: "test_ready", record: metroclusterRecord, want: true},
+		{name: "test_not_configured", record: MetroclusterGetDataModelONTAP{Local: MetroclusterSite{ConfigurationState: "not_configured"}}, want: false},
+		{name: "test_switchover", record: switchedOver, want: false},
+		{name: "test_unreachable", record: unreachable, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.record.IsReadyForSwitchover(); got != tt.want {
+				t.Errorf("IsReadyForSwitchover() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetListMetroclusterDrGroups(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(metroclusterDrGroupRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/dr-groups", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/dr-groups", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/dr-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []MetroclusterDrGroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: []MetroclusterDrGroupGetDataModelONTAP{metroclusterDrGroupRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListMetroclusterDrGroups(errorHandler, *r)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListMetroclusterDrGroups() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListMetroclusterDrGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetListMetroclusterOperations(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(metroclusterOperationRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/operations", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/operations", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/metrocluster/operations", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []MetroclusterOperationGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []MetroclusterOperationGetDataModelONTAP{metroclusterOperationRecord, metroclusterOperationRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListMetroclusterOperations(errorHandler, *r, &MetroclusterOperationDataSourceFilterModel{Type: "check"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListMetroclusterOperations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListMetroclusterOperations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
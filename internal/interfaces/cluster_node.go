@@ -0,0 +1,109 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NodeGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NodeGetDataModelONTAP struct {
+	Name                 string                    `mapstructure:"name"`
+	UUID                 string                    `mapstructure:"uuid"`
+	Model                string                    `mapstructure:"model"`
+	SerialNumber         string                    `mapstructure:"serial_number"`
+	Location             string                    `mapstructure:"location"`
+	State                string                    `mapstructure:"state"`
+	Uptime               int64                     `mapstructure:"uptime"`
+	Version              versionModelONTAP         `mapstructure:"version"`
+	ManagementInterfaces []NodeManagementInterface `mapstructure:"management_interfaces"`
+	HA                   NodeHA                    `mapstructure:"ha"`
+}
+
+// NodeManagementInterface describes a node management interface.
+type NodeManagementInterface struct {
+	Name string    `mapstructure:"name"`
+	IP   ipAddress `mapstructure:"ip"`
+}
+
+// NodeHA describes the HA configuration of a node.
+type NodeHA struct {
+	Enabled  bool            `mapstructure:"enabled"`
+	Partners []NameDataModel `mapstructure:"partners"`
+}
+
+// NodeDataSourceFilterModel describes the data source filter model.
+type NodeDataSourceFilterModel struct {
+	Name  string `mapstructure:"name,omitempty"`
+	Model string `mapstructure:"model,omitempty"`
+}
+
+var nodeFields = []string{"name", "uuid", "model", "serial_number", "location", "state", "uptime", "version",
+	"management_interfaces", "ha.enabled", "ha.partners"}
+
+// HAPartnerName returns the name of the HA partner, or an empty string for a single node cluster
+func (n NodeGetDataModelONTAP) HAPartnerName() string {
+	if len(n.HA.Partners) == 0 {
+		return ""
+	}
+	return n.HA.Partners[0].Name
+}
+
+// GetClusterNodeByName to get a cluster node by name
+func GetClusterNodeByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*NodeGetDataModelONTAP, error) {
+	api := "cluster/nodes"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields(nodeFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no node found with name %s", name)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster node info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NodeGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster node data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListClusterNodes to get cluster node info for all nodes matching a filter
+func GetListClusterNodes(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NodeDataSourceFilterModel) ([]NodeGetDataModelONTAP, error) {
+	api := "cluster/nodes"
+	query := r.NewQuery()
+	query.Fields(nodeFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding cluster node filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster node info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NodeGetDataModelONTAP
+	for _, info := range response {
+		var record NodeGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster nodes data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
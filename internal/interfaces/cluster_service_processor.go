@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ClusterServiceProcessorGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ClusterServiceProcessorGetDataModelONTAP struct {
+	Name             string                           `mapstructure:"name"`
+	UUID             string                           `mapstructure:"uuid"`
+	ServiceProcessor ClusterServiceProcessorDataModel `mapstructure:"service_processor"`
+}
+
+// ClusterServiceProcessorDataModel describes the service processor settings of a node.
+type ClusterServiceProcessorDataModel struct {
+	DHCPEnabled   bool                           `mapstructure:"dhcp_enabled"`
+	IPv4Interface ClusterServiceProcessorIPv4    `mapstructure:"ipv4_interface"`
+	SSHInfo       ClusterServiceProcessorSSHInfo `mapstructure:"ssh_info"`
+	State         string                         `mapstructure:"state,omitempty"`
+}
+
+// ClusterServiceProcessorIPv4 describes the service processor IPv4 interface.
+type ClusterServiceProcessorIPv4 struct {
+	Address string `mapstructure:"address,omitempty"`
+	Netmask string `mapstructure:"netmask,omitempty"`
+	Gateway string `mapstructure:"gateway,omitempty"`
+}
+
+// ClusterServiceProcessorSSHInfo describes the addresses allowed to connect to the service processor with SSH.
+type ClusterServiceProcessorSSHInfo struct {
+	AllowedAddresses []string `mapstructure:"allowed_addresses"`
+}
+
+// ClusterServiceProcessorResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ClusterServiceProcessorResourceBodyDataModelONTAP struct {
+	ServiceProcessor ClusterServiceProcessorBodyDataModel `mapstructure:"service_processor"`
+}
+
+// ClusterServiceProcessorBodyDataModel only includes the settings to modify.
+type ClusterServiceProcessorBodyDataModel struct {
+	DHCPEnabled   *bool                           `mapstructure:"dhcp_enabled,omitempty"`
+	IPv4Interface *ClusterServiceProcessorIPv4    `mapstructure:"ipv4_interface,omitempty"`
+	SSHInfo       *ClusterServiceProcessorSSHInfo `mapstructure:"ssh_info,omitempty"`
+}
+
+// GetClusterServiceProcessor to get the service processor info for a node
+func GetClusterServiceProcessor(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string) (*ClusterServiceProcessorGetDataModelONTAP, error) {
+	api := "cluster/nodes"
+	query := r.NewQuery()
+	query.Set("name", nodeName)
+	query.Fields([]string{"name", "uuid", "service_processor.dhcp_enabled", "service_processor.ipv4_interface", "service_processor.ssh_info", "service_processor.state"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no node %s found", nodeName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading service processor info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ClusterServiceProcessorGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read service processor: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateClusterServiceProcessor to update the service processor settings of a node
+func UpdateClusterServiceProcessor(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ClusterServiceProcessorResourceBodyDataModelONTAP, nodeUUID string) error {
+	api := "cluster/nodes/" + nodeUUID
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding service processor body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating service processor", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var clusterServiceProcessorRecord = ClusterServiceProcessorGetDataModelONTAP{
+	Name: "node1",
+	UUID: "node1-uuid",
+	ServiceProcessor: ClusterServiceProcessorDataModel{
+		DHCPEnabled:   false,
+		IPv4Interface: ClusterServiceProcessorIPv4{Address: "10.10.10.10", Netmask: "255.255.255.0", Gateway: "10.10.10.1"},
+		SSHInfo:       ClusterServiceProcessorSSHInfo{AllowedAddresses: []string{"10.10.10.0/24"}},
+		State:         "online",
+	},
+}
+
+var badClusterServiceProcessorRecord = struct{ Name int }{123}
+
+func TestGetClusterServiceProcessor(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(clusterServiceProcessorRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badClusterServiceProcessorRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ClusterServiceProcessorGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &clusterServiceProcessorRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetClusterServiceProcessor(errorHandler, *r, "node1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterServiceProcessor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterServiceProcessor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateClusterServiceProcessor(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_ssh": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster/nodes/node1-uuid", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster/nodes/node1-uuid", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := ClusterServiceProcessorResourceBodyDataModelONTAP{
+		ServiceProcessor: ClusterServiceProcessorBodyDataModel{
+			SSHInfo: &ClusterServiceProcessorSSHInfo{AllowedAddresses: []string{"10.10.10.0/24"}},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_ssh", responses: responses["test_update_ssh"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateClusterServiceProcessor(errorHandler, *r, body, "node1-uuid")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateClusterServiceProcessor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
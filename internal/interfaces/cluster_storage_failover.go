@@ -0,0 +1,72 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Storage failover settings can not be modified with the public REST API, the CLI passthrough is used instead.
+const storageFailoverAPI = "private/cli/storage/failover"
+
+// ClusterStorageFailoverGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ClusterStorageFailoverGetDataModelONTAP struct {
+	Node         string `mapstructure:"node"`
+	PartnerName  string `mapstructure:"partner_name"`
+	Enabled      bool   `mapstructure:"enabled"`
+	AutoGiveback bool   `mapstructure:"auto_giveback"`
+	Hwassist     bool   `mapstructure:"hwassist"`
+}
+
+// ClusterStorageFailoverResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Only the settings to modify are included.
+type ClusterStorageFailoverResourceBodyDataModelONTAP struct {
+	Enabled      *bool `mapstructure:"enabled,omitempty"`
+	AutoGiveback *bool `mapstructure:"auto_giveback,omitempty"`
+	Hwassist     *bool `mapstructure:"hwassist,omitempty"`
+}
+
+// GetClusterStorageFailover to get the storage failover settings of a node
+func GetClusterStorageFailover(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string) (*ClusterStorageFailoverGetDataModelONTAP, error) {
+	api := storageFailoverAPI
+	query := r.NewQuery()
+	query.Set("node", nodeName)
+	query.Fields([]string{"node", "partner_name", "enabled", "auto_giveback", "hwassist"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no storage failover info found for node %s, the node may not be part of an HA pair", nodeName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading storage failover info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ClusterStorageFailoverGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read storage failover: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateClusterStorageFailover to update the storage failover settings of a node
+func UpdateClusterStorageFailover(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ClusterStorageFailoverResourceBodyDataModelONTAP, nodeName string) error {
+	api := storageFailoverAPI
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding storage failover body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	query := r.NewQuery()
+	query.Set("node", nodeName)
+	statusCode, _, err := r.CallUpdateMethod(api, query, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating storage failover", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
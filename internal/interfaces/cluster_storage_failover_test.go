@@ -0,0 +1,126 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var clusterStorageFailoverRecord = ClusterStorageFailoverGetDataModelONTAP{
+	Node:         "node1",
+	PartnerName:  "node2",
+	Enabled:      true,
+	AutoGiveback: true,
+	Hwassist:     false,
+}
+
+var badClusterStorageFailoverRecord = struct{ Node int }{123}
+
+func TestGetClusterStorageFailover(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(clusterStorageFailoverRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badClusterStorageFailoverRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/storage/failover", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/storage/failover", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/storage/failover", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/storage/failover", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ClusterStorageFailoverGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &clusterStorageFailoverRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetClusterStorageFailover(errorHandler, *r, "node1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterStorageFailover() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterStorageFailover() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateClusterStorageFailover(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_auto_giveback": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "private/cli/storage/failover", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_no_change": {},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "private/cli/storage/failover", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	autoGiveback := false
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      ClusterStorageFailoverResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update_auto_giveback", responses: responses["test_update_auto_giveback"], body: ClusterStorageFailoverResourceBodyDataModelONTAP{AutoGiveback: &autoGiveback}, wantErr: false},
+		{name: "test_no_change", responses: responses["test_no_change"], body: ClusterStorageFailoverResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], body: ClusterStorageFailoverResourceBodyDataModelONTAP{AutoGiveback: &autoGiveback}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateClusterStorageFailover(errorHandler, *r, tt.body, "node1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateClusterStorageFailover() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
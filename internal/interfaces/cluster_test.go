@@ -11,6 +11,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/zapiclient"
 )
 
 func TestGetCluster(t *testing.T) {
@@ -90,6 +91,43 @@ func TestGetCluster(t *testing.T) {
 	}
 }
 
+func TestGetCluster_Cached(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := ClusterGetDataModelONTAP{
+		Name: "cluster1",
+		Version: versionModelONTAP{
+			Full: "ONTAP 1.2.3",
+		},
+	}
+	var recordInterface map[string]any
+	if err := mapstructure.Decode(record, &recordInterface); err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	cache := &restclient.ClusterCache{}
+
+	r, err := restclient.NewMockedRestClient([]restclient.MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: oneRecord, Err: nil},
+	})
+	if err != nil {
+		panic(err)
+	}
+	r.SetClusterCache(cache)
+	if got, err := GetCluster(errorHandler, *r); err != nil || !reflect.DeepEqual(got, &record) {
+		t.Fatalf("GetCluster() = %v, %v, want %v", got, err, record)
+	}
+
+	// a second client for the same profile does not send a request
+	r, err = restclient.NewMockedRestClient([]restclient.MockResponse{})
+	if err != nil {
+		panic(err)
+	}
+	r.SetClusterCache(cache)
+	if got, err := GetCluster(errorHandler, *r); err != nil || !reflect.DeepEqual(got, &record) {
+		t.Errorf("GetCluster() = %v, %v, want %v", got, err, record)
+	}
+}
+
 func TestGetClusterNodes(t *testing.T) {
 
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
@@ -172,3 +210,187 @@ func TestGetClusterNodes(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClusterZAPI(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	version := `<netapp><results status="passed"><version>NetApp Release 9.5P1</version><version-tuple><system-version-tuple><generation>9</generation><major>5</major><minor>0</minor></system-version-tuple></version-tuple></results></netapp>`
+	identity := `<netapp><results status="passed"><attributes><cluster-identity-info><cluster-name>cluster1</cluster-name></cluster-identity-info></attributes></results></netapp>`
+	failed := `<netapp><results status="failed" errno="13003" reason="Insufficient privileges"></results></netapp>`
+	tests := []struct {
+		name      string
+		responses []zapiclient.MockResponse
+		want      *ClusterGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: []zapiclient.MockResponse{{ExpectedAPI: "system-get-version", StatusCode: 200, Response: version}, {ExpectedAPI: "cluster-identity-get", StatusCode: 200, Response: identity}},
+			want: &ClusterGetDataModelONTAP{Name: "cluster1", Version: versionModelONTAP{Full: "NetApp Release 9.5P1", Generation: 9, Major: 5}}, wantErr: false},
+		{name: "test_error_1", responses: []zapiclient.MockResponse{{ExpectedAPI: "system-get-version", StatusCode: 200, Response: failed}}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := zapiclient.NewMockedZapiClient(tt.responses)
+			got, err := GetClusterZAPI(errorHandler, z)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterZAPI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterZAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetClusterNodesZAPI(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	nodes := `<netapp><results status="passed"><attributes-list><node-details-info><node>node1</node></node-details-info><node-details-info><node>node2</node></node-details-info></attributes-list><num-records>2</num-records></results></netapp>`
+	interfaces := `<netapp><results status="passed"><attributes-list><net-interface-info><address>10.10.10.11</address><home-node>node1</home-node></net-interface-info></attributes-list><num-records>1</num-records></results></netapp>`
+	want := []ClusterNodeGetDataModelONTAP{
+		{Name: "node1", ManagementInterfaces: []mgmtInterface{{IP: ipAddress{Address: "10.10.10.11"}}}},
+		{Name: "node2"},
+	}
+	z := zapiclient.NewMockedZapiClient([]zapiclient.MockResponse{{ExpectedAPI: "system-node-get-iter", StatusCode: 200, Response: nodes}, {ExpectedAPI: "net-interface-get-iter", StatusCode: 200, Response: interfaces}})
+	got, err := GetClusterNodesZAPI(errorHandler, z)
+	if err != nil {
+		t.Fatalf("GetClusterNodesZAPI() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetClusterNodesZAPI() = %v, want %v", got, want)
+	}
+}
+
+func TestUseZAPIFallback(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		cached    map[string]any
+		zapi      *zapiclient.ZapiClient
+		want      bool
+	}{
+		{name: "test_no_zapi_client", responses: nil, zapi: nil, want: false},
+		{name: "test_cached_cluster", responses: nil, cached: map[string]any{"name": "cluster1"}, zapi: zapiclient.NewMockedZapiClient(nil), want: false},
+		{name: "test_rest_available", responses: []restclient.MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil}}, zapi: zapiclient.NewMockedZapiClient(nil), want: false},
+		{name: "test_rest_not_available", responses: []restclient.MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 404, Response: restclient.RestResponse{}, Err: genericError}}, zapi: zapiclient.NewMockedZapiClient(nil), want: true},
+		{name: "test_rest_error", responses: []restclient.MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 401, Response: restclient.RestResponse{}, Err: genericError}}, zapi: zapiclient.NewMockedZapiClient(nil), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			r.SetClusterCache(&restclient.ClusterCache{})
+			if tt.cached != nil {
+				r.SetCachedClusterRecord(tt.cached)
+			}
+			if got := UseZAPIFallback(errorHandler, *r, tt.zapi); got != tt.want {
+				t.Errorf("UseZAPIFallback() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetClusterIdentity(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := ClusterIdentityGetDataModelONTAP{
+		Name:        "cluster1",
+		Location:    "datacenter 1",
+		Contact:     "storage team",
+		DNSDomains:  []string{"example.com"},
+		NameServers: []string{"10.10.10.10", "10.10.10.11"},
+		Timezone:    ClusterTimezone{Name: "Europe/Paris"},
+	}
+	var recordInterface map[string]any
+	if err := mapstructure.Decode(record, &recordInterface); err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ClusterIdentityGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &record, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetClusterIdentity(errorHandler, *r)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterIdentity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterIdentity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateCluster(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	location := "datacenter 2"
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_no_change": {},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      ClusterResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], body: ClusterResourceBodyDataModelONTAP{Name: "cluster2", Location: &location, Timezone: &ClusterTimezone{Name: "Etc/UTC"}}, wantErr: false},
+		{name: "test_no_change", responses: responses["test_no_change"], body: ClusterResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], body: ClusterResourceBodyDataModelONTAP{NameServers: []string{"10.10.10.10"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			cache := &restclient.ClusterCache{}
+			r.SetClusterCache(cache)
+			r.SetCachedClusterRecord(map[string]any{"name": "cluster1"})
+			err = UpdateCluster(errorHandler, *r, tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// the cached record is cleared after a successful update, as the name may have changed
+			if tt.name == "test_update" && r.GetCachedClusterRecord() != nil {
+				t.Errorf("UpdateCluster() did not clear the cached cluster record")
+			}
+		})
+	}
+}
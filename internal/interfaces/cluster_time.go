@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// The NTP synchronization status is not available with the public REST API, the CLI passthrough is used instead.
+const ntpStatusAPI = "private/cli/cluster/time-service/ntp/status"
+
+// ClusterNodeDateGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ClusterNodeDateGetDataModelONTAP struct {
+	Name string `mapstructure:"name"`
+	Date string `mapstructure:"date"`
+}
+
+// ClusterNtpStatusGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ClusterNtpStatusGetDataModelONTAP struct {
+	Node            string `mapstructure:"node"`
+	Server          string `mapstructure:"server"`
+	IsPeerReachable bool   `mapstructure:"is_peer_reachable"`
+	IsSelected      bool   `mapstructure:"is_selected"`
+}
+
+// GetClusterNodeDates to get the current time of each node, in ISO 8601 format with the time zone offset
+func GetClusterNodeDates(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]ClusterNodeDateGetDataModelONTAP, error) {
+	api := "cluster/nodes"
+	query := r.NewQuery()
+	query.Fields([]string{"name", "date"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cluster nodes time", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ClusterNodeDateGetDataModelONTAP
+	for _, info := range response {
+		var record ClusterNodeDateGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster nodes time: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// GetClusterNtpStatus to get the status of each NTP server, as seen by each node
+func GetClusterNtpStatus(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]ClusterNtpStatusGetDataModelONTAP, error) {
+	api := ntpStatusAPI
+	query := r.NewQuery()
+	query.Fields([]string{"node", "server", "is_peer_reachable", "is_selected"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading NTP status", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ClusterNtpStatusGetDataModelONTAP
+	for _, info := range response {
+		var record ClusterNtpStatusGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read NTP status: %#v", dataONTAP))
+	return dataONTAP, nil
+}
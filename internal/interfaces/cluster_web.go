@@ -0,0 +1,101 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ClusterWebGetDataModelONTAP describes the GET record data model using go types for mapping.
+// The cluster only settings are not returned for an SVM.
+type ClusterWebGetDataModelONTAP struct {
+	HTTPEnabled   bool            `mapstructure:"http_enabled,omitempty"`
+	HTTPPort      int64           `mapstructure:"http_port,omitempty"`
+	HTTPSPort     int64           `mapstructure:"https_port,omitempty"`
+	Certificate   WebCertificate  `mapstructure:"certificate"`
+	ClientEnabled bool            `mapstructure:"client_enabled"`
+	OcspEnabled   bool            `mapstructure:"ocsp_enabled"`
+	CSRF          *ClusterWebCSRF `mapstructure:"csrf,omitempty"`
+}
+
+// WebCertificate identifies the certificate of the web server.
+type WebCertificate struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// ClusterWebCSRF describes the cross-site request forgery protection.
+type ClusterWebCSRF struct {
+	ProtectionEnabled bool `mapstructure:"protection_enabled"`
+}
+
+// ClusterWebResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ClusterWebResourceBodyDataModelONTAP struct {
+	HTTPEnabled   *bool                    `mapstructure:"http_enabled,omitempty"`
+	HTTPPort      int64                    `mapstructure:"http_port,omitempty"`
+	HTTPSPort     int64                    `mapstructure:"https_port,omitempty"`
+	Certificate   *WebCertificate          `mapstructure:"certificate,omitempty"`
+	ClientEnabled *bool                    `mapstructure:"client_enabled,omitempty"`
+	OcspEnabled   *bool                    `mapstructure:"ocsp_enabled,omitempty"`
+	CSRF          *ClusterWebCSRFBodyONTAP `mapstructure:"csrf,omitempty"`
+}
+
+// ClusterWebCSRFBodyONTAP describes the cross-site request forgery protection settings to change.
+type ClusterWebCSRFBodyONTAP struct {
+	ProtectionEnabled *bool `mapstructure:"protection_enabled,omitempty"`
+}
+
+// clusterWebAPI returns the web API of the cluster, or of an SVM if svmUUID is set
+func clusterWebAPI(svmUUID string) string {
+	if svmUUID != "" {
+		return "svm/svms/" + svmUUID + "/web"
+	}
+	return "cluster/web"
+}
+
+// GetClusterWeb to get the web services settings of the cluster, or of an SVM if svmUUID is set
+func GetClusterWeb(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) (*ClusterWebGetDataModelONTAP, error) {
+	api := clusterWebAPI(svmUUID)
+	query := r.NewQuery()
+	fields := []string{"certificate", "client_enabled", "ocsp_enabled"}
+	if svmUUID == "" {
+		fields = append(fields, "http_enabled", "http_port", "https_port", "csrf.protection_enabled")
+	}
+	query.Fields(fields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading web services info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ClusterWebGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read web services: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateClusterWeb to change the web services settings of the cluster, or of an SVM if svmUUID is set
+// Changing the ports or HTTP enablement restarts the web services, so the request may be answered by a job
+func UpdateClusterWeb(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ClusterWebResourceBodyDataModelONTAP) error {
+	api := clusterWebAPI(svmUUID)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding web services body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating web services", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
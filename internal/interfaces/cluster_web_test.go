@@ -0,0 +1,141 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var clusterWebRecord = ClusterWebGetDataModelONTAP{
+	HTTPEnabled:   false,
+	HTTPPort:      80,
+	HTTPSPort:     443,
+	Certificate:   WebCertificate{Name: "cluster4_cert", UUID: "cert-uuid"},
+	ClientEnabled: false,
+	OcspEnabled:   true,
+	CSRF:          &ClusterWebCSRF{ProtectionEnabled: true},
+}
+
+var svmWebRecord = ClusterWebGetDataModelONTAP{
+	Certificate:   WebCertificate{Name: "svm1_cert", UUID: "cert-uuid"},
+	ClientEnabled: true,
+}
+
+var badClusterWebRecord = map[string]any{"http_port": "http"}
+
+func TestGetClusterWeb(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(clusterWebRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var svmRecordInterface map[string]any
+	err = mapstructure.Decode(svmWebRecord, &svmRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	svmRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{svmRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badClusterWebRecord}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/web", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/web", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_svm_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms/svm-uuid/web", StatusCode: 200, Response: svmRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/web", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/web", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		svmUUID   string
+		want      *ClusterWebGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &clusterWebRecord, wantErr: false},
+		{name: "test_svm_record", responses: responses["test_svm_record"], svmUUID: "svm-uuid", want: &svmWebRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetClusterWeb(errorHandler, *r, tt.svmUUID)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetClusterWeb() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterWeb() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateClusterWeb(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	enabled := true
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster/web", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_svm": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "svm/svms/svm-uuid/web", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_no_change": {},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "cluster/web", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		svmUUID   string
+		body      ClusterWebResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], body: ClusterWebResourceBodyDataModelONTAP{CSRF: &ClusterWebCSRFBodyONTAP{ProtectionEnabled: &enabled}}, wantErr: false},
+		{name: "test_update_svm", responses: responses["test_update_svm"], svmUUID: "svm-uuid", body: ClusterWebResourceBodyDataModelONTAP{Certificate: &WebCertificate{Name: "svm1_cert"}}, wantErr: false},
+		{name: "test_no_change", responses: responses["test_no_change"], body: ClusterWebResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], body: ClusterWebResourceBodyDataModelONTAP{HTTPEnabled: &enabled}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateClusterWeb(errorHandler, *r, tt.svmUUID, tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateClusterWeb() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
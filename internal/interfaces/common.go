@@ -1,7 +1,27 @@
 package interfaces
 
+import (
+	"github.com/mitchellh/mapstructure"
+)
+
 // NameDataModel is the standard name/uuid pair that required by most resources
 type NameDataModel struct {
 	Name string
 	UUID string
 }
+
+// encodeSliceOfStructs converts each struct of items to a map using the mapstructure tags.
+// When encoding a body, mapstructure.Decode converts the nested structs but leaves a slice of structs as is,
+// which would be sent with the Go field names. Such a slice is encoded with this function and set in the body map.
+// An empty, not nil, list is returned for no items, so that the list can be cleared.
+func encodeSliceOfStructs[T any](items []T) ([]map[string]interface{}, error) {
+	maps := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		var itemMap map[string]interface{}
+		if err := mapstructure.Decode(item, &itemMap); err != nil {
+			return nil, err
+		}
+		maps = append(maps, itemMap)
+	}
+	return maps, nil
+}
@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeSliceOfStructs(t *testing.T) {
+	type applyTo struct {
+		Files bool `mapstructure:"files"`
+	}
+	type entry struct {
+		Name    string   `mapstructure:"name"`
+		Comment string   `mapstructure:"comment,omitempty"`
+		ApplyTo *applyTo `mapstructure:"apply_to,omitempty"`
+	}
+	tests := []struct {
+		name  string
+		items []entry
+		want  []map[string]interface{}
+	}{
+		{name: "no items", items: nil, want: []map[string]interface{}{}},
+		{
+			name:  "tags and nested struct",
+			items: []entry{{Name: "one", ApplyTo: &applyTo{Files: true}}, {Name: "two", Comment: "second"}},
+			want: []map[string]interface{}{
+				{"name": "one", "apply_to": map[string]interface{}{"files": true}},
+				{"name": "two", "comment": "second"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeSliceOfStructs(tt.items)
+			if err != nil {
+				t.Fatalf("encodeSliceOfStructs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("encodeSliceOfStructs() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// BroadcastDomainGetDataModelONTAP describes the GET record data model using go types for mapping.
+type BroadcastDomainGetDataModelONTAP struct {
+	Name    string                `mapstructure:"name"`
+	UUID    string                `mapstructure:"uuid"`
+	Ipspace NameDataModel         `mapstructure:"ipspace"`
+	MTU     int64                 `mapstructure:"mtu"`
+	Ports   []BroadcastDomainPort `mapstructure:"ports"`
+}
+
+// BroadcastDomainPort describes a member port of a broadcast domain.
+type BroadcastDomainPort struct {
+	Name string        `mapstructure:"name"`
+	Node NameDataModel `mapstructure:"node"`
+}
+
+// BroadcastDomainDataSourceFilterModel describes the data source filter model.
+type BroadcastDomainDataSourceFilterModel struct {
+	Name        string `mapstructure:"name,omitempty"`
+	IpspaceName string `mapstructure:"ipspace.name,omitempty"`
+}
+
+var broadcastDomainFields = []string{"name", "uuid", "ipspace.name", "mtu", "ports.name", "ports.node.name"}
+
+// GetBroadcastDomainByName to get a broadcast domain by name, the name is unique within an IPspace
+func GetBroadcastDomainByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, ipspaceName string) (*BroadcastDomainGetDataModelONTAP, error) {
+	api := "network/ethernet/broadcast-domains"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("ipspace.name", ipspaceName)
+	query.Fields(broadcastDomainFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no broadcast domain %s found in ipspace %s", name, ipspaceName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading broadcast_domain info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP BroadcastDomainGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read broadcast_domain data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListBroadcastDomains to get the broadcast domains matching a filter
+func GetListBroadcastDomains(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *BroadcastDomainDataSourceFilterModel) ([]BroadcastDomainGetDataModelONTAP, error) {
+	api := "network/ethernet/broadcast-domains"
+	query := r.NewQuery()
+	query.Fields(broadcastDomainFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding broadcast_domain filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading broadcast_domain info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []BroadcastDomainGetDataModelONTAP
+	for _, info := range response {
+		var record BroadcastDomainGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read broadcast_domain data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EthernetPortGetDataModelONTAP describes the GET record data model using go types for mapping.
+type EthernetPortGetDataModelONTAP struct {
+	UUID            string                      `mapstructure:"uuid"`
+	Name            string                      `mapstructure:"name"`
+	Node            NameDataModel               `mapstructure:"node"`
+	Type            string                      `mapstructure:"type"`
+	State           string                      `mapstructure:"state"`
+	Enabled         bool                        `mapstructure:"enabled"`
+	MTU             int64                       `mapstructure:"mtu"`
+	Speed           int64                       `mapstructure:"speed"`
+	MacAddress      string                      `mapstructure:"mac_address"`
+	BroadcastDomain EthernetPortBroadcastDomain `mapstructure:"broadcast_domain"`
+	Vlan            EthernetPortVlan            `mapstructure:"vlan"`
+}
+
+// EthernetPortBroadcastDomain describes the broadcast domain a port belongs to.
+type EthernetPortBroadcastDomain struct {
+	Name    string        `mapstructure:"name"`
+	Ipspace NameDataModel `mapstructure:"ipspace"`
+}
+
+// EthernetPortVlan describes the VLAN tag and base port of a vlan port.
+type EthernetPortVlan struct {
+	Tag      int64         `mapstructure:"tag"`
+	BasePort NameDataModel `mapstructure:"base_port"`
+}
+
+// EthernetPortDataSourceFilterModel describes the data source filter model.
+type EthernetPortDataSourceFilterModel struct {
+	Name     string `mapstructure:"name,omitempty"`
+	NodeName string `mapstructure:"node.name,omitempty"`
+	Type     string `mapstructure:"type,omitempty"`
+}
+
+// GetListEthernetPorts to get ethernet_port info for all ports matching a filter
+func GetListEthernetPorts(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *EthernetPortDataSourceFilterModel) ([]EthernetPortGetDataModelONTAP, error) {
+	api := "network/ethernet/ports"
+	query := r.NewQuery()
+	query.Fields([]string{"uuid", "name", "node.name", "type", "state", "enabled", "mtu", "speed", "mac_address",
+		"broadcast_domain.name", "broadcast_domain.ipspace.name", "vlan.tag", "vlan.base_port.name"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding ethernet_port filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ethernet_port info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []EthernetPortGetDataModelONTAP
+	for _, info := range response {
+		var record EthernetPortGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ethernet_port data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var ethernetPortRecord = EthernetPortGetDataModelONTAP{
+	UUID:       "port-uuid",
+	Name:       "e0c-100",
+	Node:       NameDataModel{Name: "node1", UUID: "node1-uuid"},
+	Type:       "vlan",
+	State:      "up",
+	Enabled:    true,
+	MTU:        1500,
+	Speed:      1000,
+	MacAddress: "00:50:56:b3:1a:2b",
+	BroadcastDomain: EthernetPortBroadcastDomain{
+		Name:    "Default",
+		Ipspace: NameDataModel{Name: "Default"},
+	},
+	Vlan: EthernetPortVlan{
+		Tag:      100,
+		BasePort: NameDataModel{Name: "e0c"},
+	},
+}
+
+var badEthernetPortRecord = struct{ Name int }{123}
+
+func TestGetListEthernetPorts(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(ethernetPortRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badEthernetPortRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []EthernetPortGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []EthernetPortGetDataModelONTAP{ethernetPortRecord, ethernetPortRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListEthernetPorts(errorHandler, *r, &EthernetPortDataSourceFilterModel{NodeName: "node1", Type: "vlan"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListEthernetPorts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListEthernetPorts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
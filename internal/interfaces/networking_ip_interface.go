@@ -11,12 +11,14 @@ import (
 
 // IPInterfaceGetDataModelONTAP describes the GET record data model using go types for mapping.
 type IPInterfaceGetDataModelONTAP struct {
-	Name     string                      `mapstructure:"name"`
-	Scope    string                      `mapstructure:"scope"`
-	SVM      IPInterfaceSvmName          `mapstructure:"svm"`
-	UUID     string                      `mapstructure:"uuid"`
-	IP       IPInterfaceGetIP            `mapstructure:"ip"`
-	Location IPInterfaceResourceLocation `mapstructure:"location"`
+	Name          string                      `mapstructure:"name"`
+	Scope         string                      `mapstructure:"scope"`
+	SVM           IPInterfaceSvmName          `mapstructure:"svm"`
+	UUID          string                      `mapstructure:"uuid"`
+	IP            IPInterfaceGetIP            `mapstructure:"ip"`
+	Location      IPInterfaceResourceLocation `mapstructure:"location"`
+	IPSpace       NameDataModel               `mapstructure:"ipspace"`
+	ServicePolicy NameDataModel               `mapstructure:"service_policy"`
 }
 
 // IPInterfaceGetIP describes the GET record data for IP.
@@ -31,6 +33,14 @@ type IPInterfaceResourceBodyDataModelONTAP struct {
 	SVM      IPInterfaceSvmName          `mapstructure:"svm,omitempty"` // API errors if body contains svm name when updating. can not use universal 'svm struct'
 	IP       IPInterfaceResourceIP       `mapstructure:"ip"`
 	Location IPInterfaceResourceLocation `mapstructure:"location"`
+	// cluster scoped interfaces, such as node management interfaces, use an ipspace rather than a svm
+	IPSpace       *IPInterfaceResourceName `mapstructure:"ipspace,omitempty"`
+	ServicePolicy *IPInterfaceResourceName `mapstructure:"service_policy,omitempty"`
+}
+
+// IPInterfaceResourceName is the body data model for fields referenced by name, eg ipspace or service_policy
+type IPInterfaceResourceName struct {
+	Name string `mapstructure:"name"`
 }
 
 // IPInterfaceSvmName describes the svm name specifcally for network ip interface.
@@ -48,6 +58,11 @@ type IPInterfaceResourceIP struct {
 type IPInterfaceResourceLocation struct {
 	HomeNode IPInterfaceResourceHomeNode `mapstructure:"home_node,omitempty"`
 	HomePort IPInterfaceResourceHomePort `mapstructure:"home_port,omitempty"`
+	// failover scope, eg home_port_only, default, home_node_only, sfo_partners_only, broadcast_domain_only
+	Failover   string `mapstructure:"failover,omitempty"`
+	AutoRevert *bool  `mapstructure:"auto_revert,omitempty"`
+	// Port is the current port, it differs from the home port after a failover or a migration. It is read only.
+	Port *IPInterfaceResourceHomePort `mapstructure:"port,omitempty"`
 }
 
 // IPInterfaceResourceHomeNode is the body data model for home_node field
@@ -78,7 +93,7 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, i
 	// 	query.Set("svm.name", svmName)
 	// 	query.Set("scope", "svm")
 	// }
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields([]string{"name", "svm.name", "ip", "scope", "location", "location.failover", "location.auto_revert", "location.port", "ipspace.name", "service_policy.name"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -107,7 +122,7 @@ func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestCli
 		query.Set("svm.name", svmName)
 		query.Set("scope", "svm")
 	}
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields([]string{"name", "svm.name", "ip", "scope", "location", "location.failover", "location.auto_revert", "location.port", "ipspace.name", "service_policy.name"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -129,7 +144,7 @@ func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestCli
 func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IPInterfaceDataSourceFilterModel) ([]IPInterfaceGetDataModelONTAP, error) {
 	api := "network/ip/interfaces"
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields([]string{"name", "svm.name", "ip", "scope", "location", "location.failover", "location.auto_revert", "location.port", "ipspace.name", "service_policy.name"})
 
 	if filter != nil {
 		if filter.Name != "" {
@@ -171,6 +186,10 @@ func CreateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	if err := mapstructure.Decode(body, &bodyMap); err != nil {
 		return nil, errorHandler.MakeAndReportError("error encoding ip_interface body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
 	}
+	if body.SVM.Name == "" {
+		// cluster scoped interface, omitempty does not apply to structs
+		delete(bodyMap, "svm")
+	}
 	query := r.NewQuery()
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
@@ -200,6 +219,24 @@ func UpdateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	return nil
 }
 
+// MigrateIPInterface moves ip_interface to a port, eg to revert it to its home port
+func MigrateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, node string, port string) error {
+	api := fmt.Sprintf("network/ip/interfaces/%s", id)
+	body := map[string]interface{}{
+		"location": map[string]interface{}{
+			"port": map[string]interface{}{
+				"name": port,
+				"node": map[string]interface{}{"name": node},
+			},
+		},
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error migrating ip_interface", fmt.Sprintf("error on PATCH %s to port %s on node %s: %s, statusCode %d", api, port, node, err, statusCode))
+	}
+	return nil
+}
+
 // DeleteIPInterface to delete ip_interface
 func DeleteIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	api := "network/ip/interfaces"
@@ -34,9 +34,19 @@ var ipInterfaceRecord = IPInterfaceGetDataModelONTAP{
 				Name: "string",
 			},
 		},
+		Failover:   "broadcast_domain_only",
+		AutoRevert: &ipInterfaceAutoRevert,
+		Port: &IPInterfaceResourceHomePort{
+			Name: "string",
+			Node: IPInterfaceResourceHomeNode{
+				Name: "string",
+			},
+		},
 	},
 }
 
+var ipInterfaceAutoRevert = true
+
 // create network ip interface body
 var basicNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
 	Name: "string",
@@ -359,3 +369,37 @@ func TestUpdateIPInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateIPInterface(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_migrate_network_ip_interface": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ip/interfaces/12884901889", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_migrate_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ip/interfaces/12884901889", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_migrate_network_ip_interface", responses: responses["test_migrate_network_ip_interface"], wantErr: false},
+		{name: "test_migrate_error_1", responses: responses["test_migrate_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = MigrateIPInterface(errorHandler, *r, "12884901889", "node1", "e0d")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MigrateIPInterface() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
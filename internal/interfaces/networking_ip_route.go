@@ -40,10 +40,14 @@ type IPRouteDataSourceFilterModel struct {
 }
 
 // GetIPRoute to get net_route info
-func GetIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, Destination string, svmName string, Gateway string, version versionModelONTAP) (*IPRouteGetDataModelONTAP, error) {
+// netmask is optional, it is needed to tell apart routes to the same address with different netmasks
+func GetIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, Destination string, netmask string, svmName string, Gateway string, version versionModelONTAP) (*IPRouteGetDataModelONTAP, error) {
 	api := "/network/ip/routes"
 	query := r.NewQuery()
 	query.Set("destination.address", Destination)
+	if netmask != "" {
+		query.Set("destination.netmask", utils.NetmaskLength(netmask))
+	}
 	query.Set("gateway", Gateway)
 	if svmName == "" {
 		query.Set("scope", "cluster")
@@ -51,10 +55,7 @@ func GetIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, Desti
 		query.Set("svm.name", svmName)
 		query.Set("scope", "svm")
 	}
-	var fields = []string{"destination", "svm.name", "gateway", "scope"}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "metric")
-	}
+	fields := FieldsForVersion("network/ip/routes", version, []string{"destination", "svm.name", "gateway", "scope"})
 	query.Fields(fields)
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
@@ -73,20 +74,58 @@ func GetIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, Desti
 	return &dataONTAP, nil
 }
 
+// GetIPRouteByUUID to get net_route info, returns nil if the route does not exist
+func GetIPRouteByUUID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, version versionModelONTAP) (*IPRouteGetDataModelONTAP, error) {
+	api := "network/ip/routes/" + uuid
+	query := r.NewQuery()
+	fields := FieldsForVersion("network/ip/routes", version, []string{"destination", "svm.name", "gateway", "scope"})
+	query.Fields(fields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("route %s not found", uuid))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading /network/ip/routes info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP IPRouteGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read /network/ip/routes/%s: %#v", uuid, dataONTAP))
+	return &dataONTAP, nil
+}
+
 // GetListIPRoutes to get net_route info for all resources matching a filter
 func GetListIPRoutes(errorHandler *utils.ErrorHandler, r restclient.RestClient, gateway string, filter *IPRouteDataSourceFilterModel, version versionModelONTAP) ([]IPRouteGetDataModelONTAP, error) {
 	api := "/network/ip/routes"
 	query := r.NewQuery()
 
+	// only set the filters with a value, an empty value would not match any route
 	if filter != nil {
-		query.Set("gateway", filter.Gateway)
-		query.Set("svm.name", filter.SVMName)
+		if filter.Gateway != "" {
+			query.Set("gateway", filter.Gateway)
+		}
+		if filter.SVMName != "" {
+			query.Set("svm.name", filter.SVMName)
+		}
+		if filter.Destination.Address != "" {
+			query.Set("destination.address", filter.Destination.Address)
+		}
+		if filter.Destination.Netmask != "" {
+			query.Set("destination.netmask", filter.Destination.Netmask)
+		}
 	}
-
-	var fields = []string{"destination", "gateway"}
-	if version.Generation == 9 && version.Major > 11 {
-		fields = append(fields, "metric")
+	if gateway != "" && query.Get("gateway") == "" {
+		query.Set("gateway", gateway)
 	}
+
+	fields := FieldsForVersion(ipRoutesCollection, version, []string{"destination", "gateway", "svm.name"})
 	query.Fields(fields)
 
 	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
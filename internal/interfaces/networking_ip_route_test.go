@@ -81,7 +81,7 @@ func TestGetIPRoute(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetIPRoute(errorHandler, *r, "destination", "svmName", "gateway", versionModelONTAP{Generation: tt.gen, Major: tt.maj})
+			got, err := GetIPRoute(errorHandler, *r, "destination", "", "svmName", "gateway", versionModelONTAP{Generation: tt.gen, Major: tt.maj})
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -96,6 +96,54 @@ func TestGetIPRoute(t *testing.T) {
 	}
 }
 
+func TestGetIPRouteByUUID(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(ipRouteRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	notFound := &restclient.ONTAPError{RestError: restclient.RestError{Code: "4", Message: "entry doesn't exist"}, StatusCode: 404}
+	responses := map[string][]restclient.MockResponse{
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/routes/1234", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_not_found": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/routes/1234", StatusCode: 404, Response: restclient.RestResponse{}, Err: notFound},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/routes/1234", StatusCode: 500, Response: restclient.RestResponse{}, Err: errors.New("generic error for UT")},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *IPRouteGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &ipRouteRecord, wantErr: false},
+		{name: "test_not_found", responses: responses["test_not_found"], want: nil, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetIPRouteByUUID(errorHandler, *r, "1234", versionModelONTAP{Generation: 9, Major: 11})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetIPRouteByUUID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetIPRouteByUUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetListIPRoutes(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 	var recordInterface map[string]any
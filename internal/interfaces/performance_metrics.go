@@ -0,0 +1,77 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// PerformanceMetricGetDataModelONTAP describes a performance sample, as returned by the metrics endpoints.
+type PerformanceMetricGetDataModelONTAP struct {
+	Timestamp  string                  `mapstructure:"timestamp"`
+	Duration   string                  `mapstructure:"duration"`
+	Status     string                  `mapstructure:"status"`
+	Latency    PerformanceMetricValues `mapstructure:"latency"`
+	IOPS       PerformanceMetricValues `mapstructure:"iops"`
+	Throughput PerformanceMetricValues `mapstructure:"throughput"`
+}
+
+// PerformanceMetricValues describes the read, write, other and total values of a performance counter.
+type PerformanceMetricValues struct {
+	Read  int64 `mapstructure:"read"`
+	Write int64 `mapstructure:"write"`
+	Other int64 `mapstructure:"other"`
+	Total int64 `mapstructure:"total"`
+}
+
+// svmProtocolMetricsAPIs maps a protocol to the service collection exposing its SVM metrics.
+var svmProtocolMetricsAPIs = map[string]string{
+	"cifs":  "protocols/cifs/services",
+	"fcp":   "protocols/san/fcp/services",
+	"iscsi": "protocols/san/iscsi/services",
+	"nvme":  "protocols/nvme/services",
+}
+
+// GetStorageVolumeMetrics to get the performance samples of a volume over interval (1h, 1d, 1w, 1m, or 1y)
+func GetStorageVolumeMetrics(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, interval string) ([]PerformanceMetricGetDataModelONTAP, error) {
+	return getPerformanceMetrics(errorHandler, r, "storage/volumes/"+uuid+"/metrics", interval)
+}
+
+// GetStorageLunMetrics to get the performance samples of a LUN over interval (1h, 1d, 1w, 1m, or 1y)
+func GetStorageLunMetrics(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, interval string) ([]PerformanceMetricGetDataModelONTAP, error) {
+	return getPerformanceMetrics(errorHandler, r, "storage/luns/"+uuid+"/metrics", interval)
+}
+
+// GetSvmProtocolMetrics to get the performance samples of a protocol service (cifs, fcp, iscsi, or nvme) of a SVM over interval
+func GetSvmProtocolMetrics(errorHandler *utils.ErrorHandler, r restclient.RestClient, protocol string, svmUUID string, interval string) ([]PerformanceMetricGetDataModelONTAP, error) {
+	api, ok := svmProtocolMetricsAPIs[protocol]
+	if !ok {
+		return nil, errorHandler.MakeAndReportError("error reading svm metrics", fmt.Sprintf("unsupported protocol %s", protocol))
+	}
+	return getPerformanceMetrics(errorHandler, r, api+"/"+svmUUID+"/metrics", interval)
+}
+
+func getPerformanceMetrics(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, interval string) ([]PerformanceMetricGetDataModelONTAP, error) {
+	query := r.NewQuery()
+	query.Set("interval", interval)
+	query.Fields([]string{"timestamp", "duration", "status", "latency", "iops", "throughput"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading performance metrics", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []PerformanceMetricGetDataModelONTAP
+	for _, info := range response {
+		var record PerformanceMetricGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read performance metrics from %s: %d samples", api, len(dataONTAP)))
+	return dataONTAP, nil
+}
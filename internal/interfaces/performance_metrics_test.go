@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var performanceMetricRecord = PerformanceMetricGetDataModelONTAP{
+	Timestamp:  "2024-03-01T10:15:00Z",
+	Duration:   "PT15S",
+	Status:     "ok",
+	Latency:    PerformanceMetricValues{Read: 150, Write: 250, Other: 50, Total: 200},
+	IOPS:       PerformanceMetricValues{Read: 1000, Write: 500, Other: 10, Total: 1510},
+	Throughput: PerformanceMetricValues{Read: 4096000, Write: 2048000, Other: 0, Total: 6144000},
+}
+
+func TestGetStorageVolumeMetrics(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(performanceMetricRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"latency": "slow"}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "storage/volumes/volume-uuid/metrics"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []PerformanceMetricGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []PerformanceMetricGetDataModelONTAP{performanceMetricRecord, performanceMetricRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageVolumeMetrics(errorHandler, *r, "volume-uuid", "1h")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageVolumeMetrics() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageVolumeMetrics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSvmProtocolMetrics(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(performanceMetricRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_iscsi": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services/svm-uuid/metrics", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_cifs": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services/svm-uuid/metrics", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_unsupported_protocol": {},
+	}
+	tests := []struct {
+		name      string
+		protocol  string
+		responses []restclient.MockResponse
+		want      []PerformanceMetricGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_iscsi", protocol: "iscsi", responses: responses["test_iscsi"], want: []PerformanceMetricGetDataModelONTAP{performanceMetricRecord}, wantErr: false},
+		{name: "test_cifs", protocol: "cifs", responses: responses["test_cifs"], want: []PerformanceMetricGetDataModelONTAP{performanceMetricRecord}, wantErr: false},
+		{name: "test_unsupported_protocol", protocol: "nfs", responses: responses["test_unsupported_protocol"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSvmProtocolMetrics(errorHandler, *r, tt.protocol, "svm-uuid", "1d")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSvmProtocolMetrics() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSvmProtocolMetrics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
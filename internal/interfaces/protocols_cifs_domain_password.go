@@ -0,0 +1,118 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// CifsDomainPasswordGetDataModelONTAP describes the GET record data model using go types for mapping.
+type CifsDomainPasswordGetDataModelONTAP struct {
+	Name     string                     `mapstructure:"name"`
+	SVM      SvmDataModelONTAP          `mapstructure:"svm"`
+	ADDomain CifsDomainPasswordADDomain `mapstructure:"ad_domain"`
+}
+
+// CifsDomainPasswordADDomain describes the machine account settings of the CIFS server.
+type CifsDomainPasswordADDomain struct {
+	FQDN             string                     `mapstructure:"fqdn,omitempty"`
+	PasswordSchedule CifsDomainPasswordSchedule `mapstructure:"password_schedule"`
+}
+
+// CifsDomainPasswordSchedule describes the automatic machine account password change schedule.
+type CifsDomainPasswordSchedule struct {
+	ScheduleEnabled            bool  `mapstructure:"schedule_enabled"`
+	ScheduleWeeklyInterval     int64 `mapstructure:"schedule_weekly_interval,omitempty"`
+	ScheduleRandomizedInterval int64 `mapstructure:"schedule_randomized_interval,omitempty"`
+}
+
+// CifsDomainPasswordScheduleBodyDataModelONTAP describes the body data model to modify the schedule.
+type CifsDomainPasswordScheduleBodyDataModelONTAP struct {
+	ADDomain struct {
+		PasswordSchedule CifsDomainPasswordScheduleBody `mapstructure:"password_schedule"`
+	} `mapstructure:"ad_domain"`
+}
+
+// CifsDomainPasswordScheduleBody only includes the schedule settings to modify.
+type CifsDomainPasswordScheduleBody struct {
+	ScheduleEnabled            *bool  `mapstructure:"schedule_enabled,omitempty"`
+	ScheduleWeeklyInterval     *int64 `mapstructure:"schedule_weekly_interval,omitempty"`
+	ScheduleRandomizedInterval *int64 `mapstructure:"schedule_randomized_interval,omitempty"`
+}
+
+// CifsDomainPasswordResetBodyDataModelONTAP describes the body data model to reset the machine account password.
+// The credentials are only required when resetting, rather than changing, the password.
+type CifsDomainPasswordResetBodyDataModelONTAP struct {
+	ADDomain struct {
+		User     string `mapstructure:"user,omitempty"`
+		Password string `mapstructure:"password,omitempty"`
+	} `mapstructure:"ad_domain"`
+}
+
+// GetCifsDomainPassword to get the machine account password schedule of the CIFS server of a svm
+func GetCifsDomainPassword(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*CifsDomainPasswordGetDataModelONTAP, error) {
+	api := "protocols/cifs/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "svm.name", "svm.uuid", "ad_domain.fqdn", "ad_domain.password_schedule"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no CIFS server found for svm %s", svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cifs_domain_password info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP CifsDomainPasswordGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cifs_domain_password: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateCifsDomainPasswordSchedule to modify the machine account password schedule
+func UpdateCifsDomainPasswordSchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, schedule CifsDomainPasswordScheduleBody, svmUUID string) error {
+	api := "protocols/cifs/services/" + svmUUID
+	var body CifsDomainPasswordScheduleBodyDataModelONTAP
+	body.ADDomain.PasswordSchedule = schedule
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding cifs_domain_password body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating cifs_domain_password schedule", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// ResetCifsDomainPassword to change the machine account password now.
+// Without credentials, the password is changed using the current machine account.
+// With credentials, the password is reset, which is needed when the machine account password is no longer valid.
+func ResetCifsDomainPassword(errorHandler *utils.ErrorHandler, r restclient.RestClient, user string, password string, svmUUID string) error {
+	api := "protocols/cifs/services/" + svmUUID
+	var body CifsDomainPasswordResetBodyDataModelONTAP
+	body.ADDomain.User = user
+	body.ADDomain.Password = password
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		// do not log the body, it contains the password
+		return errorHandler.MakeAndReportError("error encoding cifs_domain_password body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	query := r.NewQuery()
+	if user == "" {
+		query.Add("password_change", "true")
+	} else {
+		query.Add("password_reset", "true")
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, query, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error resetting cifs_domain_password", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var cifsDomainPasswordRecord = CifsDomainPasswordGetDataModelONTAP{
+	Name: "CIFS1",
+	SVM:  SvmDataModelONTAP{Name: "svm1", UUID: "svm1-uuid"},
+	ADDomain: CifsDomainPasswordADDomain{
+		FQDN:             "example.com",
+		PasswordSchedule: CifsDomainPasswordSchedule{ScheduleEnabled: true, ScheduleWeeklyInterval: 4, ScheduleRandomizedInterval: 120},
+	},
+}
+
+var badCifsDomainPasswordRecord = struct{ Name int }{123}
+
+func TestGetCifsDomainPassword(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsDomainPasswordRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badCifsDomainPasswordRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *CifsDomainPasswordGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &cifsDomainPasswordRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetCifsDomainPassword(errorHandler, *r, "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCifsDomainPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCifsDomainPassword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResetCifsDomainPassword(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_change": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/services/svm1-uuid", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_reset_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/services/svm1-uuid", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		user      string
+		password  string
+		wantErr   bool
+	}{
+		{name: "test_change", responses: responses["test_change"], wantErr: false},
+		{name: "test_reset_error", responses: responses["test_reset_error"], user: "admin", password: "secret", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = ResetCifsDomainPassword(errorHandler, *r, tt.user, tt.password, "svm1-uuid")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResetCifsDomainPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
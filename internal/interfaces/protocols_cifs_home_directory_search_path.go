@@ -0,0 +1,83 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP struct {
+	Index int64             `mapstructure:"index"`
+	Path  string            `mapstructure:"path"`
+	SVM   SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsCIFSHomeDirectorySearchPathResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ProtocolsCIFSHomeDirectorySearchPathResourceBodyDataModelONTAP struct {
+	Path  string            `mapstructure:"path"`
+	Index int64             `mapstructure:"index,omitempty"`
+	SVM   SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// GetProtocolsCIFSHomeDirectorySearchPath to get a home directory search path by path, returns nil if it does not exist
+func GetProtocolsCIFSHomeDirectorySearchPath(errorHandler *utils.ErrorHandler, r restclient.RestClient, path string, svmName string) (*ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP, error) {
+	api := "protocols/cifs/home-directory/search-paths"
+	query := r.NewQuery()
+	query.Set("path", path)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"index", "path", "svm.name", "svm.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading home directory search path info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read home directory search path: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsCIFSHomeDirectorySearchPath to add a home directory search path, at the end of the list unless index is set
+func CreateProtocolsCIFSHomeDirectorySearchPath(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsCIFSHomeDirectorySearchPathResourceBodyDataModelONTAP) error {
+	api := "protocols/cifs/home-directory/search-paths"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding home directory search path body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating home directory search path", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsCIFSHomeDirectorySearchPathIndex to move a home directory search path to a new position in the list
+func UpdateProtocolsCIFSHomeDirectorySearchPathIndex(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, index int64, newIndex int64) error {
+	api := fmt.Sprintf("protocols/cifs/home-directory/search-paths/%s/%d", svmUUID, index)
+	body := map[string]interface{}{"new_index": newIndex}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating home directory search path", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsCIFSHomeDirectorySearchPath to remove a home directory search path, the following paths move up in the list
+func DeleteProtocolsCIFSHomeDirectorySearchPath(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, index int64) error {
+	api := fmt.Sprintf("protocols/cifs/home-directory/search-paths/%s/%d", svmUUID, index)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting home directory search path", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
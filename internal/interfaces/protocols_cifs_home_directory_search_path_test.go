@@ -0,0 +1,153 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var homeDirectorySearchPathRecord = ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP{
+	Index: 1,
+	Path:  "/home1",
+	SVM:   SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+}
+
+var badHomeDirectorySearchPathRecord = struct{ Path int }{123}
+
+func TestGetProtocolsCIFSHomeDirectorySearchPath(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(homeDirectorySearchPathRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badHomeDirectorySearchPathRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "protocols/cifs/home-directory/search-paths"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsCIFSHomeDirectorySearchPathGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &homeDirectorySearchPathRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCIFSHomeDirectorySearchPath(errorHandler, *r, "/home1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCIFSHomeDirectorySearchPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCIFSHomeDirectorySearchPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsCIFSHomeDirectorySearchPathIndex(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "protocols/cifs/home-directory/search-paths/svm-uuid/2"
+	responses := map[string][]restclient.MockResponse{
+		"test_move": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_move_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_move", responses: responses["test_move"], wantErr: false},
+		{name: "test_move_error", responses: responses["test_move_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsCIFSHomeDirectorySearchPathIndex(errorHandler, *r, "svm-uuid", 2, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsCIFSHomeDirectorySearchPathIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteProtocolsCIFSHomeDirectorySearchPath(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "protocols/cifs/home-directory/search-paths/svm-uuid/1"
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_delete_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_delete_error", responses: responses["test_delete_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteProtocolsCIFSHomeDirectorySearchPath(errorHandler, *r, "svm-uuid", 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteProtocolsCIFSHomeDirectorySearchPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
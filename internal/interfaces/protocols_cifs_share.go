@@ -0,0 +1,77 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsCIFSShareGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsCIFSShareGetDataModelONTAP struct {
+	Name                   string                  `mapstructure:"name"`
+	SVM                    SvmDataModelONTAP       `mapstructure:"svm"`
+	Path                   string                  `mapstructure:"path"`
+	Comment                string                  `mapstructure:"comment"`
+	Volume                 NameDataModel           `mapstructure:"volume"`
+	AccessBasedEnumeration bool                    `mapstructure:"access_based_enumeration"`
+	ChangeNotify           bool                    `mapstructure:"change_notify"`
+	ContinuouslyAvailable  bool                    `mapstructure:"continuously_available"`
+	Encryption             bool                    `mapstructure:"encryption"`
+	HomeDirectory          bool                    `mapstructure:"home_directory"`
+	Oplocks                bool                    `mapstructure:"oplocks"`
+	ShowSnapshot           bool                    `mapstructure:"show_snapshot"`
+	OfflineFiles           string                  `mapstructure:"offline_files"`
+	UnixSymlink            string                  `mapstructure:"unix_symlink"`
+	ACLs                   []ProtocolsCIFSShareACL `mapstructure:"acls"`
+}
+
+// ProtocolsCIFSShareACL describes an access control entry of a share.
+type ProtocolsCIFSShareACL struct {
+	UserOrGroup string `mapstructure:"user_or_group"`
+	Permission  string `mapstructure:"permission"`
+	Type        string `mapstructure:"type"`
+}
+
+// ProtocolsCIFSShareDataSourceFilterModel describes the data source filter model.
+type ProtocolsCIFSShareDataSourceFilterModel struct {
+	Name    string `mapstructure:"name,omitempty"`
+	SVMName string `mapstructure:"svm.name,omitempty"`
+	Path    string `mapstructure:"path,omitempty"`
+}
+
+// GetListProtocolsCIFSShares to get the CIFS shares matching a filter
+func GetListProtocolsCIFSShares(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsCIFSShareDataSourceFilterModel) ([]ProtocolsCIFSShareGetDataModelONTAP, error) {
+	api := "protocols/cifs/shares"
+	query := r.NewQuery()
+	query.Fields([]string{"name", "svm.name", "svm.uuid", "path", "comment", "volume.name", "volume.uuid", "access_based_enumeration", "change_notify",
+		"continuously_available", "encryption", "home_directory", "oplocks", "show_snapshot", "offline_files", "unix_symlink", "acls"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding cifs share filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading cifs share info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsCIFSShareGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsCIFSShareGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cifs shares data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
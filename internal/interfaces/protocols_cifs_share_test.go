@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var protocolsCIFSShareRecord = ProtocolsCIFSShareGetDataModelONTAP{
+	Name:                  "share1",
+	SVM:                   SvmDataModelONTAP{Name: "svm1", UUID: "svm1-uuid"},
+	Path:                  "/vol1/share1",
+	Comment:               "project share",
+	Volume:                NameDataModel{Name: "vol1", UUID: "vol1-uuid"},
+	ChangeNotify:          true,
+	ContinuouslyAvailable: false,
+	Oplocks:               true,
+	ShowSnapshot:          true,
+	OfflineFiles:          "manual",
+	UnixSymlink:           "local",
+	ACLs: []ProtocolsCIFSShareACL{
+		{UserOrGroup: "Everyone", Permission: "full_control", Type: "windows"},
+	},
+}
+
+var badProtocolsCIFSShareRecord = struct{ Path int }{123}
+
+func TestGetListProtocolsCIFSShares(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(protocolsCIFSShareRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badProtocolsCIFSShareRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []ProtocolsCIFSShareGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []ProtocolsCIFSShareGetDataModelONTAP{protocolsCIFSShareRecord, protocolsCIFSShareRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListProtocolsCIFSShares(errorHandler, *r, &ProtocolsCIFSShareDataSourceFilterModel{SVMName: "svm1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListProtocolsCIFSShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListProtocolsCIFSShares() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
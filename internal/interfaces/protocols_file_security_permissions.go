@@ -0,0 +1,167 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// FileSecurityPermissionsGetDataModelONTAP describes the GET record data model using go types for mapping.
+type FileSecurityPermissionsGetDataModelONTAP struct {
+	Owner string                       `mapstructure:"owner"`
+	Group string                       `mapstructure:"group"`
+	ACLs  []FileSecurityPermissionsACL `mapstructure:"acls"`
+}
+
+// FileSecurityPermissionsACL describes an access control entry of the DACL.
+type FileSecurityPermissionsACL struct {
+	User      string                          `mapstructure:"user"`
+	Access    string                          `mapstructure:"access"`
+	Rights    string                          `mapstructure:"rights,omitempty"`
+	ApplyTo   *FileSecurityPermissionsApplyTo `mapstructure:"apply_to,omitempty"`
+	Inherited bool                            `mapstructure:"inherited,omitempty"`
+}
+
+// FileSecurityPermissionsApplyTo describes where an access control entry is propagated.
+type FileSecurityPermissionsApplyTo struct {
+	Files      bool `mapstructure:"files"`
+	SubFolders bool `mapstructure:"sub_folders"`
+	ThisFolder bool `mapstructure:"this_folder"`
+}
+
+// FileSecurityPermissionsResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type FileSecurityPermissionsResourceBodyDataModelONTAP struct {
+	Owner           string                       `mapstructure:"owner,omitempty"`
+	Group           string                       `mapstructure:"group,omitempty"`
+	PropagationMode string                       `mapstructure:"propagation_mode,omitempty"`
+	ACLs            []FileSecurityPermissionsACL `mapstructure:"acls,omitempty"`
+}
+
+// fileSecurityPermissionsAPI returns the API for a path, the path is encoded as a single segment, eg %2Fvol1%2Fdir1
+func fileSecurityPermissionsAPI(svmUUID string, path string) string {
+	return "protocols/file-security/permissions/" + svmUUID + "/" + url.PathEscape(path)
+}
+
+// GetFileSecurityPermissions to get the owner, group, and DACL of a path
+func GetFileSecurityPermissions(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string) (*FileSecurityPermissionsGetDataModelONTAP, error) {
+	api := fileSecurityPermissionsAPI(svmUUID, path)
+	query := r.NewQuery()
+	query.Fields([]string{"owner", "group", "acls"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading file security permissions", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP FileSecurityPermissionsGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read file security permissions: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// encodeFileSecurityPermissionsBody converts the body, including the ACL entries, to a map
+func encodeFileSecurityPermissionsBody(body FileSecurityPermissionsResourceBodyDataModelONTAP) (map[string]interface{}, error) {
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, err
+	}
+	if len(body.ACLs) > 0 {
+		acls, err := encodeSliceOfStructs(body.ACLs)
+		if err != nil {
+			return nil, err
+		}
+		bodyMap["acls"] = acls
+	}
+	return bodyMap, nil
+}
+
+// CreateFileSecurityPermissions to set the security descriptor of a path, the propagation job is polled every interval seconds for up to timeout seconds
+func CreateFileSecurityPermissions(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string, body FileSecurityPermissionsResourceBodyDataModelONTAP, timeout int, interval int) error {
+	api := fileSecurityPermissionsAPI(svmUUID, path)
+	bodyMap, err := encodeFileSecurityPermissionsBody(body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error encoding file security permissions body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, response, err := r.CallAsyncMethod("POST", api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating file security permissions", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// UpdateFileSecurityPermissions to change the owner or group of a path, the propagation job is polled every interval seconds for up to timeout seconds
+func UpdateFileSecurityPermissions(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string, body FileSecurityPermissionsResourceBodyDataModelONTAP, timeout int, interval int) error {
+	api := fileSecurityPermissionsAPI(svmUUID, path)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding file security permissions body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, response, err := r.CallAsyncMethod("PATCH", api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating file security permissions", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// AddFileSecurityPermissionsACL to add an access control entry to the DACL of a path
+func AddFileSecurityPermissionsACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string, acl FileSecurityPermissionsACL, propagationMode string, timeout int, interval int) error {
+	api := fileSecurityPermissionsAPI(svmUUID, path) + "/acl"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(acl, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding file security ACL body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, acl))
+	}
+	if propagationMode != "" {
+		bodyMap["propagation_mode"] = propagationMode
+	}
+	statusCode, response, err := r.CallAsyncMethod("POST", api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding file security ACL", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// UpdateFileSecurityPermissionsACL to change the rights of an access control entry, identified by user and access
+func UpdateFileSecurityPermissionsACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string, acl FileSecurityPermissionsACL, propagationMode string, timeout int, interval int) error {
+	api := fileSecurityPermissionsAPI(svmUUID, path) + "/acl/" + url.PathEscape(acl.User)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(acl, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding file security ACL body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, acl))
+	}
+	// the user is in the API path
+	delete(bodyMap, "user")
+	if propagationMode != "" {
+		bodyMap["propagation_mode"] = propagationMode
+	}
+	statusCode, response, err := r.CallAsyncMethod("PATCH", api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating file security ACL", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// DeleteFileSecurityPermissionsACL to remove an access control entry, identified by user and access
+func DeleteFileSecurityPermissionsACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, path string, acl FileSecurityPermissionsACL, timeout int, interval int) error {
+	api := fileSecurityPermissionsAPI(svmUUID, path) + "/acl/" + url.PathEscape(acl.User)
+	body := map[string]interface{}{"access": acl.Access}
+	if acl.ApplyTo != nil {
+		var applyTo map[string]interface{}
+		if err := mapstructure.Decode(acl.ApplyTo, &applyTo); err != nil {
+			return errorHandler.MakeAndReportError("error encoding file security ACL body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, acl))
+		}
+		body["apply_to"] = applyTo
+	}
+	statusCode, response, err := r.CallAsyncMethod("DELETE", api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting file security ACL", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
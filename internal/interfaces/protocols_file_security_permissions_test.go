@@ -0,0 +1,258 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var fileSecurityPermissionsRecord = FileSecurityPermissionsGetDataModelONTAP{
+	Owner: "BUILTIN\\Administrators",
+	Group: "BUILTIN\\Administrators",
+	ACLs: []FileSecurityPermissionsACL{
+		{User: "DOMAIN\\user1", Access: "access_allow", Rights: "full_control", ApplyTo: &FileSecurityPermissionsApplyTo{Files: true, SubFolders: true, ThisFolder: true}},
+		{User: "Everyone", Access: "access_allow", Rights: "read", ApplyTo: &FileSecurityPermissionsApplyTo{ThisFolder: true}, Inherited: true},
+	},
+}
+
+var badFileSecurityPermissionsRecord = struct{ Owner int }{123}
+
+func TestGetFileSecurityPermissions(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(fileSecurityPermissionsRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badFileSecurityPermissionsRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "protocols/file-security/permissions/svm-uuid/%2Fvol1%2Fshare1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *FileSecurityPermissionsGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &fileSecurityPermissionsRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetFileSecurityPermissions(errorHandler, *r, "svm-uuid", "/vol1/share1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetFileSecurityPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetFileSecurityPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateFileSecurityPermissions(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "POST /api/protocols/file-security/permissions"}}}
+	}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/file-security/permissions/svm-uuid/%2Fvol1%2Fshare1"
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_job_failure": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("failure"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := FileSecurityPermissionsResourceBodyDataModelONTAP{
+		Owner:           "BUILTIN\\Administrators",
+		PropagationMode: "propagate",
+		ACLs:            []FileSecurityPermissionsACL{{User: "DOMAIN\\user1", Access: "access_allow", Rights: "full_control"}},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_job_failure", responses: responses["test_job_failure"], wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateFileSecurityPermissions(errorHandler, *r, "svm-uuid", "/vol1/share1", body, 10, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateFileSecurityPermissions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncodeFileSecurityPermissionsBody(t *testing.T) {
+	body := FileSecurityPermissionsResourceBodyDataModelONTAP{
+		Owner:           "BUILTIN\\Administrators",
+		PropagationMode: "propagate",
+		ACLs: []FileSecurityPermissionsACL{
+			{User: "DOMAIN\\user1", Access: "access_allow", Rights: "full_control", ApplyTo: &FileSecurityPermissionsApplyTo{Files: true, ThisFolder: true}},
+		},
+	}
+	want := map[string]interface{}{
+		"owner":            "BUILTIN\\Administrators",
+		"propagation_mode": "propagate",
+		"acls": []map[string]interface{}{
+			{
+				"user":     "DOMAIN\\user1",
+				"access":   "access_allow",
+				"rights":   "full_control",
+				"apply_to": map[string]interface{}{"files": true, "sub_folders": false, "this_folder": true},
+			},
+		},
+	}
+	got, err := encodeFileSecurityPermissionsBody(body)
+	if err != nil {
+		t.Fatalf("encodeFileSecurityPermissionsBody() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("encodeFileSecurityPermissionsBody() = %#v, want %#v", got, want)
+	}
+}
+
+func TestFileSecurityPermissionsACL(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobSuccess := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": "success"}}}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/file-security/permissions/svm-uuid/%2Fvol1%2Fshare1/acl"
+	userAPI := api + "/DOMAIN%5Cuser1"
+	acl := FileSecurityPermissionsACL{User: "DOMAIN\\user1", Access: "access_allow", Rights: "modify", ApplyTo: &FileSecurityPermissionsApplyTo{ThisFolder: true}}
+	tests := []struct {
+		name      string
+		call      func(r restclient.RestClient) error
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{
+			name: "test_add",
+			call: func(r restclient.RestClient) error {
+				return AddFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, "propagate", 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobSuccess, Err: nil},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test_add_error",
+			call: func(r restclient.RestClient) error {
+				return AddFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, "", 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test_update",
+			call: func(r restclient.RestClient) error {
+				return UpdateFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, "", 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "PATCH", ExpectedURL: userAPI, StatusCode: 202, Response: job, Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobSuccess, Err: nil},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test_update_error",
+			call: func(r restclient.RestClient) error {
+				return UpdateFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, "", 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "PATCH", ExpectedURL: userAPI, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test_delete",
+			call: func(r restclient.RestClient) error {
+				return DeleteFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "DELETE", ExpectedURL: userAPI, StatusCode: 202, Response: job, Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobSuccess, Err: nil},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test_delete_error",
+			call: func(r restclient.RestClient) error {
+				return DeleteFileSecurityPermissionsACL(errorHandler, r, "svm-uuid", "/vol1/share1", acl, 10, 1)
+			},
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "DELETE", ExpectedURL: userAPI, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = tt.call(*r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsLockGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsLockGetDataModelONTAP struct {
+	UUID          string            `mapstructure:"uuid"`
+	SVM           SvmDataModelONTAP `mapstructure:"svm"`
+	Volume        NameDataModel     `mapstructure:"volume"`
+	Path          string            `mapstructure:"path"`
+	ClientAddress string            `mapstructure:"client_address"`
+	Protocol      string            `mapstructure:"protocol"`
+	Type          string            `mapstructure:"type"`
+	State         string            `mapstructure:"state"`
+}
+
+// ProtocolsLockDataSourceFilterModel describes the data source filter model.
+type ProtocolsLockDataSourceFilterModel struct {
+	SVMName       string `mapstructure:"svm.name,omitempty"`
+	VolumeName    string `mapstructure:"volume.name,omitempty"`
+	Path          string `mapstructure:"path,omitempty"`
+	ClientAddress string `mapstructure:"client_address,omitempty"`
+	Protocol      string `mapstructure:"protocol,omitempty"`
+}
+
+// GetListProtocolsLocks to get protocols_lock info for all locks matching a filter
+func GetListProtocolsLocks(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsLockDataSourceFilterModel) ([]ProtocolsLockGetDataModelONTAP, error) {
+	api := "protocols/locks"
+	query := r.NewQuery()
+	query.Fields([]string{"uuid", "svm.name", "svm.uuid", "volume.name", "volume.uuid", "path", "client_address", "protocol", "type", "state"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_lock filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_lock info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsLockGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsLockGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_lock data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// BreakProtocolsLock to break a lock
+func BreakProtocolsLock(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "protocols/locks"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error breaking protocols_lock", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
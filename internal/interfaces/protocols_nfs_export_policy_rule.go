@@ -11,6 +11,7 @@ import (
 )
 
 // ExportpolicyRuleResourceBodyDataModelONTAP describes the resource data model.
+// allow_device_creation and allow_suid are always sent, as they default to true on ONTAP.
 type ExportpolicyRuleResourceBodyDataModelONTAP struct {
 	// SVM                 svm                 `mapstructure:"svm"`
 	ClientsMatch        []map[string]string `mapstructure:"clients,omitempty"`
@@ -19,10 +20,10 @@ type ExportpolicyRuleResourceBodyDataModelONTAP struct {
 	Protocols           []string            `mapstructure:"protocols,omitempty"`
 	AnonymousUser       string              `mapstructure:"anonymous_user,omitempty"`
 	Superuser           []string            `mapstructure:"superuser,omitempty"`
-	AllowDeviceCreation bool                `mapstructure:"allow_device_creation,omitempty"`
+	AllowDeviceCreation bool                `mapstructure:"allow_device_creation"`
 	NtfsUnixSecurity    string              `mapstructure:"ntfs_unix_security,omitempty"`
 	ChownMode           string              `mapstructure:"chown_mode,omitempty"`
-	AllowSuid           bool                `mapstructure:"allow_suid,omitempty"`
+	AllowSuid           bool                `mapstructure:"allow_suid"`
 	Index               int64               `mapstructure:"index,omitempty"`
 }
 
@@ -114,11 +115,9 @@ func GetExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestClie
 func GetExportPolicyRuleSingle(errorHandler *utils.ErrorHandler, r restclient.RestClient, exportPolicyID string, index int64, version versionModelONTAP) (*ExportPolicyRuleGetDataModelONTAP, error) {
 	query := r.NewQuery()
 	fields := []string{"policy.name", "svm.name", "svm.uuid", "superuser", "protocols", "policy.name", "allow_device_creation",
-		"chown_mode", "rw_rule", "index", "allow_suid", "ro_rule", "clients.match", "anonymous_user"}
+		"chown_mode", "rw_rule", "index", "allow_suid", "ro_rule", "clients.match", "anonymous_user", "ntfs_unix_security"}
 
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "ntfs_unix_security")
-	}
+	fields = FieldsForVersion("protocols/nfs/export-policies/rules", version, fields)
 
 	query.Fields(fields)
 
@@ -147,11 +146,9 @@ func GetListExportPolicyRules(errorHandler *utils.ErrorHandler, r restclient.Res
 	}
 
 	fields := []string{"policy.name", "svm.name", "svm.uuid", "superuser", "protocols", "policy.name", "allow_device_creation",
-		"chown_mode", "rw_rule", "index", "allow_suid", "ro_rule", "clients.match", "anonymous_user"}
+		"chown_mode", "rw_rule", "index", "allow_suid", "ro_rule", "clients.match", "anonymous_user", "ntfs_unix_security"}
 
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "ntfs_unix_security")
-	}
+	fields = FieldsForVersion("protocols/nfs/export-policies/rules", version, fields)
 	query.Fields(fields)
 
 	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
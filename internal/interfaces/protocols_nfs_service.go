@@ -90,11 +90,7 @@ func GetProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.RestC
 		"transport.udp_enabled", "protocol.v40_features.acl_enabled", "protocol.v40_features.read_delegation_enabled",
 		"protocol.v40_features.write_delegation_enabled", "protocol.v41_features.acl_enabled", "protocol.v41_features.read_delegation_enabled",
 		"protocol.v41_features.write_delegation_enabled", "enabled"}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "root.ignore_nt_acl", "root.skip_write_permission_check",
-			"security.chown_mode", "security.nt_acl_display_permission", "security.ntfs_unix_security", "security.rpcsec_context_idle",
-			"windows.default_user", "windows.map_unknown_uid_to_default_user", "windows.v3_ms_dos_client_enabled", "transport.tcp_max_transfer_size")
-	}
+	fields = FieldsForVersion(api, version, fields)
 	query.Fields(fields)
 
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
@@ -124,11 +120,7 @@ func GetProtocolsNfsServices(errorHandler *utils.ErrorHandler, r restclient.Rest
 		"transport.udp_enabled", "protocol.v40_features.acl_enabled", "protocol.v40_features.read_delegation_enabled",
 		"protocol.v40_features.write_delegation_enabled", "protocol.v41_features.acl_enabled", "protocol.v41_features.read_delegation_enabled",
 		"protocol.v41_features.write_delegation_enabled", "enabled"}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "root.ignore_nt_acl", "root.skip_write_permission_check",
-			"security.chown_mode", "security.nt_acl_display_permission", "security.ntfs_unix_security", "security.rpcsec_context_idle",
-			"windows.default_user", "windows.map_unknown_uid_to_default_user", "windows.v3_ms_dos_client_enabled", "transport.tcp_max_transfer_size")
-	}
+	fields = FieldsForVersion(api, version, fields)
 	query.Fields(fields)
 	if filter != nil {
 		var filterMap map[string]interface{}
@@ -0,0 +1,122 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NvmeSubsystemGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NvmeSubsystemGetDataModelONTAP struct {
+	Name string            `mapstructure:"name"`
+	UUID string            `mapstructure:"uuid"`
+	SVM  SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// NvmeSubsystemHostGetDataModelONTAP describes the GET record data model using go types for mapping.
+// The DH-HMAC-CHAP secret keys are never returned.
+type NvmeSubsystemHostGetDataModelONTAP struct {
+	NQN        string                  `mapstructure:"nqn"`
+	DhHmacChap NvmeSubsystemDhHmacChap `mapstructure:"dh_hmac_chap"`
+}
+
+// NvmeSubsystemDhHmacChap describes the DH-HMAC-CHAP authentication settings of a host.
+type NvmeSubsystemDhHmacChap struct {
+	// none, unidirectional, or bidirectional
+	Mode                string `mapstructure:"mode,omitempty"`
+	HostSecretKey       string `mapstructure:"host_secret_key,omitempty"`
+	ControllerSecretKey string `mapstructure:"controller_secret_key,omitempty"`
+	HashFunction        string `mapstructure:"hash_function,omitempty"`
+	GroupSize           string `mapstructure:"group_size,omitempty"`
+}
+
+// NvmeSubsystemHostResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NvmeSubsystemHostResourceBodyDataModelONTAP struct {
+	NQN        string                   `mapstructure:"nqn"`
+	DhHmacChap *NvmeSubsystemDhHmacChap `mapstructure:"dh_hmac_chap,omitempty"`
+}
+
+// nvmeSubsystemHostsAPI returns the API for the hosts of a subsystem
+func nvmeSubsystemHostsAPI(subsystemUUID string) string {
+	return "protocols/nvme/subsystems/" + subsystemUUID + "/hosts"
+}
+
+// GetNvmeSubsystemByName to get a NVMe subsystem by name
+func GetNvmeSubsystemByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*NvmeSubsystemGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystems"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "svm.name", "svm.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no NVMe subsystem %s found in svm %s", name, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading NVMe subsystem info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NvmeSubsystemGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read NVMe subsystem: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNvmeSubsystemHost to get a host of a NVMe subsystem, returns nil if the host is not found
+func GetNvmeSubsystemHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, nqn string) (*NvmeSubsystemHostGetDataModelONTAP, error) {
+	// the NQN contains colons, eg nqn.2014-08.org.nvmexpress:uuid:<uuid>
+	api := nvmeSubsystemHostsAPI(subsystemUUID) + "/" + url.PathEscape(nqn)
+	query := r.NewQuery()
+	query.Fields([]string{"nqn", "dh_hmac_chap.mode", "dh_hmac_chap.hash_function", "dh_hmac_chap.group_size"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("NVMe subsystem host %s not found", nqn))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading NVMe subsystem host", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP NvmeSubsystemHostGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read NVMe subsystem host: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// AddNvmeSubsystemHost to add a host to a NVMe subsystem
+func AddNvmeSubsystemHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, body NvmeSubsystemHostResourceBodyDataModelONTAP) error {
+	api := nvmeSubsystemHostsAPI(subsystemUUID)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		// the body is not logged, as it contains the secret keys
+		return errorHandler.MakeAndReportError("error encoding NVMe subsystem host body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding NVMe subsystem host", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// RemoveNvmeSubsystemHost to remove a host from a NVMe subsystem
+func RemoveNvmeSubsystemHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, nqn string) error {
+	api := nvmeSubsystemHostsAPI(subsystemUUID) + "/" + url.PathEscape(nqn)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing NVMe subsystem host", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
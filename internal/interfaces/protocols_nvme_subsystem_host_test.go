@@ -0,0 +1,219 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var nvmeSubsystemRecord = NvmeSubsystemGetDataModelONTAP{
+	Name: "subsystem1",
+	UUID: "subsystem-uuid",
+	SVM:  SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+}
+
+var nvmeSubsystemHostRecord = NvmeSubsystemHostGetDataModelONTAP{
+	NQN: "nqn.2014-08.org.nvmexpress:uuid:host1",
+	DhHmacChap: NvmeSubsystemDhHmacChap{
+		Mode:         "bidirectional",
+		HashFunction: "sha_256",
+		GroupSize:    "2048_bit",
+	},
+}
+
+func TestGetNvmeSubsystemByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(nvmeSubsystemRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"uuid": 123}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "protocols/nvme/subsystems"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NvmeSubsystemGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &nvmeSubsystemRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNvmeSubsystemByName(errorHandler, *r, "subsystem1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNvmeSubsystemByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNvmeSubsystemByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNvmeSubsystemHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(nvmeSubsystemHostRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"dh_hmac_chap": "none"}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "protocols/nvme/subsystems/subsystem-uuid/hosts/nqn.2014-08.org.nvmexpress:uuid:host1"
+	responses := map[string][]restclient.MockResponse{
+		"test_not_found": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NvmeSubsystemHostGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_not_found", responses: responses["test_not_found"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &nvmeSubsystemHostRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNvmeSubsystemHost(errorHandler, *r, "subsystem-uuid", "nqn.2014-08.org.nvmexpress:uuid:host1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNvmeSubsystemHost() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNvmeSubsystemHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddNvmeSubsystemHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "protocols/nvme/subsystems/subsystem-uuid/hosts"
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_add_error": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := NvmeSubsystemHostResourceBodyDataModelONTAP{
+		NQN:        "nqn.2014-08.org.nvmexpress:uuid:host1",
+		DhHmacChap: &NvmeSubsystemDhHmacChap{HostSecretKey: "DHHC-1:00:secret:"},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], wantErr: false},
+		{name: "test_add_error", responses: responses["test_add_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = AddNvmeSubsystemHost(errorHandler, *r, "subsystem-uuid", body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddNvmeSubsystemHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemoveNvmeSubsystemHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "protocols/nvme/subsystems/subsystem-uuid/hosts/nqn.2014-08.org.nvmexpress:uuid:host1"
+	responses := map[string][]restclient.MockResponse{
+		"test_remove": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_remove_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_remove", responses: responses["test_remove"], wantErr: false},
+		{name: "test_remove_error", responses: responses["test_remove_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = RemoveNvmeSubsystemHost(errorHandler, *r, "subsystem-uuid", "nqn.2014-08.org.nvmexpress:uuid:host1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RemoveNvmeSubsystemHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// S3BucketPolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type S3BucketPolicyGetDataModelONTAP struct {
+	Name   string            `mapstructure:"name"`
+	UUID   string            `mapstructure:"uuid"`
+	SVM    SvmDataModelONTAP `mapstructure:"svm"`
+	Policy S3BucketPolicy    `mapstructure:"policy"`
+}
+
+// S3BucketPolicy describes the access policy of a bucket.
+type S3BucketPolicy struct {
+	Statements []S3BucketPolicyStatement `mapstructure:"statements"`
+}
+
+// S3BucketPolicyStatement describes a statement of a bucket policy.
+type S3BucketPolicyStatement struct {
+	Sid        string                    `mapstructure:"sid,omitempty"`
+	Effect     string                    `mapstructure:"effect"`
+	Actions    []string                  `mapstructure:"actions"`
+	Principals []string                  `mapstructure:"principals,omitempty"`
+	Resources  []string                  `mapstructure:"resources"`
+	Conditions []S3BucketPolicyCondition `mapstructure:"conditions,omitempty"`
+}
+
+// S3BucketPolicyCondition describes a condition of a bucket policy statement.
+type S3BucketPolicyCondition struct {
+	Operator   string   `mapstructure:"operator"`
+	SourceIPs  []string `mapstructure:"source_ips,omitempty"`
+	Usernames  []string `mapstructure:"usernames,omitempty"`
+	Prefixes   []string `mapstructure:"prefixes,omitempty"`
+	Delimiters []string `mapstructure:"delimiters,omitempty"`
+	MaxKeys    []int64  `mapstructure:"max_keys,omitempty"`
+}
+
+// GetS3BucketPolicy to get the policy of a bucket by bucket name and svm name, returns nil if the bucket is not found
+func GetS3BucketPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, bucketName string, svmName string) (*S3BucketPolicyGetDataModelONTAP, error) {
+	api := "protocols/s3/buckets"
+	query := r.NewQuery()
+	query.Set("name", bucketName)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "svm.name", "svm.uuid", "policy.statements"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading S3 bucket policy", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("bucket %s not found in svm %s", bucketName, svmName))
+		return nil, nil
+	}
+
+	var dataONTAP S3BucketPolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read S3 bucket policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateS3BucketPolicy to replace the statements of the policy of a bucket, an empty list removes the policy
+func UpdateS3BucketPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, bucketUUID string, statements []S3BucketPolicyStatement) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/buckets/%s", svmUUID, bucketUUID)
+	statementMaps, err := encodeSliceOfStructs(statements)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error encoding S3 bucket policy statement", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, statements))
+	}
+	for index, statement := range statements {
+		if len(statement.Conditions) == 0 {
+			continue
+		}
+		conditionMaps, err := encodeSliceOfStructs(statement.Conditions)
+		if err != nil {
+			return errorHandler.MakeAndReportError("error encoding S3 bucket policy condition", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, statement.Conditions))
+		}
+		statementMaps[index]["conditions"] = conditionMaps
+	}
+	body := map[string]interface{}{
+		"policy": map[string]interface{}{"statements": statementMaps},
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating S3 bucket policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var s3BucketPolicyStatements = []S3BucketPolicyStatement{
+	{
+		Sid:        "ReadOnly",
+		Effect:     "allow",
+		Actions:    []string{"GetObject", "ListBucket"},
+		Principals: []string{"user1", "nasgroup/group1"},
+		Resources:  []string{"bucket1", "bucket1/*"},
+		Conditions: []S3BucketPolicyCondition{
+			{Operator: "ip_address", SourceIPs: []string{"10.0.0.0/24"}},
+		},
+	},
+}
+
+func TestGetS3BucketPolicy(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{
+		"name": "bucket1",
+		"uuid": "bucket-uuid",
+		"svm":  map[string]any{"name": "svm1", "uuid": "svm-uuid"},
+		"policy": map[string]any{"statements": []any{
+			map[string]any{
+				"sid":        "ReadOnly",
+				"effect":     "allow",
+				"actions":    []any{"GetObject", "ListBucket"},
+				"principals": []any{"user1", "nasgroup/group1"},
+				"resources":  []any{"bucket1", "bucket1/*"},
+				"conditions": []any{map[string]any{"operator": "ip_address", "source_ips": []any{"10.0.0.0/24"}}},
+			},
+		}},
+	}
+	want := S3BucketPolicyGetDataModelONTAP{
+		Name:   "bucket1",
+		UUID:   "bucket-uuid",
+		SVM:    SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+		Policy: S3BucketPolicy{Statements: s3BucketPolicyStatements},
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"policy": "none"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/s3/buckets", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/s3/buckets", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/s3/buckets", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/s3/buckets", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *S3BucketPolicyGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &want, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetS3BucketPolicy(errorHandler, *r, "bucket1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetS3BucketPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetS3BucketPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateS3BucketPolicy(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/s3/services/svm-uuid/buckets/bucket-uuid", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_remove_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/s3/services/svm-uuid/buckets/bucket-uuid", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/s3/services/svm-uuid/buckets/bucket-uuid", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name       string
+		responses  []restclient.MockResponse
+		statements []S3BucketPolicyStatement
+		wantErr    bool
+	}{
+		{name: "test_update_1", responses: responses["test_update_1"], statements: s3BucketPolicyStatements, wantErr: false},
+		{name: "test_remove_1", responses: responses["test_remove_1"], statements: nil, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], statements: s3BucketPolicyStatements, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateS3BucketPolicy(errorHandler, *r, "svm-uuid", "bucket-uuid", tt.statements)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateS3BucketPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
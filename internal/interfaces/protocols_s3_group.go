@@ -0,0 +1,128 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// S3GroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type S3GroupGetDataModelONTAP struct {
+	Name     string          `mapstructure:"name"`
+	ID       int64           `mapstructure:"id"`
+	Comment  string          `mapstructure:"comment"`
+	Users    []NameDataModel `mapstructure:"users"`
+	Policies []NameDataModel `mapstructure:"policies"`
+}
+
+// S3GroupResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type S3GroupResourceBodyDataModelONTAP struct {
+	Name     string         `mapstructure:"name,omitempty"`
+	Comment  string         `mapstructure:"comment"`
+	Users    []S3MemberName `mapstructure:"users"`
+	Policies []S3MemberName `mapstructure:"policies"`
+}
+
+// S3MemberName describes a user or a policy attached to a group.
+type S3MemberName struct {
+	Name string `mapstructure:"name"`
+}
+
+func s3GroupsAPI(svmUUID string) string {
+	return fmt.Sprintf("protocols/s3/services/%s/groups", svmUUID)
+}
+
+// encodeS3GroupBody encodes a group body, including the users and policies
+func encodeS3GroupBody(errorHandler *utils.ErrorHandler, api string, body S3GroupResourceBodyDataModelONTAP) (map[string]interface{}, error) {
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding S3 group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	users, err := encodeSliceOfStructs(body.Users)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding S3 group users", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body.Users))
+	}
+	bodyMap["users"] = users
+	policies, err := encodeSliceOfStructs(body.Policies)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding S3 group policies", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body.Policies))
+	}
+	bodyMap["policies"] = policies
+	return bodyMap, nil
+}
+
+// GetS3GroupByName to get a S3 group by name, returns nil if the group is not found
+func GetS3GroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*S3GroupGetDataModelONTAP, error) {
+	api := s3GroupsAPI(svmUUID)
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "id", "comment", "users.name", "policies.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading S3 group info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("S3 group %s not found", name))
+		return nil, nil
+	}
+
+	var dataONTAP S3GroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read S3 group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateS3Group to create a S3 group
+func CreateS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body S3GroupResourceBodyDataModelONTAP) (*S3GroupGetDataModelONTAP, error) {
+	api := s3GroupsAPI(svmUUID)
+	bodyMap, err := encodeS3GroupBody(errorHandler, api, body)
+	if err != nil {
+		return nil, err
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && response.NumRecords == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating S3 group", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP S3GroupGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding S3 group info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create S3 group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateS3Group to update the comment, users, and policies of a S3 group, the lists replace the current ones
+func UpdateS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, id int64, body S3GroupResourceBodyDataModelONTAP) error {
+	api := fmt.Sprintf("%s/%d", s3GroupsAPI(svmUUID), id)
+	bodyMap, err := encodeS3GroupBody(errorHandler, api, body)
+	if err != nil {
+		return err
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating S3 group", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteS3Group to delete a S3 group
+func DeleteS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, id int64) error {
+	api := fmt.Sprintf("%s/%d", s3GroupsAPI(svmUUID), id)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting S3 group", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var s3GroupRecord = S3GroupGetDataModelONTAP{
+	Name:     "group1",
+	ID:       1,
+	Comment:  "readers",
+	Users:    []NameDataModel{{Name: "user1"}, {Name: "user2"}},
+	Policies: []NameDataModel{{Name: "ReadOnly"}},
+}
+
+func TestGetS3GroupByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{
+		"name":     "group1",
+		"id":       1,
+		"comment":  "readers",
+		"users":    []any{map[string]any{"name": "user1"}, map[string]any{"name": "user2"}},
+		"policies": []any{map[string]any{"name": "ReadOnly"}},
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"id": "one"}}}
+	api := "protocols/s3/services/svm-uuid/groups"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *S3GroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &s3GroupRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetS3GroupByName(errorHandler, *r, "svm-uuid", "group1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetS3GroupByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetS3GroupByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateS3Group(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{"name": "group1", "id": 1}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/s3/services/svm-uuid/groups"
+	body := S3GroupResourceBodyDataModelONTAP{
+		Name:     "group1",
+		Users:    []S3MemberName{{Name: "user1"}},
+		Policies: []S3MemberName{{Name: "ReadOnly"}},
+	}
+	responses := map[string][]restclient.MockResponse{
+		"test_one_record_1": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records_1": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *S3GroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &S3GroupGetDataModelONTAP{Name: "group1", ID: 1}, wantErr: false},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateS3Group(errorHandler, *r, "svm-uuid", body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateS3Group() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateS3Group() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// S3PolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type S3PolicyGetDataModelONTAP struct {
+	Name       string              `mapstructure:"name"`
+	Comment    string              `mapstructure:"comment"`
+	Statements []S3PolicyStatement `mapstructure:"statements"`
+}
+
+// S3PolicyResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type S3PolicyResourceBodyDataModelONTAP struct {
+	Name       string              `mapstructure:"name,omitempty"`
+	Comment    string              `mapstructure:"comment"`
+	Statements []S3PolicyStatement `mapstructure:"statements"`
+}
+
+// S3PolicyStatement describes a statement of a S3 access policy.
+type S3PolicyStatement struct {
+	Sid       string   `mapstructure:"sid,omitempty"`
+	Effect    string   `mapstructure:"effect"`
+	Actions   []string `mapstructure:"actions"`
+	Resources []string `mapstructure:"resources"`
+}
+
+func s3PoliciesAPI(svmUUID string) string {
+	return fmt.Sprintf("protocols/s3/services/%s/policies", svmUUID)
+}
+
+// encodeS3PolicyBody encodes a policy body, including the statements
+func encodeS3PolicyBody(errorHandler *utils.ErrorHandler, api string, body S3PolicyResourceBodyDataModelONTAP) (map[string]interface{}, error) {
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding S3 policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statements, err := encodeSliceOfStructs(body.Statements)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding S3 policy statement", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body.Statements))
+	}
+	bodyMap["statements"] = statements
+	return bodyMap, nil
+}
+
+// GetS3Policy to get a S3 access policy by name, returns nil if the policy is not found
+func GetS3Policy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*S3PolicyGetDataModelONTAP, error) {
+	api := s3PoliciesAPI(svmUUID) + "/" + url.PathEscape(name)
+	query := r.NewQuery()
+	query.Fields([]string{"name", "comment", "statements"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("S3 policy %s not found", name))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading S3 policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP S3PolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read S3 policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateS3Policy to create a S3 access policy
+func CreateS3Policy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body S3PolicyResourceBodyDataModelONTAP) error {
+	api := s3PoliciesAPI(svmUUID)
+	bodyMap, err := encodeS3PolicyBody(errorHandler, api, body)
+	if err != nil {
+		return err
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating S3 policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateS3Policy to update the comment and statements of a S3 access policy, the statements replace the current ones
+func UpdateS3Policy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body S3PolicyResourceBodyDataModelONTAP) error {
+	api := s3PoliciesAPI(svmUUID) + "/" + url.PathEscape(name)
+	bodyMap, err := encodeS3PolicyBody(errorHandler, api, body)
+	if err != nil {
+		return err
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating S3 policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteS3Policy to delete a S3 access policy
+func DeleteS3Policy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := s3PoliciesAPI(svmUUID) + "/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting S3 policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
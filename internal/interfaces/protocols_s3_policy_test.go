@@ -0,0 +1,119 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetS3Policy(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{
+		"name":    "ReadOnly",
+		"comment": "read only access",
+		"statements": []any{
+			map[string]any{"sid": "Read", "effect": "allow", "actions": []any{"GetObject", "ListBucket"}, "resources": []any{"*"}, "index": 0},
+		},
+	}
+	want := S3PolicyGetDataModelONTAP{
+		Name:       "ReadOnly",
+		Comment:    "read only access",
+		Statements: []S3PolicyStatement{{Sid: "Read", Effect: "allow", Actions: []string{"GetObject", "ListBucket"}, Resources: []string{"*"}}},
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"statements": "none"}}}
+	api := "protocols/s3/services/svm-uuid/policies/ReadOnly"
+	responses := map[string][]restclient.MockResponse{
+		"test_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *S3PolicyGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_not_found_1", responses: responses["test_not_found_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &want, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetS3Policy(errorHandler, *r, "svm-uuid", "ReadOnly")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetS3Policy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetS3Policy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateS3Policy(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/s3/services/svm-uuid/policies/ReadOnly"
+	body := S3PolicyResourceBodyDataModelONTAP{
+		Statements: []S3PolicyStatement{{Effect: "allow", Actions: []string{"GetObject"}, Resources: []string{"bucket1/*"}}},
+	}
+	responses := map[string][]restclient.MockResponse{
+		"test_update_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_1", responses: responses["test_update_1"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateS3Policy(errorHandler, *r, "svm-uuid", "ReadOnly", body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateS3Policy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// S3ServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type S3ServiceGetDataModelONTAP struct {
+	Name        string               `mapstructure:"name"`
+	Enabled     bool                 `mapstructure:"enabled"`
+	Certificate S3ServiceCertificate `mapstructure:"certificate"`
+}
+
+// S3ServiceCertificate identifies the server certificate of the object store server.
+type S3ServiceCertificate struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// S3ServiceResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type S3ServiceResourceBodyDataModelONTAP struct {
+	Certificate *S3ServiceCertificate `mapstructure:"certificate,omitempty"`
+}
+
+// GetS3Service to get the object store server of an SVM, returns nil if the SVM has no object store server
+func GetS3Service(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) (*S3ServiceGetDataModelONTAP, error) {
+	api := "protocols/s3/services/" + svmUUID
+	query := r.NewQuery()
+	query.Fields([]string{"name", "enabled", "certificate.name", "certificate.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no S3 service for svm %s", svmUUID))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading S3 service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP S3ServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read S3 service: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateS3Service to change the settings of the object store server of an SVM
+// Changing the certificate does not restart the service, the buckets remain available
+func UpdateS3Service(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body S3ServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/s3/services/" + svmUUID
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding S3 service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating S3 service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
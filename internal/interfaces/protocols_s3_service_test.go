@@ -0,0 +1,114 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetS3Service(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{
+		"name":        "s3server1",
+		"enabled":     true,
+		"certificate": map[string]any{"name": "s3_cert", "uuid": "cert-uuid"},
+	}
+	want := S3ServiceGetDataModelONTAP{Name: "s3server1", Enabled: true, Certificate: S3ServiceCertificate{Name: "s3_cert", UUID: "cert-uuid"}}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"certificate": "s3_cert"}}}
+	api := "protocols/s3/services/svm-uuid"
+	responses := map[string][]restclient.MockResponse{
+		"test_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *S3ServiceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_not_found_1", responses: responses["test_not_found_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &want, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetS3Service(errorHandler, *r, "svm-uuid")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetS3Service() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetS3Service() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateS3Service(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/s3/services/svm-uuid"
+	body := S3ServiceResourceBodyDataModelONTAP{Certificate: &S3ServiceCertificate{Name: "s3_cert_2024"}}
+	responses := map[string][]restclient.MockResponse{
+		"test_update_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_no_change_1": {},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      S3ServiceResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update_1", responses: responses["test_update_1"], body: body, wantErr: false},
+		{name: "test_no_change_1", responses: responses["test_no_change_1"], body: S3ServiceResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], body: body, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateS3Service(errorHandler, *r, "svm-uuid", tt.body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateS3Service() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// IgroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type IgroupGetDataModelONTAP struct {
+	Name       string            `mapstructure:"name"`
+	UUID       string            `mapstructure:"uuid"`
+	SVM        SvmDataModelONTAP `mapstructure:"svm"`
+	Portset    IgroupPortset     `mapstructure:"portset"`
+	Protocol   string            `mapstructure:"protocol"`
+	OsType     string            `mapstructure:"os_type"`
+	Initiators []IgroupInitiator `mapstructure:"initiators"`
+	LunMaps    []IgroupLunMap    `mapstructure:"lun_maps"`
+}
+
+// IgroupInitiator describes an initiator of an igroup.
+type IgroupInitiator struct {
+	Name    string `mapstructure:"name"`
+	Comment string `mapstructure:"comment"`
+}
+
+// IgroupLunMap describes a LUN mapped to an igroup.
+type IgroupLunMap struct {
+	Lun               NameDataModel `mapstructure:"lun"`
+	LogicalUnitNumber int64         `mapstructure:"logical_unit_number"`
+}
+
+// IgroupDataSourceFilterModel describes the data source filter model.
+type IgroupDataSourceFilterModel struct {
+	Name     string `mapstructure:"name,omitempty"`
+	SVMName  string `mapstructure:"svm.name,omitempty"`
+	Protocol string `mapstructure:"protocol,omitempty"`
+}
+
+// IgroupPortset describes the portset bound to an igroup.
+type IgroupPortset struct {
+	Name string `mapstructure:"name"`
+}
+
+// IgroupPortsetBodyDataModelONTAP describes the body to bind or unbind a portset, an empty name unbinds the portset.
+type IgroupPortsetBodyDataModelONTAP struct {
+	Portset IgroupPortset `mapstructure:"portset"`
+}
+
+// GetProtocolsSanIgroupByName to get igroup info
+func GetProtocolsSanIgroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*IgroupGetDataModelONTAP, error) {
+	api := "protocols/san/igroups"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "svm.name", "svm.uuid", "portset.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no igroup %s found in svm %s", name, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading igroup info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP IgroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read igroup: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListProtocolsSanIgroups to get the igroups matching a filter, with their initiators and mapped LUNs
+func GetListProtocolsSanIgroups(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IgroupDataSourceFilterModel) ([]IgroupGetDataModelONTAP, error) {
+	api := "protocols/san/igroups"
+	query := r.NewQuery()
+	query.Fields([]string{"name", "uuid", "svm.name", "svm.uuid", "portset.name", "protocol", "os_type", "initiators", "lun_maps"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding igroup filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading igroup info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []IgroupGetDataModelONTAP
+	for _, info := range response {
+		var record IgroupGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read igroups data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// UpdateProtocolsSanIgroupPortset to bind a portset to an igroup, or to unbind it when portsetName is empty
+func UpdateProtocolsSanIgroupPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, igroupUUID string, portsetName string) error {
+	api := "protocols/san/igroups/" + igroupUUID
+	body := IgroupPortsetBodyDataModelONTAP{Portset: IgroupPortset{Name: portsetName}}
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding igroup body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating igroup portset", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// IscsiSessionGetDataModelONTAP describes the GET record data model using go types for mapping.
+type IscsiSessionGetDataModelONTAP struct {
+	SVM         SvmDataModelONTAP        `mapstructure:"svm"`
+	Initiator   IscsiSessionInitiator    `mapstructure:"initiator"`
+	Tpgroup     NameDataModel            `mapstructure:"tpgroup"`
+	TSIH        int64                    `mapstructure:"tsih"`
+	ISID        string                   `mapstructure:"isid"`
+	Igroups     []NameDataModel          `mapstructure:"igroups"`
+	Connections []IscsiSessionConnection `mapstructure:"connections"`
+}
+
+// IscsiSessionInitiator describes the initiator of an iSCSI session.
+type IscsiSessionInitiator struct {
+	Name  string `mapstructure:"name"`
+	Alias string `mapstructure:"alias"`
+}
+
+// IscsiSessionConnection describes a connection of an iSCSI session.
+type IscsiSessionConnection struct {
+	CID                int64                           `mapstructure:"cid"`
+	AuthenticationType string                          `mapstructure:"authentication_type"`
+	Interface          IscsiSessionConnectionInterface `mapstructure:"interface"`
+	InitiatorAddress   IscsiSessionInitiatorAddress    `mapstructure:"initiator_address"`
+}
+
+// IscsiSessionConnectionInterface describes the network interface of an iSCSI connection.
+type IscsiSessionConnectionInterface struct {
+	Name string         `mapstructure:"name"`
+	IP   IscsiSessionIP `mapstructure:"ip"`
+}
+
+// IscsiSessionIP describes the IP address of the network interface of an iSCSI connection.
+type IscsiSessionIP struct {
+	Address string `mapstructure:"address"`
+}
+
+// IscsiSessionInitiatorAddress describes the address of the initiator of an iSCSI connection.
+type IscsiSessionInitiatorAddress struct {
+	Address string `mapstructure:"address"`
+	Port    int64  `mapstructure:"port"`
+}
+
+// FcLoginGetDataModelONTAP describes the GET record data model using go types for mapping.
+type FcLoginGetDataModelONTAP struct {
+	SVM       SvmDataModelONTAP `mapstructure:"svm"`
+	Initiator FcLoginInitiator  `mapstructure:"initiator"`
+	Interface NameDataModel     `mapstructure:"interface"`
+	// fcp or fc_nvme
+	Protocol string          `mapstructure:"protocol"`
+	Igroups  []NameDataModel `mapstructure:"igroups"`
+}
+
+// FcLoginInitiator describes the initiator of a FC login.
+type FcLoginInitiator struct {
+	WWPN string `mapstructure:"wwpn"`
+	WWNN string `mapstructure:"wwnn"`
+}
+
+// GetIscsiSessions to get the iSCSI sessions of a SVM
+func GetIscsiSessions(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) ([]IscsiSessionGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/sessions"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "initiator.name", "initiator.alias", "tpgroup.name", "tsih", "isid", "igroups.name",
+		"connections.cid", "connections.authentication_type", "connections.interface.name", "connections.interface.ip.address", "connections.initiator_address"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading iSCSI sessions", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []IscsiSessionGetDataModelONTAP
+	for _, info := range response {
+		var record IscsiSessionGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read iSCSI sessions: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// GetFcLogins to get the FC logins of a SVM, for FCP and FC-NVMe
+func GetFcLogins(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) ([]FcLoginGetDataModelONTAP, error) {
+	api := "network/fc/logins"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "initiator.wwpn", "initiator.wwnn", "interface.name", "protocol", "igroups.name"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading FC logins", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []FcLoginGetDataModelONTAP
+	for _, info := range response {
+		var record FcLoginGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read FC logins: %#v", dataONTAP))
+	return dataONTAP, nil
+}
@@ -0,0 +1,140 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// PortsetGetDataModelONTAP describes the GET record data model using go types for mapping.
+type PortsetGetDataModelONTAP struct {
+	Name       string             `mapstructure:"name"`
+	UUID       string             `mapstructure:"uuid"`
+	Protocol   string             `mapstructure:"protocol"`
+	SVM        SvmDataModelONTAP  `mapstructure:"svm"`
+	Interfaces []PortsetInterface `mapstructure:"interfaces"`
+	Igroups    []PortsetIgroup    `mapstructure:"igroups"`
+}
+
+// PortsetInterface describes a network interface in a portset, either an IP interface for iSCSI or a FC interface.
+type PortsetInterface struct {
+	UUID string                `mapstructure:"uuid,omitempty"`
+	IP   *PortsetInterfaceName `mapstructure:"ip,omitempty"`
+	FC   *PortsetInterfaceName `mapstructure:"fc,omitempty"`
+}
+
+// PortsetInterfaceName describes the IP or FC interface referenced by a portset interface.
+type PortsetInterfaceName struct {
+	Name string `mapstructure:"name"`
+}
+
+// PortsetIgroup describes an igroup bound to a portset.
+type PortsetIgroup struct {
+	Name string `mapstructure:"name"`
+	UUID string `mapstructure:"uuid"`
+}
+
+// PortsetResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Interfaces are added one at a time, see AddProtocolsSanPortsetInterface.
+type PortsetResourceBodyDataModelONTAP struct {
+	Name     string            `mapstructure:"name"`
+	Protocol string            `mapstructure:"protocol,omitempty"`
+	SVM      SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// InterfaceName returns the name of the IP or FC interface.
+func (i PortsetInterface) InterfaceName() string {
+	if i.IP != nil {
+		return i.IP.Name
+	}
+	if i.FC != nil {
+		return i.FC.Name
+	}
+	return ""
+}
+
+// GetProtocolsSanPortset to get portset info
+func GetProtocolsSanPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*PortsetGetDataModelONTAP, error) {
+	api := "protocols/san/portsets"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "protocol", "svm.name", "svm.uuid", "interfaces.uuid", "interfaces.ip.name", "interfaces.fc.name", "igroups.name", "igroups.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading portset info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP PortsetGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read portset: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsSanPortset to create portset
+func CreateProtocolsSanPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, body PortsetResourceBodyDataModelONTAP) (*PortsetGetDataModelONTAP, error) {
+	api := "protocols/san/portsets"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding portset body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && response.NumRecords == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating portset", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP PortsetGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding portset info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create portset: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// DeleteProtocolsSanPortset to delete portset
+func DeleteProtocolsSanPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "protocols/san/portsets"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting portset", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// AddProtocolsSanPortsetInterface to add a network interface to a portset
+func AddProtocolsSanPortsetInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, portsetUUID string, body PortsetInterface) error {
+	api := "protocols/san/portsets/" + portsetUUID + "/interfaces"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding portset interface body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding portset interface", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// RemoveProtocolsSanPortsetInterface to remove a network interface from a portset
+func RemoveProtocolsSanPortsetInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, portsetUUID string, interfaceUUID string) error {
+	api := "protocols/san/portsets/" + portsetUUID + "/interfaces"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+interfaceUUID, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing portset interface", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
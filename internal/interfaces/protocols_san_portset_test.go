@@ -0,0 +1,183 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var portsetRecord = PortsetGetDataModelONTAP{
+	Name:     "portset1",
+	UUID:     "a8f1c6d4-1234-11ee-8d3c-005056b3f0a7",
+	Protocol: "iscsi",
+	SVM:      SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+	Interfaces: []PortsetInterface{
+		{UUID: "if-uuid1", IP: &PortsetInterfaceName{Name: "lif1"}},
+	},
+	Igroups: []PortsetIgroup{{Name: "igroup1", UUID: "igroup-uuid1"}},
+}
+
+var badPortsetRecord = struct{ Name int }{123}
+
+func TestGetProtocolsSanPortset(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(portsetRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badPortsetRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *PortsetGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &portsetRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsSanPortset(errorHandler, *r, "portset1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsSanPortset() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsSanPortset() = %v, want %v", got, tt.want)
+			}
+			if got != nil && got.Interfaces[0].InterfaceName() != "lif1" {
+				t.Errorf("InterfaceName() = %s, want lif1", got.Interfaces[0].InterfaceName())
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsSanPortset(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := PortsetGetDataModelONTAP{Name: "portset1", UUID: "a8f1c6d4-1234-11ee-8d3c-005056b3f0a7", Protocol: "iscsi", SVM: SvmDataModelONTAP{Name: "svm1"}}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(record, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/portsets", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_create_no_record": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/portsets", StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/portsets", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := PortsetResourceBodyDataModelONTAP{Name: "portset1", Protocol: "iscsi", SVM: SvmDataModelONTAP{Name: "svm1"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *PortsetGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &record, wantErr: false},
+		{name: "test_create_no_record", responses: responses["test_create_no_record"], want: nil, wantErr: true},
+		{name: "test_create_error", responses: responses["test_create_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateProtocolsSanPortset(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsSanPortset() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateProtocolsSanPortset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddProtocolsSanPortsetInterface(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "protocols/san/portsets/a8f1c6d4-1234-11ee-8d3c-005056b3f0a7/interfaces"
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_add_error": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := PortsetInterface{FC: &PortsetInterfaceName{Name: "fc_lif1"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], wantErr: false},
+		{name: "test_add_error", responses: responses["test_add_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = AddProtocolsSanPortsetInterface(errorHandler, *r, "a8f1c6d4-1234-11ee-8d3c-005056b3f0a7", body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddProtocolsSanPortsetInterface() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
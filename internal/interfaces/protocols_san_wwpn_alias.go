@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// WwpnAliasGetDataModelONTAP describes the GET record data model using go types for mapping.
+type WwpnAliasGetDataModelONTAP struct {
+	Alias string            `mapstructure:"alias"`
+	WWPN  string            `mapstructure:"wwpn"`
+	SVM   SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// WwpnAliasResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type WwpnAliasResourceBodyDataModelONTAP struct {
+	Alias string            `mapstructure:"alias"`
+	WWPN  string            `mapstructure:"wwpn"`
+	SVM   SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// GetProtocolsSanWwpnAlias to get a WWPN alias by name, returns nil if the alias is not found
+func GetProtocolsSanWwpnAlias(errorHandler *utils.ErrorHandler, r restclient.RestClient, alias string, svmName string) (*WwpnAliasGetDataModelONTAP, error) {
+	api := "network/fc/wwpn-aliases"
+	query := r.NewQuery()
+	query.Set("alias", alias)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"alias", "wwpn", "svm.name", "svm.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading WWPN alias", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP WwpnAliasGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read WWPN alias: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsSanWwpnAlias to create a WWPN alias
+func CreateProtocolsSanWwpnAlias(errorHandler *utils.ErrorHandler, r restclient.RestClient, body WwpnAliasResourceBodyDataModelONTAP) error {
+	api := "network/fc/wwpn-aliases"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding WWPN alias body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating WWPN alias", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsSanWwpnAlias to delete a WWPN alias
+func DeleteProtocolsSanWwpnAlias(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, alias string) error {
+	api := "network/fc/wwpn-aliases/" + svmUUID + "/" + url.PathEscape(alias)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting WWPN alias", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// maxConcurrentRecordDetails bounds the workers reading per record details for plural data sources.
+// The REST client also limits concurrent requests per connection profile, using max_concurrent_requests.
+const maxConcurrentRecordDetails = 6
+
+// getRecordDetails calls getDetails for each record index in [0, count), using a bounded pool of workers.
+// Diagnostics are not safe for concurrent use, so each call reports errors through its own error handler,
+// and diagnostics are added to errorHandler in record order once all calls are done.
+// The first error in record order is returned.
+func getRecordDetails(errorHandler *utils.ErrorHandler, count int, getDetails func(errorHandler *utils.ErrorHandler, index int) error) error {
+	workers := maxConcurrentRecordDetails
+	if count < workers {
+		workers = count
+	}
+	diags := make([]diag.Diagnostics, count)
+	errs := make([]error, count)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				errs[index] = getDetails(utils.NewErrorHandler(errorHandler.Ctx, &diags[index]), index)
+			}
+		}()
+	}
+	for index := 0; index < count; index++ {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	var firstErr error
+	for index := 0; index < count; index++ {
+		errorHandler.AddDiagnostics(diags[index])
+		if firstErr == nil {
+			firstErr = errs[index]
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,76 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetRecordDetails(t *testing.T) {
+	diags := diag.Diagnostics{}
+	errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	details := make([]int, 20)
+	err := getRecordDetails(errorHandler, len(details), func(errorHandler *utils.ErrorHandler, index int) error {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(time.Millisecond)
+		details[index] = index * 2
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("getRecordDetails() unexpected error = %v", err)
+	}
+	if maxRunning > maxConcurrentRecordDetails {
+		t.Errorf("getRecordDetails() ran %d calls concurrently, want at most %d", maxRunning, maxConcurrentRecordDetails)
+	}
+	for index, detail := range details {
+		if detail != index*2 {
+			t.Errorf("getRecordDetails() details[%d] = %d, want %d", index, detail, index*2)
+		}
+	}
+	if diags.HasError() {
+		t.Errorf("getRecordDetails() unexpected diagnostics = %v", diags)
+	}
+}
+
+func TestGetRecordDetails_Errors(t *testing.T) {
+	diags := diag.Diagnostics{}
+	errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+	err := getRecordDetails(errorHandler, 10, func(errorHandler *utils.ErrorHandler, index int) error {
+		if index == 3 || index == 7 {
+			return errorHandler.MakeAndReportError("error reading record", fmt.Sprintf("record %d", index))
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("getRecordDetails() expected an error")
+	}
+	if len(diags) != 2 || diags[0].Detail() != "record 3" || diags[1].Detail() != "record 7" {
+		t.Errorf("getRecordDetails() diagnostics = %v, want errors for records 3 and 7 in order", diags)
+	}
+}
+
+func TestGetRecordDetails_NoRecords(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	err := getRecordDetails(errorHandler, 0, func(errorHandler *utils.ErrorHandler, index int) error {
+		t.Errorf("getRecordDetails() unexpected call for index %d", index)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("getRecordDetails() unexpected error = %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// GetRestQueryRecords runs a GET on any ONTAP REST API, and returns the raw records
+// api is relative to /api, eg storage/qtrees, query is a set of query parameters, fields is optional
+func GetRestQueryRecords(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, query map[string]string, fields []string) ([]map[string]interface{}, error) {
+	api = strings.Trim(api, "/")
+	if api == "" || strings.ContainsAny(api, "?#") {
+		return nil, errorHandler.MakeAndReportError("invalid api", fmt.Sprintf("expecting a REST API path, eg storage/qtrees, with query parameters set in query, got %q", api))
+	}
+	restQuery := r.NewQuery()
+	for key, value := range query {
+		restQuery.Set(key, value)
+	}
+	if len(fields) > 0 {
+		restQuery.Fields(fields)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, restQuery, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading records", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read %d records from %s", len(response), api))
+	return response, nil
+}
@@ -0,0 +1,60 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetRestQueryRecords(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{"name": "qtree1", "svm": map[string]any{"name": "svm1"}}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{record, record}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		api       string
+		responses []restclient.MockResponse
+		want      []map[string]any
+		wantErr   bool
+	}{
+		{name: "test_no_records", api: "storage/qtrees", responses: responses["test_no_records"], want: []map[string]any{}, wantErr: false},
+		{name: "test_two_records", api: "/storage/qtrees/", responses: responses["test_two_records"], want: []map[string]any{record, record}, wantErr: false},
+		{name: "test_error", api: "storage/qtrees", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_empty_api", api: "/", responses: nil, want: nil, wantErr: true},
+		{name: "test_query_in_api", api: "storage/qtrees?name=qtree1", responses: nil, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetRestQueryRecords(errorHandler, *r, tt.api, map[string]string{"svm.name": "svm1"}, []string{"name", "svm"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRestQueryRecords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRestQueryRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
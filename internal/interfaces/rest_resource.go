@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// CreateRestResource sends a POST to any ONTAP REST API, and returns the created record, or nil when ONTAP does not return it
+func CreateRestResource(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, body map[string]interface{}) (map[string]interface{}, error) {
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, body)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating rest resource", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create rest resource %s: %#v", api, response))
+	if len(response.Records) == 0 {
+		return nil, nil
+	}
+	return response.Records[0], nil
+}
+
+// GetRestResource reads an object from any ONTAP REST API, and returns nil if it does not exist
+func GetRestResource(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string) (map[string]interface{}, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("rest resource %s not found", api))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading rest resource", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read rest resource %s: %#v", api, response))
+	return response, nil
+}
+
+// UpdateRestResource sends a PATCH to any ONTAP REST API
+func UpdateRestResource(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, body map[string]interface{}) error {
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating rest resource", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteRestResource sends a DELETE to any ONTAP REST API
+func DeleteRestResource(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string) error {
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting rest resource", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
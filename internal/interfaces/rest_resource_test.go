@@ -0,0 +1,92 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestCreateRestResource(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{"name": "qtree1", "id": 1}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      map[string]any
+		wantErr   bool
+	}{
+		{name: "test_create", responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "storage/qtrees", StatusCode: 201, Response: oneRecord, Err: nil},
+		}, want: record, wantErr: false},
+		{name: "test_create_no_record", responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "storage/qtrees", StatusCode: 201, Response: noRecords, Err: nil},
+		}, want: nil, wantErr: false},
+		{name: "test_error", responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "storage/qtrees", StatusCode: 400, Response: noRecords, Err: genericError},
+		}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateRestResource(errorHandler, *r, "storage/qtrees", map[string]any{"name": "qtree1"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateRestResource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateRestResource() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRestResource(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{"name": "qtree1", "id": 1}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	genericError := errors.New("generic error for UT")
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      map[string]any
+		wantErr   bool
+	}{
+		{name: "test_found", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees/vol-uuid/1", StatusCode: 200, Response: oneRecord, Err: nil},
+		}, want: record, wantErr: false},
+		{name: "test_not_found", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees/vol-uuid/1", StatusCode: 404, Response: noRecords, Err: genericError},
+		}, want: nil, wantErr: false},
+		{name: "test_error", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees/vol-uuid/1", StatusCode: 400, Response: noRecords, Err: genericError},
+		}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetRestResource(errorHandler, *r, "storage/qtrees/vol-uuid/1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRestResource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRestResource() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityAccountGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityAccountGetDataModelONTAP struct {
+	Name         string                       `mapstructure:"name"`
+	Owner        SvmDataModelONTAP            `mapstructure:"owner"`
+	Applications []SecurityAccountApplication `mapstructure:"applications"`
+}
+
+// SecurityAccountApplication describes how an account logs in to an application.
+type SecurityAccountApplication struct {
+	Application                string   `mapstructure:"application"`
+	AuthenticationMethods      []string `mapstructure:"authentication_methods"`
+	SecondAuthenticationMethod string   `mapstructure:"second_authentication_method,omitempty"`
+}
+
+// GetSecurityAccount to get a login account, of an SVM if svmName is set or of the cluster, returns nil if it does not exist
+func GetSecurityAccount(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, name string) (*SecurityAccountGetDataModelONTAP, error) {
+	api := "security/accounts"
+	query := r.NewQuery()
+	query.Set("name", name)
+	if svmName != "" {
+		query.Set("owner.name", svmName)
+	} else {
+		query.Set("scope", "cluster")
+	}
+	query.Fields([]string{"name", "owner", "applications"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading account info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SecurityAccountGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read account: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSecurityAccountApplications to replace the applications of a login account
+func UpdateSecurityAccountApplications(errorHandler *utils.ErrorHandler, r restclient.RestClient, ownerUUID string, name string, applications []SecurityAccountApplication) error {
+	api := "security/accounts/" + ownerUUID + "/" + name
+	applicationMaps, err := encodeSliceOfStructs(applications)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error encoding account body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, applications))
+	}
+	body := map[string]interface{}{"applications": applicationMaps}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating account", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
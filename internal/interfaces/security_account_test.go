@@ -0,0 +1,112 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var securityAccountRecord = SecurityAccountGetDataModelONTAP{
+	Name:  "storage_admin",
+	Owner: SvmDataModelONTAP{Name: "cluster4", UUID: "owner-uuid"},
+	Applications: []SecurityAccountApplication{
+		{Application: "http", AuthenticationMethods: []string{"password"}},
+		{Application: "ssh", AuthenticationMethods: []string{"password"}, SecondAuthenticationMethod: "totp"},
+	},
+}
+
+func TestGetSecurityAccount(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	// as returned by the REST API
+	recordInterface := map[string]any{
+		"name":  "storage_admin",
+		"owner": map[string]any{"name": "cluster4", "uuid": "owner-uuid"},
+		"applications": []any{
+			map[string]any{"application": "http", "authentication_methods": []any{"password"}},
+			map[string]any{"application": "ssh", "authentication_methods": []any{"password"}, "second_authentication_method": "totp"},
+		},
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/accounts", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/accounts", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/accounts", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SecurityAccountGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &securityAccountRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSecurityAccount(errorHandler, *r, "", "storage_admin")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSecurityAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSecurityAccount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSecurityAccountApplications(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "security/accounts/owner-uuid/storage_admin"
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateSecurityAccountApplications(errorHandler, *r, "owner-uuid", "storage_admin", securityAccountRecord.Applications)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSecurityAccountApplications() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
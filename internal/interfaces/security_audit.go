@@ -0,0 +1,145 @@
+package interfaces
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityAuditGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityAuditGetDataModelONTAP struct {
+	Cli    bool `mapstructure:"cli"`
+	HTTP   bool `mapstructure:"http"`
+	Ontapi bool `mapstructure:"ontapi"`
+}
+
+// SecurityAuditResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Only the settings to modify are included.
+type SecurityAuditResourceBodyDataModelONTAP struct {
+	Cli    *bool `mapstructure:"cli,omitempty"`
+	HTTP   *bool `mapstructure:"http,omitempty"`
+	Ontapi *bool `mapstructure:"ontapi,omitempty"`
+}
+
+// SecurityAuditDestinationGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityAuditDestinationGetDataModelONTAP struct {
+	Address      string `mapstructure:"address"`
+	Port         int64  `mapstructure:"port"`
+	Protocol     string `mapstructure:"protocol"`
+	Facility     string `mapstructure:"facility"`
+	VerifyServer bool   `mapstructure:"verify_server"`
+}
+
+// SecurityAuditDestinationResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SecurityAuditDestinationResourceBodyDataModelONTAP struct {
+	Address      string `mapstructure:"address,omitempty"`
+	Port         int64  `mapstructure:"port,omitempty"`
+	Protocol     string `mapstructure:"protocol,omitempty"`
+	Facility     string `mapstructure:"facility,omitempty"`
+	VerifyServer bool   `mapstructure:"verify_server"`
+}
+
+// GetSecurityAudit to get the audit settings for GET requests
+func GetSecurityAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*SecurityAuditGetDataModelONTAP, error) {
+	api := "security/audit"
+	query := r.NewQuery()
+	query.Fields([]string{"cli", "http", "ontapi"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading security audit info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SecurityAuditGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read security audit: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSecurityAudit to update the audit settings for GET requests
+func UpdateSecurityAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SecurityAuditResourceBodyDataModelONTAP) error {
+	api := "security/audit"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding security audit body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating security audit", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// GetSecurityAuditDestination to get an audit log destination by address and port, returns nil if it does not exist
+func GetSecurityAuditDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, address string, port int64) (*SecurityAuditDestinationGetDataModelONTAP, error) {
+	api := "security/audit/destinations"
+	query := r.NewQuery()
+	query.Set("address", address)
+	query.Set("port", strconv.FormatInt(port, 10))
+	query.Fields([]string{"address", "port", "protocol", "facility", "verify_server"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading security audit destination info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SecurityAuditDestinationGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read security audit destination: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSecurityAuditDestination to add an audit log destination, ONTAP checks that the server is reachable
+func CreateSecurityAuditDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SecurityAuditDestinationResourceBodyDataModelONTAP) error {
+	api := "security/audit/destinations"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding security audit destination body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating security audit destination", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateSecurityAuditDestination to update the facility and server verification of an audit log destination
+func UpdateSecurityAuditDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, address string, port int64, body SecurityAuditDestinationResourceBodyDataModelONTAP) error {
+	api := fmt.Sprintf("security/audit/destinations/%s/%d", address, port)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding security audit destination body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating security audit destination", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSecurityAuditDestination to remove an audit log destination
+func DeleteSecurityAuditDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, address string, port int64) error {
+	api := fmt.Sprintf("security/audit/destinations/%s/%d", address, port)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting security audit destination", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
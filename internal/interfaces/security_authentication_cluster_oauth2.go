@@ -0,0 +1,156 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityOAuth2GetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityOAuth2GetDataModelONTAP struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// SecurityOAuth2ClientGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityOAuth2ClientGetDataModelONTAP struct {
+	Name                   string                             `mapstructure:"name"`
+	Application            string                             `mapstructure:"application"`
+	Issuer                 string                             `mapstructure:"issuer"`
+	Audience               string                             `mapstructure:"audience"`
+	ClientID               string                             `mapstructure:"client_id"`
+	Jwks                   *SecurityOAuth2ClientJwks          `mapstructure:"jwks,omitempty"`
+	Introspection          *SecurityOAuth2ClientIntrospection `mapstructure:"introspection,omitempty"`
+	RemoteUserClaim        string                             `mapstructure:"remote_user_claim,omitempty"`
+	UseLocalRolesIfPresent bool                               `mapstructure:"use_local_roles_if_present"`
+	UseMutualTLS           string                             `mapstructure:"use_mutual_tls,omitempty"`
+	OutgoingProxy          string                             `mapstructure:"outgoing_proxy,omitempty"`
+}
+
+// SecurityOAuth2ClientJwks describes the JSON web key set used to validate the tokens locally.
+type SecurityOAuth2ClientJwks struct {
+	ProviderURI     string `mapstructure:"provider_uri,omitempty"`
+	RefreshInterval string `mapstructure:"refresh_interval,omitempty"`
+}
+
+// SecurityOAuth2ClientIntrospection describes the endpoint used to validate the tokens remotely.
+type SecurityOAuth2ClientIntrospection struct {
+	EndpointURI string `mapstructure:"endpoint_uri,omitempty"`
+	Interval    string `mapstructure:"interval,omitempty"`
+}
+
+// SecurityOAuth2ClientResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SecurityOAuth2ClientResourceBodyDataModelONTAP struct {
+	Name                   string                             `mapstructure:"name,omitempty"`
+	Application            string                             `mapstructure:"application,omitempty"`
+	Issuer                 string                             `mapstructure:"issuer,omitempty"`
+	Audience               string                             `mapstructure:"audience,omitempty"`
+	ClientID               string                             `mapstructure:"client_id,omitempty"`
+	ClientSecret           string                             `mapstructure:"client_secret,omitempty"`
+	Jwks                   *SecurityOAuth2ClientJwks          `mapstructure:"jwks,omitempty"`
+	Introspection          *SecurityOAuth2ClientIntrospection `mapstructure:"introspection,omitempty"`
+	RemoteUserClaim        string                             `mapstructure:"remote_user_claim,omitempty"`
+	UseLocalRolesIfPresent *bool                              `mapstructure:"use_local_roles_if_present,omitempty"`
+	UseMutualTLS           string                             `mapstructure:"use_mutual_tls,omitempty"`
+	OutgoingProxy          string                             `mapstructure:"outgoing_proxy,omitempty"`
+}
+
+// GetSecurityOAuth2 to get whether OAuth 2.0 authorization is enabled for the cluster
+func GetSecurityOAuth2(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*SecurityOAuth2GetDataModelONTAP, error) {
+	api := "security/authentication/cluster/oauth2"
+	query := r.NewQuery()
+	query.Fields([]string{"enabled"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading OAuth 2.0 info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SecurityOAuth2GetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read OAuth 2.0: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSecurityOAuth2 to enable or disable OAuth 2.0 authorization for the cluster
+func UpdateSecurityOAuth2(errorHandler *utils.ErrorHandler, r restclient.RestClient, enabled bool) error {
+	api := "security/authentication/cluster/oauth2"
+	body := map[string]interface{}{"enabled": enabled}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating OAuth 2.0", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// GetSecurityOAuth2Client to get an OAuth 2.0 authorization server configuration by name, returns nil if it does not exist
+func GetSecurityOAuth2Client(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*SecurityOAuth2ClientGetDataModelONTAP, error) {
+	api := "security/authentication/cluster/oauth2/clients"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "application", "issuer", "audience", "client_id", "jwks", "introspection", "remote_user_claim",
+		"use_local_roles_if_present", "use_mutual_tls", "outgoing_proxy"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading OAuth 2.0 client info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SecurityOAuth2ClientGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read OAuth 2.0 client: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSecurityOAuth2Client to add an OAuth 2.0 authorization server configuration
+func CreateSecurityOAuth2Client(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SecurityOAuth2ClientResourceBodyDataModelONTAP) error {
+	api := "security/authentication/cluster/oauth2/clients"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding OAuth 2.0 client body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating OAuth 2.0 client", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateSecurityOAuth2Client to update the settings of an OAuth 2.0 authorization server configuration that can be modified in place
+func UpdateSecurityOAuth2Client(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, body SecurityOAuth2ClientResourceBodyDataModelONTAP) error {
+	api := "security/authentication/cluster/oauth2/clients/" + name
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding OAuth 2.0 client body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating OAuth 2.0 client", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSecurityOAuth2Client to remove an OAuth 2.0 authorization server configuration
+func DeleteSecurityOAuth2Client(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) error {
+	api := "security/authentication/cluster/oauth2/clients/" + name
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting OAuth 2.0 client", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
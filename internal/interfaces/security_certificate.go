@@ -0,0 +1,65 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityCertificateGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityCertificateGetDataModelONTAP struct {
+	UUID         string            `mapstructure:"uuid"`
+	Name         string            `mapstructure:"name"`
+	CommonName   string            `mapstructure:"common_name"`
+	Type         string            `mapstructure:"type"`
+	SerialNumber string            `mapstructure:"serial_number"`
+	CA           string            `mapstructure:"ca"`
+	ExpiryTime   string            `mapstructure:"expiry_time"`
+	Scope        string            `mapstructure:"scope"`
+	SVM          SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// SecurityCertificateDataSourceFilterModel describes the data source filter model.
+type SecurityCertificateDataSourceFilterModel struct {
+	Name       string `mapstructure:"name,omitempty"`
+	Type       string `mapstructure:"type,omitempty"`
+	CommonName string `mapstructure:"common_name,omitempty"`
+	SVMName    string `mapstructure:"svm.name,omitempty"`
+	Scope      string `mapstructure:"scope,omitempty"`
+}
+
+// GetListSecurityCertificates to get security_certificate info for all certificates matching a filter
+func GetListSecurityCertificates(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SecurityCertificateDataSourceFilterModel) ([]SecurityCertificateGetDataModelONTAP, error) {
+	api := "security/certificates"
+	query := r.NewQuery()
+	query.Fields([]string{"uuid", "name", "common_name", "type", "serial_number", "ca", "expiry_time", "scope", "svm.name", "svm.uuid"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding security_certificate filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading security_certificate info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []SecurityCertificateGetDataModelONTAP
+	for _, info := range response {
+		var record SecurityCertificateGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read security_certificate data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
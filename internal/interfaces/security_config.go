@@ -0,0 +1,108 @@
+package interfaces
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityConfigGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityConfigGetDataModelONTAP struct {
+	Fips SecurityConfigFips `mapstructure:"fips"`
+	TLS  SecurityConfigTLS  `mapstructure:"tls"`
+}
+
+// SecurityConfigFips describes the FIPS settings.
+type SecurityConfigFips struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// SecurityConfigTLS describes the TLS settings.
+type SecurityConfigTLS struct {
+	ProtocolVersions []string `mapstructure:"protocol_versions,omitempty"`
+	CipherSuites     []string `mapstructure:"cipher_suites,omitempty"`
+}
+
+// SecurityConfigResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Only the non nil sections are sent, so FIPS and TLS can be modified in separate calls.
+type SecurityConfigResourceBodyDataModelONTAP struct {
+	Fips *SecurityConfigFips `mapstructure:"fips,omitempty"`
+	TLS  *SecurityConfigTLS  `mapstructure:"tls,omitempty"`
+}
+
+// GetSecurityConfig to get security config info
+func GetSecurityConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*SecurityConfigGetDataModelONTAP, error) {
+	api := "security"
+	query := r.NewQuery()
+	query.Fields([]string{"fips", "tls"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading security config info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SecurityConfigGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read security config: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSecurityConfig to update security config
+// Changing FIPS mode restarts the HTTPS server, so the connection may be reset before a response is received.
+// In that case, we reconnect and wait for the new FIPS state to be reported, up to timeout seconds.
+func UpdateSecurityConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SecurityConfigResourceBodyDataModelONTAP, timeout int) error {
+	api := "security"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding security config body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err == nil {
+		return nil
+	}
+	if body.Fips == nil || statusCode > 0 {
+		// ONTAP answered, this is a real error
+		return errorHandler.MakeAndReportError("error updating security config", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("connection lost on PATCH %s while changing FIPS mode: %s, waiting for reconnection", api, err))
+	if waitErr := waitForSecurityConfigFips(errorHandler, r, body.Fips.Enabled, timeout); waitErr != nil {
+		return errorHandler.MakeAndReportError("error updating security config", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, then %s", api, err, statusCode, waitErr))
+	}
+	return nil
+}
+
+// waitForSecurityConfigFips polls the security config until FIPS mode is reported with the expected value.
+// Errors are expected while the HTTPS server restarts, and are not reported.
+func waitForSecurityConfigFips(errorHandler *utils.ErrorHandler, r restclient.RestClient, enabled bool, timeout int) error {
+	api := "security"
+	query := r.NewQuery()
+	query.Fields([]string{"fips"})
+	var lastErr error
+	for timeRemaining := timeout; timeRemaining > 0; timeRemaining -= 10 {
+		time.Sleep(10 * time.Second)
+		statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+		if err != nil || response == nil {
+			tflog.Debug(errorHandler.Ctx, fmt.Sprintf("waiting for %s, statusCode %d, err: %v", api, statusCode, err))
+			lastErr = err
+			continue
+		}
+		var dataONTAP SecurityConfigGetDataModelONTAP
+		if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+			return fmt.Errorf("failed to decode response from GET %s: %s, response %#v", api, err, response)
+		}
+		if dataONTAP.Fips.Enabled == enabled {
+			return nil
+		}
+		lastErr = fmt.Errorf("fips.enabled is %t, expecting %t", dataONTAP.Fips.Enabled, enabled)
+	}
+	return fmt.Errorf("timed out after %d seconds waiting for FIPS mode change, last error: %v", timeout, lastErr)
+}
@@ -0,0 +1,124 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var securityConfigRecord = SecurityConfigGetDataModelONTAP{
+	Fips: SecurityConfigFips{Enabled: true},
+	TLS: SecurityConfigTLS{
+		ProtocolVersions: []string{"tls1.3", "tls1.2"},
+		CipherSuites:     []string{"TLS_AES_256_GCM_SHA384"},
+	},
+}
+
+var badSecurityConfigRecord = struct{ Fips int }{123}
+
+func TestGetSecurityConfig(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(securityConfigRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badSecurityConfigRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "security", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SecurityConfigGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &securityConfigRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSecurityConfig(errorHandler, *r)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSecurityConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSecurityConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSecurityConfig(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_tls": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "security", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_fips_rest_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "security", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      SecurityConfigResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update_tls", responses: responses["test_update_tls"], body: SecurityConfigResourceBodyDataModelONTAP{TLS: &SecurityConfigTLS{ProtocolVersions: []string{"tls1.2"}}}, wantErr: false},
+		// ONTAP answered with an error, there is no need to wait for a reconnection
+		{name: "test_update_fips_rest_error", responses: responses["test_update_fips_rest_error"], body: SecurityConfigResourceBodyDataModelONTAP{Fips: &SecurityConfigFips{Enabled: true}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateSecurityConfig(errorHandler, *r, tt.body, 600)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSecurityConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
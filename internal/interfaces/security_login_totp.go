@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SecurityLoginTotpGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SecurityLoginTotpGetDataModelONTAP struct {
+	Owner   SvmDataModelONTAP     `mapstructure:"owner"`
+	Account SecurityLoginTotpUser `mapstructure:"account"`
+	Enabled bool                  `mapstructure:"enabled"`
+	Comment string                `mapstructure:"comment,omitempty"`
+	Scope   string                `mapstructure:"scope,omitempty"`
+}
+
+// SecurityLoginTotpUser identifies the account of a TOTP profile.
+type SecurityLoginTotpUser struct {
+	Name string `mapstructure:"name"`
+}
+
+// SecurityLoginTotpCreateDataModelONTAP describes the POST record data model, the secrets are only returned on create.
+type SecurityLoginTotpCreateDataModelONTAP struct {
+	Owner          SvmDataModelONTAP `mapstructure:"owner"`
+	SecretKey      string            `mapstructure:"secret_key"`
+	InstallURL     string            `mapstructure:"install_url"`
+	EmergencyCodes []string          `mapstructure:"emergency_codes"`
+}
+
+// SecurityLoginTotpResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SecurityLoginTotpResourceBodyDataModelONTAP struct {
+	Owner   *SvmDataModelONTAP     `mapstructure:"owner,omitempty"`
+	Account *SecurityLoginTotpUser `mapstructure:"account,omitempty"`
+	Enabled *bool                  `mapstructure:"enabled,omitempty"`
+	Comment *string                `mapstructure:"comment,omitempty"`
+}
+
+// GetSecurityLoginTotp to get the TOTP profile of an account, of an SVM if svmName is set or of the cluster, returns nil if it does not exist
+func GetSecurityLoginTotp(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, accountName string) (*SecurityLoginTotpGetDataModelONTAP, error) {
+	api := "security/login/totps"
+	query := r.NewQuery()
+	query.Set("account.name", accountName)
+	if svmName != "" {
+		query.Set("owner.name", svmName)
+	} else {
+		query.Set("scope", "cluster")
+	}
+	query.Fields([]string{"owner", "account.name", "enabled", "comment", "scope"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading TOTP profile info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SecurityLoginTotpGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read TOTP profile: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSecurityLoginTotp to create the TOTP profile of an account, the secret key and emergency codes are only returned here
+func CreateSecurityLoginTotp(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SecurityLoginTotpResourceBodyDataModelONTAP) (*SecurityLoginTotpCreateDataModelONTAP, error) {
+	api := "security/login/totps"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding TOTP profile body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && len(response.Records) == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating TOTP profile", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SecurityLoginTotpCreateDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from POST %s", api),
+			fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+	return &dataONTAP, nil
+}
+
+// UpdateSecurityLoginTotp to enable or disable the TOTP profile of an account, or change its comment
+func UpdateSecurityLoginTotp(errorHandler *utils.ErrorHandler, r restclient.RestClient, ownerUUID string, accountName string, body SecurityLoginTotpResourceBodyDataModelONTAP) error {
+	api := "security/login/totps/" + ownerUUID + "/" + accountName
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding TOTP profile body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating TOTP profile", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSecurityLoginTotp to delete the TOTP profile of an account
+func DeleteSecurityLoginTotp(errorHandler *utils.ErrorHandler, r restclient.RestClient, ownerUUID string, accountName string) error {
+	api := "security/login/totps/" + ownerUUID + "/" + accountName
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting TOTP profile", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
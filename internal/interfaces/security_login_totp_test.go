@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var securityLoginTotpRecord = SecurityLoginTotpGetDataModelONTAP{
+	Owner:   SvmDataModelONTAP{Name: "cluster4", UUID: "owner-uuid"},
+	Account: SecurityLoginTotpUser{Name: "storage_admin"},
+	Enabled: true,
+	Comment: "managed by terraform",
+	Scope:   "cluster",
+}
+
+var securityLoginTotpCreateRecord = SecurityLoginTotpCreateDataModelONTAP{
+	Owner:          SvmDataModelONTAP{Name: "cluster4", UUID: "owner-uuid"},
+	SecretKey:      "EKBTFGJ6BMHXYYQZ",
+	InstallURL:     "otpauth://totp/storage_admin?secret=EKBTFGJ6BMHXYYQZ",
+	EmergencyCodes: []string{"17503785", "17592371"},
+}
+
+func TestGetSecurityLoginTotp(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(securityLoginTotpRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/login/totps", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/login/totps", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "security/login/totps", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SecurityLoginTotpGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &securityLoginTotpRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSecurityLoginTotp(errorHandler, *r, "", "storage_admin")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSecurityLoginTotp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSecurityLoginTotp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSecurityLoginTotp(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(securityLoginTotpCreateRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "security/login/totps", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_record": {
+			{ExpectedMethod: "POST", ExpectedURL: "security/login/totps", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "security/login/totps", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	body := SecurityLoginTotpResourceBodyDataModelONTAP{Account: &SecurityLoginTotpUser{Name: "storage_admin"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SecurityLoginTotpCreateDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &securityLoginTotpCreateRecord, wantErr: false},
+		{name: "test_no_record", responses: responses["test_no_record"], want: nil, wantErr: true},
+		{name: "test_create_error", responses: responses["test_create_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateSecurityLoginTotp(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSecurityLoginTotp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateSecurityLoginTotp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
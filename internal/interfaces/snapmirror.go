@@ -2,6 +2,9 @@ package interfaces
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -11,9 +14,17 @@ import (
 
 // SnapmirrorGetDataModelONTAP defines the resource get data model
 type SnapmirrorGetDataModelONTAP struct {
-	Healthy bool   `mapstructure:"healthy"`
-	State   string `mapstructure:"state"`
-	UUID    string `mapstructure:"uuid"`
+	Healthy     bool                         `mapstructure:"healthy"`
+	State       string                       `mapstructure:"state"`
+	UUID        string                       `mapstructure:"uuid"`
+	Policy      SnapmirrorRelationshipPolicy `mapstructure:"policy"`
+	Destination SnapmirrorGetEndPoint        `mapstructure:"destination"`
+}
+
+// SnapmirrorGetEndPoint defines the endpoint of a relationship, the UUID is set for an object store endpoint.
+type SnapmirrorGetEndPoint struct {
+	Path string `mapstructure:"path"`
+	UUID string `mapstructure:"uuid"`
 }
 
 // SnapmirrorGetRawDataModelONTAP defines the resource get data model
@@ -26,12 +37,21 @@ type SnapmirrorResourceBodyDataModelONTAP struct {
 	SourceEndPoint      EndPoint          `mapstructure:"source"`
 	DestinationEndPoint EndPoint          `mapstructure:"destination"`
 	CreateDestination   CreateDestination `mapstructure:"create_destination,omitempty"`
+	// eg an identity preserving policy for SVM DR relationships
+	Policy *SnapmirrorRelationshipPolicy `mapstructure:"policy,omitempty"`
+}
+
+// SnapmirrorRelationshipPolicy defines the policy of a relationship, referenced by name.
+type SnapmirrorRelationshipPolicy struct {
+	Name string `mapstructure:"name"`
 }
 
 // EndPoint defines source/destination endpoint data model.
 type EndPoint struct {
 	Cluster Cluster `mapstructure:"cluster,omitempty"`
 	Path    string  `mapstructure:"path"`
+	// UUID of an existing object store endpoint, for SnapMirror Cloud relationships
+	UUID string `mapstructure:"uuid,omitempty"`
 }
 
 // CreateDestination defines CreateDestination data model.
@@ -47,6 +67,10 @@ type Cluster struct {
 // SnapmirrorFilterModel Snapmirror filter model
 type SnapmirrorFilterModel struct {
 	DestinationPath string `mapstructure:"destination.path"`
+	SourcePath      string `mapstructure:"source.path,omitempty"`
+	State           string `mapstructure:"state,omitempty"`
+	// true or false, empty to return all relationships
+	Healthy string `mapstructure:"healthy,omitempty"`
 }
 
 // SnapmirrorDataSourceModel data model
@@ -60,6 +84,25 @@ type SnapmirrorDataSourceModel struct {
 	Policy      SnapmirrorPolicy `mapstructure:"policy"`
 	GroupType   string           `mapstructure:"group_type"`
 	Throttle    int              `mapstructure:"throttle"`
+	// ISO 8601 duration, eg PT8H35M42S
+	LagTime         string                      `mapstructure:"lag_time"`
+	UnhealthyReason []SnapmirrorUnhealthyReason `mapstructure:"unhealthy_reason"`
+	// current or last transfer, not reported until a first transfer starts
+	Transfer *SnapmirrorTransfer `mapstructure:"transfer,omitempty"`
+}
+
+// SnapmirrorTransfer data model
+type SnapmirrorTransfer struct {
+	State            string `mapstructure:"state"`
+	BytesTransferred int64  `mapstructure:"bytes_transferred"`
+	EndTime          string `mapstructure:"end_time"`
+	// ISO 8601 duration, eg PT3M20S
+	TotalDuration string `mapstructure:"total_duration"`
+}
+
+// SnapmirrorUnhealthyReason data model
+type SnapmirrorUnhealthyReason struct {
+	Message string `mapstructure:"message"`
 }
 
 // Source data model
@@ -109,9 +152,11 @@ func GetSnapmirrorByDestinationPath(errorHandler *utils.ErrorHandler, r restclie
 	api := "snapmirror/relationships"
 	query := r.NewQuery()
 	query.Add("destination.path", destinationPath)
-	fields := []string{"destination", "healthy", "source", "restore", "policy", "state"}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "throttle", "group_type")
+	fields := []string{"destination", "healthy", "source", "restore", "policy", "state", "lag_time", "unhealthy_reason", "transfer"}
+	for _, field := range []string{"throttle", "group_type"} {
+		if SupportsField(api, version, field) {
+			fields = append(fields, field)
+		}
 	}
 	query.Fields(fields)
 
@@ -139,16 +184,7 @@ func GetSnapmirrors(errorHandler *utils.ErrorHandler, r restclient.RestClient, f
 	api := "snapmirror/relationships"
 	query := r.NewQuery()
 
-	fields := []string{"unhealthy_reason", "destination", "healthy", "source", "restore", "policy", "transfer", "state", "exported_snapshot", "lag_time"}
-	if version.Generation == 9 && version.Major > 7 {
-		fields = append(fields, "consistency_group_failover")
-	}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "identity_preservation", "throttle", "transfer_schedule", "group_type", "last_transfer_type")
-	}
-	if version.Generation == 9 && version.Major > 12 {
-		fields = append(fields, "total_transfer_duration", "last_transfer_network_compression_ratio", "total_transfer_bytes", "svmdr_volumes")
-	}
+	fields := FieldsForVersion(api, version, []string{"unhealthy_reason", "destination", "healthy", "source", "restore", "policy", "transfer", "state", "exported_snapshot", "lag_time"})
 	query.Fields(fields)
 	if filter != nil {
 		var filterMap map[string]interface{}
@@ -202,20 +238,63 @@ func CreateSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 	return &rawDataONTAP, nil
 }
 
-// InitializeSnapmirror ...
-func InitializeSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, state string) error {
+// InitializeSnapmirror changes the relationship state, the job is polled every interval seconds for up to timeout seconds
+func InitializeSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, state string, timeout int, interval int) error {
 	api := "snapmirror/relationships/" + id
 	body := map[string]interface{}{"state": state}
 	query := r.NewQuery()
 	query.Add("return_records", "true")
-	statusCode, response, err := r.CallUpdateMethod(api, query, body)
+	statusCode, response, err := r.CallAsyncMethod("PATCH", api, query, body)
 	if err != nil {
 		return errorHandler.MakeAndReportError("error initializing snapmirror", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, response %#v", api, err, statusCode, response))
 	}
 
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// UpdateSnapmirrorRelationshipPolicy to change the policy of a relationship
+func UpdateSnapmirrorRelationshipPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, policyName string) error {
+	api := "snapmirror/relationships/" + id
+	body := map[string]interface{}{"policy": map[string]interface{}{"name": policyName}}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating snapmirror policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
 	return nil
 }
 
+// IsSnapmirrorObjectStorePath returns true for an object store endpoint, eg objstore1:/objstore/vol1_backup, as used by SnapMirror Cloud relationships
+func IsSnapmirrorObjectStorePath(path string) bool {
+	return strings.Contains(path, ":/objstore/")
+}
+
+// IsSnapmirrorSvmPath returns true for a SVM endpoint, eg svm1:, as used by SVM DR relationships
+func IsSnapmirrorSvmPath(path string) bool {
+	return strings.HasSuffix(path, ":")
+}
+
+var snapmirrorLagTimeRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseSnapmirrorLagTime converts a lag time returned by ONTAP as an ISO 8601 duration, eg P1DT2H3M4S, to seconds
+func ParseSnapmirrorLagTime(lagTime string) (int64, error) {
+	matches := snapmirrorLagTimeRegexp.FindStringSubmatch(lagTime)
+	if matches == nil || lagTime == "P" || strings.HasSuffix(lagTime, "T") {
+		return 0, fmt.Errorf("unexpected lag time format: %s", lagTime)
+	}
+	var seconds int64
+	for index, unit := range []int64{86400, 3600, 60, 1} {
+		if matches[index+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(matches[index+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unexpected lag time format: %s, %s", lagTime, err)
+		}
+		seconds += value * unit
+	}
+	return seconds, nil
+}
+
 // DeleteSnapmirror to delete ip_interface
 func DeleteSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) error {
 	api := "snapmirror/relationships/" + id
@@ -225,3 +304,43 @@ func DeleteSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 	}
 	return nil
 }
+
+// GetSnapmirrorDestinations to list the destinations of a source path, as seen from the source cluster
+func GetSnapmirrorDestinations(errorHandler *utils.ErrorHandler, r restclient.RestClient, sourcePath string) ([]SnapmirrorDataSourceModel, error) {
+	api := "snapmirror/relationships"
+	query := r.NewQuery()
+	query.Set("list_destinations_only", "true")
+	query.Set("source.path", sourcePath)
+	query.Fields([]string{"uuid", "source.path", "source.svm.name", "destination.path", "destination.svm.name"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snapmirror destinations info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []SnapmirrorDataSourceModel
+	for _, info := range response {
+		var record SnapmirrorDataSourceModel
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snapmirror destinations: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// ReleaseSnapmirror to release a destination on the source cluster, the relationship on the destination is not modified
+func ReleaseSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) error {
+	api := "snapmirror/relationships/" + id
+	query := r.NewQuery()
+	query.Set("source_only", "true")
+	statusCode, _, err := r.CallDeleteMethod(api, query, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error releasing snapmirror/relationships", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
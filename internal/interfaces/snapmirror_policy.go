@@ -168,12 +168,7 @@ func GetSnapmirrorPolicyDataSourceByName(errorHandler *utils.ErrorHandler, r res
 
 	fields := []string{"name", "svm.name", "type", "comment", "transfer_schedule", "network_compression_enabled",
 		"retention", "identity_preservation", "uuid", "create_snapshot_on_source", "transfer_schedule.name", "sync_type"}
-	if version.Generation == 9 && version.Major > 9 {
-		fields = append(fields, "copy_all_source_snapshots")
-	}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "create_snapshot_on_source", "copy_latest_source_snapshot")
-	}
+	fields = FieldsForVersion(api, version, fields)
 	query.Fields(fields)
 
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
@@ -202,12 +197,7 @@ func GetSnapmirrorPolicies(errorHandler *utils.ErrorHandler, r restclient.RestCl
 
 	fields := []string{"name", "svm.name", "type", "comment", "transfer_schedule", "network_compression_enabled",
 		"retention", "identity_preservation", "uuid", "create_snapshot_on_source", "transfer_schedule.name", "sync_type"}
-	if version.Generation == 9 && version.Major > 9 {
-		fields = append(fields, "copy_all_source_snapshots")
-	}
-	if version.Generation == 9 && version.Major > 10 {
-		fields = append(fields, "create_snapshot_on_source", "copy_latest_source_snapshot")
-	}
+	fields = FieldsForVersion(api, version, fields)
 	query.Fields(fields)
 	if filter != nil {
 		var filterMap map[string]interface{}
@@ -277,6 +267,31 @@ func UpdateSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestC
 	return nil
 }
 
+// UpdateSnapmirrorPolicyRetention to replace the retention rules of a snapmirror policy in place, rules can be added, modified, or removed
+// while relationships are using the policy. An empty list removes all the rules.
+func UpdateSnapmirrorPolicyRetention(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, rules []RetentionGetDataModel) error {
+	api := "snapmirror/policies/" + id
+	// prefix and creation_schedule are only sent when set, ONTAP uses its defaults otherwise
+	retention := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		entry := map[string]interface{}{"label": rule.Label, "count": rule.Count}
+		if rule.Prefix != "" {
+			entry["prefix"] = rule.Prefix
+		}
+		if rule.CreationSchedule.Name != "" {
+			entry["creation_schedule"] = map[string]interface{}{"name": rule.CreationSchedule.Name}
+		}
+		retention = append(retention, entry)
+	}
+	body := map[string]interface{}{"retention": retention}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating snapmirror policy retention", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, body %#v", api, err, statusCode, body))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Update snapmirror policy %s retention: %#v", id, retention))
+	return nil
+}
+
 // DeleteSnapmirrorPolicy to delete ip_interface
 func DeleteSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	api := "snapmirror/policies/"
@@ -426,3 +426,49 @@ func TestUpdateSnapmirrorPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateSnapmirrorPolicyRetention(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	rules := []RetentionGetDataModel{
+		{Label: "hourly", Count: 7, CreationSchedule: CreationScheduleModel{Name: "hourly"}},
+		{Label: "weekly", Count: 5, Prefix: "weekly"},
+	}
+	responses := map[string][]restclient.MockResponse{
+		"test_update_rules": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_remove_all_rules": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "snapmirror/policies/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		rules     []RetentionGetDataModel
+		wantErr   bool
+	}{
+		{name: "test_update_rules", responses: responses["test_update_rules"], rules: rules, wantErr: false},
+		{name: "test_remove_all_rules", responses: responses["test_remove_all_rules"], rules: nil, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], rules: rules, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateSnapmirrorPolicyRetention(errorHandler, *r, "1234", tt.rules)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSnapmirrorPolicyRetention() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/mitchellh/mapstructure"
@@ -37,6 +38,12 @@ var snapmirrorRecord = SnapmirrorDataSourceModel{
 	Policy: SnapmirrorPolicy{
 		UUID: "string",
 	},
+	Transfer: &SnapmirrorTransfer{
+		State:            "success",
+		BytesTransferred: 1024,
+		EndTime:          "2024-03-01T10:15:00+01:00",
+		TotalDuration:    "PT3M20S",
+	},
 }
 
 var record911Snapmirror = SnapmirrorDataSourceModel{
@@ -228,3 +235,156 @@ func TestGetSnapmirrors(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSnapmirrorDestinations(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(snapmirrorRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecordSnapmirror, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecordsResponse := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecordsResponse := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/relationships", StatusCode: 200, Response: noRecordsResponse, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/relationships", StatusCode: 200, Response: twoRecordsResponse, Err: nil},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/relationships", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []SnapmirrorDataSourceModel
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []SnapmirrorDataSourceModel{snapmirrorRecord, snapmirrorRecord}, wantErr: false},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSnapmirrorDestinations(errorHandler, *r, "svm1:vol1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSnapmirrorDestinations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSnapmirrorDestinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSnapmirrorRelationshipPolicy(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_policy": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "snapmirror/relationships/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "snapmirror/relationships/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_policy", responses: responses["test_update_policy"], wantErr: false},
+		{name: "test_update_error_1", responses: responses["test_update_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateSnapmirrorRelationshipPolicy(errorHandler, *r, "1234", "MirrorAllSnapshots")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSnapmirrorRelationshipPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsSnapmirrorSvmPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "svm1:", want: true},
+		{path: "svm1:vol1", want: false},
+		{path: "svm1", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsSnapmirrorSvmPath(tt.path); got != tt.want {
+			t.Errorf("IsSnapmirrorSvmPath(%s) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIsSnapmirrorObjectStorePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "objstore1:/objstore/vol1_backup", want: true},
+		{path: "svm1:vol1", want: false},
+		{path: "svm1:", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsSnapmirrorObjectStorePath(tt.path); got != tt.want {
+			t.Errorf("IsSnapmirrorObjectStorePath(%s) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseSnapmirrorLagTime(t *testing.T) {
+	tests := []struct {
+		lagTime string
+		want    int64
+		wantErr bool
+	}{
+		{lagTime: "PT8H35M42S", want: 30942, wantErr: false},
+		{lagTime: "P1DT2H3M4S", want: 93784, wantErr: false},
+		{lagTime: "PT45S", want: 45, wantErr: false},
+		{lagTime: "P2D", want: 172800, wantErr: false},
+		{lagTime: "", want: 0, wantErr: true},
+		{lagTime: "PT", want: 0, wantErr: true},
+		{lagTime: "8H35M", want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSnapmirrorLagTime(tt.lagTime)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSnapmirrorLagTime(%s) error = %v, wantErr %v", tt.lagTime, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSnapmirrorLagTime(%s) = %v, want %v", tt.lagTime, got, tt.want)
+		}
+	}
+}
@@ -19,6 +19,8 @@ type StorageAggregateGetDataModelONTAP struct {
 	DataEncryption AggregateDataEncryption `mapstructure:"data_encryption"`
 	SnaplockType   string                  `mapstructure:"snaplock_type"`
 	State          string                  `mapstructure:"state"`
+	Space          AggregateSpace          `mapstructure:"space"`
+	CloudStorage   AggregateCloudStorage   `mapstructure:"cloud_storage"`
 }
 
 // StorageAggregateGetDataFilterModel describes filter model
@@ -43,6 +45,23 @@ type AggregateBlockStorage struct {
 	Mirror  AggregateBlockStorageMirror  `mapstructure:"mirror"`
 }
 
+// AggregateSpace describes space within StorageAggregateGetDataModelONTAP
+type AggregateSpace struct {
+	BlockStorage AggregateSpaceBlockStorage `mapstructure:"block_storage"`
+}
+
+// AggregateSpaceBlockStorage describes block_storage within AggregateSpace, sizes are in bytes
+type AggregateSpaceBlockStorage struct {
+	Size      int64 `mapstructure:"size"`
+	Available int64 `mapstructure:"available"`
+	Used      int64 `mapstructure:"used"`
+}
+
+// AggregateCloudStorage describes cloud_storage within StorageAggregateGetDataModelONTAP
+type AggregateCloudStorage struct {
+	AttachEligible bool `mapstructure:"attach_eligible"`
+}
+
 // AggregateBlockStorageMirror describes mirror within AggregateBlockStorage
 type AggregateBlockStorageMirror struct {
 	Enabled bool `mapstructure:"enabled"`
@@ -94,7 +113,8 @@ func GetStorageAggregateByName(errorHandler *utils.ErrorHandler, r restclient.Re
 	query := r.NewQuery()
 	query.Set("name", name)
 
-	query.Fields([]string{"name", "node.name", "uuid", "state", "block_storage.primary.disk_class", "block_storage.primary.disk_count", "block_storage.primary.raid_size", "block_storage.primary.raid_type", "block_storage.mirror.enabled", "snaplock_type", "data_encryption.software_encryption_enabled"})
+	query.Fields([]string{"name", "node.name", "uuid", "state", "block_storage.primary.disk_class", "block_storage.primary.disk_count", "block_storage.primary.raid_size", "block_storage.primary.raid_type", "block_storage.mirror.enabled", "snaplock_type", "data_encryption.software_encryption_enabled",
+		"space.block_storage.size", "space.block_storage.available", "space.block_storage.used", "cloud_storage.attach_eligible"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -116,7 +136,8 @@ func GetStorageAggregateByName(errorHandler *utils.ErrorHandler, r restclient.Re
 func GetStorageAggregates(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageAggregateGetDataFilterModel) ([]StorageAggregateGetDataModelONTAP, error) {
 	api := "storage/aggregates"
 	query := r.NewQuery()
-	query.Fields([]string{"name", "node.name", "uuid", "state", "block_storage.primary.disk_class", "block_storage.primary.disk_count", "block_storage.primary.raid_size", "block_storage.primary.raid_type", "block_storage.mirror.enabled", "snaplock_type", "data_encryption.software_encryption_enabled"})
+	query.Fields([]string{"name", "node.name", "uuid", "state", "block_storage.primary.disk_class", "block_storage.primary.disk_count", "block_storage.primary.raid_size", "block_storage.primary.raid_type", "block_storage.mirror.enabled", "snaplock_type", "data_encryption.software_encryption_enabled",
+		"space.block_storage.size", "space.block_storage.available", "space.block_storage.used", "cloud_storage.attach_eligible"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -169,23 +190,21 @@ func CreateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestC
 	return &dataONTAP, nil
 }
 
-// UpdateStorageAggregate updates aggregate
-func UpdateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageAggregateResourceModel, diskSize int, uuid string) error {
+// UpdateStorageAggregate updates aggregate, adding disks runs as a job, polled every interval seconds for up to timeout seconds
+func UpdateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageAggregateResourceModel, diskSize int, uuid string, timeout int, interval int) error {
 	var body map[string]interface{}
 	if err := mapstructure.Decode(data, &body); err != nil {
 		return errorHandler.MakeAndReportError("error encoding aggregate body", fmt.Sprintf("error on encoding storage/aggregates body: %s, body: %#v", err, data))
 	}
 	query := r.NewQuery()
-	query.Add("return_records", "true")
 	if diskSize > 0 {
 		query.Add("disk_size", strconv.Itoa(diskSize))
 	}
-	// API has no option to return records
-	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("storage/aggregates/%s", uuid), query, body)
+	statusCode, response, err := r.CallAsyncMethod("PATCH", fmt.Sprintf("storage/aggregates/%s", uuid), query, body)
 	if err != nil {
 		return errorHandler.MakeAndReportError("error updating aggregate", fmt.Sprintf("error on PATCH storage/aggregates: %s, statusCode %d", err, statusCode))
 	}
-	return nil
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
 }
 
 // DeleteStorageAggregate to delete aggregate
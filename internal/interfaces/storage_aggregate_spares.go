@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageAggregateSparesDataModelONTAP describes the spare disks of a node for a disk class and type.
+type StorageAggregateSparesDataModelONTAP struct {
+	NodeName        string
+	DiskClass       string
+	DiskType        string
+	Count           int64
+	TotalUsableSize int64
+}
+
+// StorageAggregateSparesFilterModel describes the data source filter model.
+type StorageAggregateSparesFilterModel struct {
+	NodeName  string
+	DiskClass string
+}
+
+// GetListStorageAggregateSpares to get the spare disks counts, grouped per node, disk class and disk type
+func GetListStorageAggregateSpares(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageAggregateSparesFilterModel) ([]StorageAggregateSparesDataModelONTAP, error) {
+	diskFilter := StorageDiskDataSourceFilterModel{ContainerType: "spare"}
+	if filter != nil {
+		diskFilter.NodeName = filter.NodeName
+		diskFilter.Class = filter.DiskClass
+	}
+	disks, err := GetListStorageDisks(errorHandler, r, &diskFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*StorageAggregateSparesDataModelONTAP)
+	for _, disk := range disks {
+		key := fmt.Sprintf("%s/%s/%s", disk.Node.Name, disk.Class, disk.Type)
+		group, ok := groups[key]
+		if !ok {
+			group = &StorageAggregateSparesDataModelONTAP{NodeName: disk.Node.Name, DiskClass: disk.Class, DiskType: disk.Type}
+			groups[key] = group
+		}
+		group.Count++
+		group.TotalUsableSize += disk.UsableSize
+	}
+
+	var dataONTAP []StorageAggregateSparesDataModelONTAP
+	for _, group := range groups {
+		dataONTAP = append(dataONTAP, *group)
+	}
+	sort.Slice(dataONTAP, func(i, j int) bool {
+		if dataONTAP[i].NodeName != dataONTAP[j].NodeName {
+			return dataONTAP[i].NodeName < dataONTAP[j].NodeName
+		}
+		if dataONTAP[i].DiskClass != dataONTAP[j].DiskClass {
+			return dataONTAP[i].DiskClass < dataONTAP[j].DiskClass
+		}
+		return dataONTAP[i].DiskType < dataONTAP[j].DiskType
+	})
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read storage aggregate spares data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetListStorageAggregateSpares(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	spares := []StorageDiskGetDataModelONTAP{
+		{Name: "1.0.3", ContainerType: "spare", Type: "ssd", Class: "solid_state", Node: NameDataModel{Name: "node2"}, UsableSize: 200},
+		{Name: "1.0.1", ContainerType: "spare", Type: "fsas", Class: "capacity", Node: NameDataModel{Name: "node1"}, UsableSize: 100},
+		{Name: "1.0.2", ContainerType: "spare", Type: "fsas", Class: "capacity", Node: NameDataModel{Name: "node1"}, UsableSize: 100},
+	}
+	var records []map[string]any
+	for _, spare := range spares {
+		var recordInterface map[string]any
+		err := mapstructure.Decode(spare, &recordInterface)
+		if err != nil {
+			panic(err)
+		}
+		records = append(records, recordInterface)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	threeRecords := restclient.RestResponse{NumRecords: 3, Records: records}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/disks", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_three_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/disks", StatusCode: 200, Response: threeRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/disks", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []StorageAggregateSparesDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_three_records_1", responses: responses["test_three_records_1"], want: []StorageAggregateSparesDataModelONTAP{
+			{NodeName: "node1", DiskClass: "capacity", DiskType: "fsas", Count: 2, TotalUsableSize: 200},
+			{NodeName: "node2", DiskClass: "solid_state", DiskType: "ssd", Count: 1, TotalUsableSize: 200},
+		}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListStorageAggregateSpares(errorHandler, *r, nil)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListStorageAggregateSpares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListStorageAggregateSpares() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
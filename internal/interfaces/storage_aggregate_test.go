@@ -36,6 +36,16 @@ var basicStorageAggregateRecord = StorageAggregateGetDataModelONTAP{
 	},
 	SnaplockType: "non_snaplock",
 	State:        "online",
+	Space: AggregateSpace{
+		BlockStorage: AggregateSpaceBlockStorage{
+			Size:      1073741824,
+			Available: 805306368,
+			Used:      268435456,
+		},
+	},
+	CloudStorage: AggregateCloudStorage{
+		AttachEligible: true,
+	},
 }
 
 // bad record
@@ -121,6 +131,68 @@ func TestGetStorageAggregate(t *testing.T) {
 	}
 }
 
+func TestGetStorageAggregates(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var basicRecordInterface map[string]any
+	err := mapstructure.Decode(basicStorageAggregateRecord, &basicRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badStorageAggregateRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{basicRecordInterface, basicRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []StorageAggregateGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []StorageAggregateGetDataModelONTAP{basicStorageAggregateRecord, basicStorageAggregateRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error_1", responses: responses["test_decode_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageAggregates(errorHandler, *r, &StorageAggregateGetDataFilterModel{Name: "string"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageAggregates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageAggregates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCreateStorageAggregate(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 
@@ -176,6 +248,50 @@ func TestCreateStorageAggregate(t *testing.T) {
 	}
 }
 
+func TestUpdateStorageAggregate(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "PATCH /api/storage/aggregates/1234"}}}
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_add_disks": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/aggregates/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_job_failure": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/aggregates/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("failure"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/aggregates/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	data := StorageAggregateResourceModel{BlockStorage: map[string]interface{}{"primary": map[string]interface{}{"disk_count": 8}}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_add_disks", responses: responses["test_add_disks"], wantErr: false},
+		{name: "test_job_failure", responses: responses["test_job_failure"], wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateStorageAggregate(errorHandler, *r, data, 0, "1234", 10, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStorageAggregate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDeleteStorageAggregate(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageDiskGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageDiskGetDataModelONTAP struct {
+	Name          string        `mapstructure:"name"`
+	UUID          string        `mapstructure:"uuid"`
+	ContainerType string        `mapstructure:"container_type"`
+	Type          string        `mapstructure:"type"`
+	Class         string        `mapstructure:"class"`
+	Model         string        `mapstructure:"model"`
+	SerialNumber  string        `mapstructure:"serial_number"`
+	Node          NameDataModel `mapstructure:"node"`
+	HomeNode      NameDataModel `mapstructure:"home_node"`
+	State         string        `mapstructure:"state"`
+	UsableSize    int64         `mapstructure:"usable_size"`
+	Pool          string        `mapstructure:"pool"`
+	Bay           int64         `mapstructure:"bay"`
+}
+
+// StorageDiskDataSourceFilterModel describes the data source filter model.
+type StorageDiskDataSourceFilterModel struct {
+	Name          string `mapstructure:"name,omitempty"`
+	ContainerType string `mapstructure:"container_type,omitempty"`
+	NodeName      string `mapstructure:"node.name,omitempty"`
+	Class         string `mapstructure:"class,omitempty"`
+}
+
+var storageDiskFields = []string{"name", "uuid", "container_type", "type", "class", "model", "serial_number", "node.name", "node.uuid",
+	"home_node.name", "home_node.uuid", "state", "usable_size", "pool", "bay"}
+
+// GetStorageDiskByName to get a disk by name
+func GetStorageDiskByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*StorageDiskGetDataModelONTAP, error) {
+	api := "storage/disks"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields(storageDiskFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no disk found with name %s", name)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading storage disk info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageDiskGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read storage disk: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListStorageDisks to get disk info for all disks matching a filter
+func GetListStorageDisks(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageDiskDataSourceFilterModel) ([]StorageDiskGetDataModelONTAP, error) {
+	api := "storage/disks"
+	query := r.NewQuery()
+	query.Fields(storageDiskFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding storage disk filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading storage disk info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []StorageDiskGetDataModelONTAP
+	for _, info := range response {
+		var record StorageDiskGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read storage disks data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// AssignStorageDisk to assign the ownership of a disk to a node
+func AssignStorageDisk(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, nodeName string) error {
+	api := "storage/disks/" + name
+	body := map[string]interface{}{"node": map[string]interface{}{"name": nodeName}}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error assigning storage disk", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageLunGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageLunGetDataModelONTAP struct {
+	Name         string              `mapstructure:"name"`
+	UUID         string              `mapstructure:"uuid"`
+	SVM          SvmDataModelONTAP   `mapstructure:"svm"`
+	Location     StorageLunLocation  `mapstructure:"location"`
+	SerialNumber string              `mapstructure:"serial_number"`
+	OsType       string              `mapstructure:"os_type"`
+	Enabled      bool                `mapstructure:"enabled"`
+	Comment      string              `mapstructure:"comment"`
+	Space        StorageLunSpace     `mapstructure:"space"`
+	Status       StorageLunStatus    `mapstructure:"status"`
+	LunMaps      []StorageLunMapInfo `mapstructure:"lun_maps"`
+}
+
+// StorageLunLocation describes the volume and qtree containing a LUN.
+type StorageLunLocation struct {
+	LogicalUnit string        `mapstructure:"logical_unit"`
+	Volume      NameDataModel `mapstructure:"volume"`
+	Qtree       NameDataModel `mapstructure:"qtree"`
+}
+
+// StorageLunSpace describes the size of a LUN.
+type StorageLunSpace struct {
+	Size int64 `mapstructure:"size"`
+	Used int64 `mapstructure:"used"`
+}
+
+// StorageLunStatus describes the state of a LUN.
+type StorageLunStatus struct {
+	State  string `mapstructure:"state"`
+	Mapped bool   `mapstructure:"mapped"`
+}
+
+// StorageLunMapInfo describes an igroup a LUN is mapped to.
+type StorageLunMapInfo struct {
+	Igroup            NameDataModel `mapstructure:"igroup"`
+	LogicalUnitNumber int64         `mapstructure:"logical_unit_number"`
+}
+
+// StorageLunDataSourceFilterModel describes the data source filter model.
+type StorageLunDataSourceFilterModel struct {
+	Name       string `mapstructure:"name,omitempty"`
+	SVMName    string `mapstructure:"svm.name,omitempty"`
+	VolumeName string `mapstructure:"location.volume.name,omitempty"`
+}
+
+var storageLunFields = []string{"name", "uuid", "svm.name", "svm.uuid", "location.logical_unit", "location.volume.name", "location.volume.uuid",
+	"location.qtree.name", "serial_number", "os_type", "enabled", "comment", "space.size", "space.used", "status.state", "status.mapped",
+	"lun_maps.igroup.name", "lun_maps.igroup.uuid", "lun_maps.logical_unit_number"}
+
+// GetStorageLunByName to get a LUN by name, the name is the LUN path, eg /vol/vol1/lun1
+func GetStorageLunByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*StorageLunGetDataModelONTAP, error) {
+	api := "storage/luns"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(storageLunFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no LUN %s found in svm %s", name, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading LUN info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageLunGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read LUN data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetListStorageLuns to get the LUNs matching a filter
+func GetListStorageLuns(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageLunDataSourceFilterModel) ([]StorageLunGetDataModelONTAP, error) {
+	api := "storage/luns"
+	query := r.NewQuery()
+	query.Fields(storageLunFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding LUN filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading LUN info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []StorageLunGetDataModelONTAP
+	for _, info := range response {
+		var record StorageLunGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read LUNs data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// StorageLunResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Only the settings to set are included.
+type StorageLunResourceBodyDataModelONTAP struct {
+	Name    string               `mapstructure:"name,omitempty"`
+	OsType  string               `mapstructure:"os_type,omitempty"`
+	SVM     *SvmDataModelONTAP   `mapstructure:"svm,omitempty"`
+	Space   *StorageLunSpaceBody `mapstructure:"space,omitempty"`
+	Comment *string              `mapstructure:"comment,omitempty"`
+	Enabled *bool                `mapstructure:"enabled,omitempty"`
+	Clone   *StorageLunCloneBody `mapstructure:"clone,omitempty"`
+}
+
+// StorageLunCloneBody describes the LUN to clone on create.
+type StorageLunCloneBody struct {
+	Source StorageLunCloneSource `mapstructure:"source"`
+}
+
+// StorageLunCloneSource describes the path of the LUN to clone, it can be a LUN in a snapshot, eg /vol/vol1/.snapshot/snap1/lun1.
+type StorageLunCloneSource struct {
+	Name string `mapstructure:"name"`
+}
+
+// StorageLunSpaceBody describes the size of a LUN to set.
+type StorageLunSpaceBody struct {
+	Size int64 `mapstructure:"size"`
+}
+
+// GetStorageLun to get a LUN by UUID, returns nil if the LUN is not found
+func GetStorageLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*StorageLunGetDataModelONTAP, error) {
+	api := "storage/luns/" + uuid
+	query := r.NewQuery()
+	query.Fields(storageLunFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("LUN %s not found", uuid))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading LUN info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP StorageLunGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read LUN: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateStorageLun to create a LUN
+func CreateStorageLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, body StorageLunResourceBodyDataModelONTAP) (*StorageLunGetDataModelONTAP, error) {
+	api := "storage/luns"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding LUN body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && response.NumRecords == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating LUN", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageLunGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding LUN info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create LUN: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateStorageLun to resize a LUN, change its comment, or take it online or offline
+func UpdateStorageLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body StorageLunResourceBodyDataModelONTAP) error {
+	api := "storage/luns/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding LUN body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating LUN", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteStorageLun to delete a LUN
+func DeleteStorageLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "storage/luns/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting LUN", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var storageLunRecord = StorageLunGetDataModelONTAP{
+	Name: "/vol/vol1/lun1",
+	UUID: "lun-uuid",
+	SVM:  SvmDataModelONTAP{Name: "svm1", UUID: "svm1-uuid"},
+	Location: StorageLunLocation{
+		LogicalUnit: "lun1",
+		Volume:      NameDataModel{Name: "vol1", UUID: "vol1-uuid"},
+	},
+	SerialNumber: "wCVbY]SZ0OtA",
+	OsType:       "linux",
+	Enabled:      true,
+	Space:        StorageLunSpace{Size: 10737418240, Used: 1073741824},
+	Status:       StorageLunStatus{State: "online", Mapped: true},
+	LunMaps: []StorageLunMapInfo{
+		{Igroup: NameDataModel{Name: "igroup1", UUID: "igroup1-uuid"}, LogicalUnitNumber: 0},
+	},
+}
+
+var badStorageLunRecord = struct{ Name int }{123}
+
+func TestGetStorageLunByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storageLunRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badStorageLunRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StorageLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &storageLunRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageLunByName(errorHandler, *r, "/vol/vol1/lun1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageLunByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageLunByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetListStorageLuns(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storageLunRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []StorageLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []StorageLunGetDataModelONTAP{storageLunRecord, storageLunRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListStorageLuns(errorHandler, *r, &StorageLunDataSourceFilterModel{SVMName: "svm1", VolumeName: "vol1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListStorageLuns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListStorageLuns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStorageLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storageLunRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badStorageLunRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StorageLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_not_found_1", responses: responses["test_not_found_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &storageLunRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageLun(errorHandler, *r, "lun-uuid")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageLun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageLun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateStorageLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	enabled := false
+	body := StorageLunResourceBodyDataModelONTAP{Space: &StorageLunSpaceBody{Size: 21474836480}, Enabled: &enabled}
+	responses := map[string][]restclient.MockResponse{
+		"test_update_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_no_change_1": {},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/luns/lun-uuid", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      StorageLunResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update_1", responses: responses["test_update_1"], body: body, wantErr: false},
+		{name: "test_no_change_1", responses: responses["test_no_change_1"], body: StorageLunResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], body: body, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateStorageLun(errorHandler, *r, "lun-uuid", tt.body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStorageLun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateStorageLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storageLunRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	body := StorageLunResourceBodyDataModelONTAP{
+		Name:  "/vol/vol1/lun1",
+		SVM:   &SvmDataModelONTAP{Name: "svm1"},
+		Clone: &StorageLunCloneBody{Source: StorageLunCloneSource{Name: "/vol/vol1/.snapshot/snap1/lun0"}},
+	}
+	responses := map[string][]restclient.MockResponse{
+		"test_one_record_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/luns", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/luns", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/luns", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StorageLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &storageLunRecord, wantErr: false},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateStorageLun(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateStorageLun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateStorageLun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
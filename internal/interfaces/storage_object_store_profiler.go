@@ -0,0 +1,100 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// The object store profiler is not exposed in the public REST API, the CLI passthrough is used instead.
+const objectStoreProfilerAPI = "private/cli/storage/aggregate/object-store/profiler"
+
+// ObjectStoreProfilerGetDataModelONTAP describes the GET record data model using go types for mapping.
+// The profiler reports one record per operation, PUT and GET.
+type ObjectStoreProfilerGetDataModelONTAP struct {
+	Node             string `mapstructure:"node"`
+	ObjectStoreName  string `mapstructure:"object_store_name"`
+	Status           string `mapstructure:"status"`
+	StartTime        string `mapstructure:"start_time"`
+	OpName           string `mapstructure:"op_name"`
+	OpSize           string `mapstructure:"op_size"`
+	OpCount          int64  `mapstructure:"op_count"`
+	OpFailed         int64  `mapstructure:"op_failed"`
+	OpLatencyMinimum string `mapstructure:"op_latency_minimum"`
+	OpLatencyMaximum string `mapstructure:"op_latency_maximum"`
+	OpLatencyAverage string `mapstructure:"op_latency_average"`
+	OpThroughput     string `mapstructure:"op_throughput"`
+}
+
+// StartObjectStoreProfiler to start the object store profiler from a node
+func StartObjectStoreProfiler(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string, objectStoreName string) error {
+	api := objectStoreProfilerAPI + "/start"
+	body := map[string]interface{}{
+		"node":              nodeName,
+		"object_store_name": objectStoreName,
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error starting object store profiler", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// GetListObjectStoreProfiler to get the object store profiler results for a node and an object store
+func GetListObjectStoreProfiler(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string, objectStoreName string) ([]ObjectStoreProfilerGetDataModelONTAP, error) {
+	api := objectStoreProfilerAPI
+	query := r.NewQuery()
+	query.Set("node", nodeName)
+	query.Set("object_store_name", objectStoreName)
+	query.Fields([]string{"node", "object_store_name", "status", "start_time", "op_name", "op_size", "op_count", "op_failed",
+		"op_latency_minimum", "op_latency_maximum", "op_latency_average", "op_throughput"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading object store profiler info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ObjectStoreProfilerGetDataModelONTAP
+	for _, info := range response {
+		var record ObjectStoreProfilerGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read object store profiler: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// WaitForObjectStoreProfiler polls the profiler results every interval seconds, until no operation is active, up to timeout seconds.
+func WaitForObjectStoreProfiler(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string, objectStoreName string, timeout int, interval int) ([]ObjectStoreProfilerGetDataModelONTAP, error) {
+	for timeRemaining := timeout; ; timeRemaining -= interval {
+		records, err := GetListObjectStoreProfiler(errorHandler, r, nodeName, objectStoreName)
+		if err != nil {
+			return nil, err
+		}
+		active := len(records) == 0
+		for _, record := range records {
+			if strings.EqualFold(record.Status, "active") {
+				active = true
+				break
+			}
+		}
+		if !active {
+			return records, nil
+		}
+		if timeRemaining <= 0 {
+			return nil, errorHandler.MakeAndReportError("error waiting for object store profiler",
+				fmt.Sprintf("timed out after %d seconds waiting for the profiler to complete on node %s for object store %s", timeout, nodeName, objectStoreName))
+		}
+		time.Sleep(time.Duration(interval) * time.Second)
+	}
+}
@@ -0,0 +1,126 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var objectStoreProfilerPutRecord = ObjectStoreProfilerGetDataModelONTAP{
+	Node:             "node1",
+	ObjectStoreName:  "s3_target",
+	Status:           "Done",
+	StartTime:        "11/20/2023 10:00:00",
+	OpName:           "PUT",
+	OpSize:           "4MB",
+	OpCount:          2500,
+	OpFailed:         0,
+	OpLatencyMinimum: "40ms",
+	OpLatencyMaximum: "900ms",
+	OpLatencyAverage: "120ms",
+	OpThroughput:     "85.3MB",
+}
+
+func TestStartObjectStoreProfiler(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_start": {
+			{ExpectedMethod: "POST", ExpectedURL: "private/cli/storage/aggregate/object-store/profiler/start", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_start_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "private/cli/storage/aggregate/object-store/profiler/start", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_start", responses: responses["test_start"], wantErr: false},
+		{name: "test_start_error", responses: responses["test_start_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = StartObjectStoreProfiler(errorHandler, *r, "node1", "s3_target")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StartObjectStoreProfiler() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForObjectStoreProfiler(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(objectStoreProfilerPutRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	activeRecord := objectStoreProfilerPutRecord
+	activeRecord.Status = "Active"
+	var activeRecordInterface map[string]any
+	err = mapstructure.Decode(activeRecord, &activeRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	doneRecords := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	activeRecords := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{activeRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	api := "private/cli/storage/aggregate/object-store/profiler"
+	responses := map[string][]restclient.MockResponse{
+		"test_done": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: doneRecords, Err: nil},
+		},
+		"test_timeout": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: activeRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: doneRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		timeout   int
+		want      []ObjectStoreProfilerGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_done", responses: responses["test_done"], timeout: 1, want: []ObjectStoreProfilerGetDataModelONTAP{objectStoreProfilerPutRecord}, wantErr: false},
+		{name: "test_timeout", responses: responses["test_timeout"], timeout: 0, want: nil, wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], timeout: 1, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := WaitForObjectStoreProfiler(errorHandler, *r, "node1", "s3_target", tt.timeout, 1)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForObjectStoreProfiler() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WaitForObjectStoreProfiler() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
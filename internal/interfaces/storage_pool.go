@@ -0,0 +1,114 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StoragePoolGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StoragePoolGetDataModelONTAP struct {
+	Name        string              `mapstructure:"name"`
+	UUID        string              `mapstructure:"uuid"`
+	Nodes       []NameDataModel     `mapstructure:"nodes"`
+	StorageType string              `mapstructure:"storage_type"`
+	Capacity    StoragePoolCapacity `mapstructure:"capacity"`
+}
+
+// StoragePoolCapacity describes the disks and allocation units of a storage pool.
+type StoragePoolCapacity struct {
+	DiskCount            int64                        `mapstructure:"disk_count"`
+	Total                int64                        `mapstructure:"total"`
+	SpareAllocationUnits []StoragePoolAllocationUnits `mapstructure:"spare_allocation_units"`
+}
+
+// StoragePoolAllocationUnits describes the spare allocation units owned by a node.
+type StoragePoolAllocationUnits struct {
+	Node          NameDataModel `mapstructure:"node"`
+	Count         int64         `mapstructure:"count"`
+	AvailableSize int64         `mapstructure:"available_size"`
+}
+
+// StoragePoolResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type StoragePoolResourceBodyDataModelONTAP struct {
+	Name     string                 `mapstructure:"name,omitempty"`
+	Nodes    []map[string]string    `mapstructure:"nodes,omitempty"`
+	Capacity map[string]interface{} `mapstructure:"capacity,omitempty"`
+}
+
+// StoragePoolSpareAllocationUnits returns the capacity body to assign allocation units to nodes
+func StoragePoolSpareAllocationUnits(units []StoragePoolAllocationUnits) map[string]interface{} {
+	body := make([]map[string]interface{}, len(units))
+	for index, unit := range units {
+		body[index] = map[string]interface{}{
+			"node":  map[string]string{"name": unit.Node.Name},
+			"count": unit.Count,
+		}
+	}
+	return map[string]interface{}{"spare_allocation_units": body}
+}
+
+// GetStoragePoolByName to get storage pool info
+func GetStoragePoolByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*StoragePoolGetDataModelONTAP, error) {
+	api := "storage/pools"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "uuid", "nodes", "storage_type", "capacity.disk_count", "capacity.total", "capacity.spare_allocation_units"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no storage pool found with name %s", name)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading storage pool info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StoragePoolGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read storage pool: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateStoragePool to create a storage pool, ONTAP runs a job so the pool is read back by name
+func CreateStoragePool(errorHandler *utils.ErrorHandler, r restclient.RestClient, body StoragePoolResourceBodyDataModelONTAP) error {
+	api := "storage/pools"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding storage pool body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating storage pool", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create storage pool: %#v", body))
+	return nil
+}
+
+// UpdateStoragePool to add disks to a storage pool, or reassign its allocation units
+func UpdateStoragePool(errorHandler *utils.ErrorHandler, r restclient.RestClient, body StoragePoolResourceBodyDataModelONTAP, uuid string) error {
+	api := "storage/pools/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding storage pool body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating storage pool", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteStoragePool to delete a storage pool, the SSDs become spares
+func DeleteStoragePool(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "storage/pools"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting storage pool", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
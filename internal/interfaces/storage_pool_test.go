@@ -0,0 +1,150 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var storagePoolRecord = StoragePoolGetDataModelONTAP{
+	Name:        "sp1",
+	UUID:        "pool-uuid",
+	Nodes:       []NameDataModel{{Name: "node1", UUID: "node1-uuid"}, {Name: "node2", UUID: "node2-uuid"}},
+	StorageType: "ssd",
+	Capacity: StoragePoolCapacity{
+		DiskCount: 4,
+		Total:     3840000000000,
+		SpareAllocationUnits: []StoragePoolAllocationUnits{
+			{Node: NameDataModel{Name: "node1"}, Count: 2, AvailableSize: 960000000000},
+			{Node: NameDataModel{Name: "node2"}, Count: 2, AvailableSize: 960000000000},
+		},
+	},
+}
+
+var badStoragePoolRecord = struct{ Name int }{123}
+
+func TestGetStoragePoolByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storagePoolRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badStoragePoolRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/pools", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/pools", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/pools", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/pools", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StoragePoolGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &storagePoolRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStoragePoolByName(errorHandler, *r, "sp1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStoragePoolByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStoragePoolByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateStoragePool(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	body := StoragePoolResourceBodyDataModelONTAP{
+		Name:     "sp1",
+		Nodes:    []map[string]string{{"name": "node1"}, {"name": "node2"}},
+		Capacity: map[string]interface{}{"disk_count": 4},
+	}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/pools", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/pools", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_create_error", responses: responses["test_create_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateStoragePool(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateStoragePool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStoragePoolSpareAllocationUnits(t *testing.T) {
+	units := []StoragePoolAllocationUnits{
+		{Node: NameDataModel{Name: "node1"}, Count: 3},
+		{Node: NameDataModel{Name: "node2"}, Count: 1},
+	}
+	want := map[string]interface{}{
+		"spare_allocation_units": []map[string]interface{}{
+			{"node": map[string]string{"name": "node1"}, "count": int64(3)},
+			{"node": map[string]string{"name": "node2"}, "count": int64(1)},
+		},
+	}
+	if got := StoragePoolSpareAllocationUnits(units); !reflect.DeepEqual(got, want) {
+		t.Errorf("StoragePoolSpareAllocationUnits() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// The compliance clock can only be read with the public REST API, the CLI passthrough is used to initialize it.
+const snaplockComplianceClockInitializeAPI = "private/cli/snaplock/compliance-clock/initialize"
+
+// SnaplockComplianceClockGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SnaplockComplianceClockGetDataModelONTAP struct {
+	Node NameDataModel `mapstructure:"node"`
+	// ISO 8601 format, empty when the clock is not initialized
+	Time string `mapstructure:"time"`
+}
+
+// GetSnaplockComplianceClock to get the compliance clock of a node, returns nil if the clock is not initialized
+func GetSnaplockComplianceClock(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string) (*SnaplockComplianceClockGetDataModelONTAP, error) {
+	api := "storage/snaplock/compliance-clocks"
+	query := r.NewQuery()
+	query.Set("node.name", nodeName)
+	query.Fields([]string{"node.name", "node.uuid", "time"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snaplock compliance clock", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SnaplockComplianceClockGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snaplock compliance clock: %#v", dataONTAP))
+	if dataONTAP.Time == "" {
+		return nil, nil
+	}
+	return &dataONTAP, nil
+}
+
+// InitializeSnaplockComplianceClock to initialize the compliance clock of a node to the current time of the node
+func InitializeSnaplockComplianceClock(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string) error {
+	api := snaplockComplianceClockInitializeAPI
+	// force skips the confirmation prompt, the clock can not be changed once initialized
+	body := map[string]interface{}{"node": nodeName, "force": true}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error initializing snaplock compliance clock", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
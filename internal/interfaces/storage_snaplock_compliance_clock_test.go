@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var snaplockComplianceClockRecord = SnaplockComplianceClockGetDataModelONTAP{
+	Node: NameDataModel{Name: "node1", UUID: "node-uuid"},
+	Time: "2024-03-01T10:15:00+01:00",
+}
+
+func TestGetSnaplockComplianceClock(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(snaplockComplianceClockRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	uninitializedRecordInterface := map[string]any{"node": map[string]any{"name": "node1", "uuid": "node-uuid"}}
+	badRecordInterface := map[string]any{"time": 123}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	uninitializedRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{uninitializedRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "storage/snaplock/compliance-clocks"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_uninitialized": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: uninitializedRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SnaplockComplianceClockGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &snaplockComplianceClockRecord, wantErr: false},
+		{name: "test_uninitialized", responses: responses["test_uninitialized"], want: nil, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSnaplockComplianceClock(errorHandler, *r, "node1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSnaplockComplianceClock() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSnaplockComplianceClock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeSnaplockComplianceClock(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_initialize": {
+			{ExpectedMethod: "POST", ExpectedURL: snaplockComplianceClockInitializeAPI, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_initialize_error": {
+			{ExpectedMethod: "POST", ExpectedURL: snaplockComplianceClockInitializeAPI, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_initialize", responses: responses["test_initialize"], wantErr: false},
+		{name: "test_initialize_error", responses: responses["test_initialize_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = InitializeSnaplockComplianceClock(errorHandler, *r, "node1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InitializeSnaplockComplianceClock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
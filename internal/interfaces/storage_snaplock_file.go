@@ -0,0 +1,71 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SnaplockFileGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SnaplockFileGetDataModelONTAP struct {
+	Path string `mapstructure:"path"`
+	// ISO 8601 date-time, or infinite
+	RetentionTime string `mapstructure:"retention_time"`
+	ExpiryTime    string `mapstructure:"expiry_time"`
+	IsExpired     bool   `mapstructure:"is_expired"`
+}
+
+// SnaplockFileResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SnaplockFileResourceBodyDataModelONTAP struct {
+	RetentionTime string `mapstructure:"retention_time"`
+}
+
+// snaplockFileAPI returns the API for a path relative to the volume root, the path is encoded as a single segment, eg dir1%2Ffile1
+func snaplockFileAPI(volumeUUID string, path string) string {
+	return "storage/snaplock/file/" + volumeUUID + "/" + url.PathEscape(path)
+}
+
+// GetSnaplockFile to get the retention of a file in a SnapLock volume, returns nil if the file does not exist
+func GetSnaplockFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string) (*SnaplockFileGetDataModelONTAP, error) {
+	api := snaplockFileAPI(volumeUUID, path)
+	query := r.NewQuery()
+	query.Fields([]string{"path", "retention_time", "expiry_time", "is_expired"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("snaplock file %s not found", path))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snaplock file retention", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SnaplockFileGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snaplock file: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSnaplockFile to set the retention time of a file in a SnapLock volume, the file is committed to WORM if needed.
+// The retention time can only be extended.
+func UpdateSnaplockFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string, body SnaplockFileResourceBodyDataModelONTAP) error {
+	api := snaplockFileAPI(volumeUUID, path)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snaplock file body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating snaplock file retention", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SnaplockLitigationGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SnaplockLitigationGetDataModelONTAP struct {
+	// <volume uuid>:<litigation name>
+	ID     string            `mapstructure:"id"`
+	Name   string            `mapstructure:"name"`
+	Volume SnaplockVolume    `mapstructure:"volume"`
+	SVM    SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// SnaplockVolume describes a volume, referenced by name.
+type SnaplockVolume struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// SnaplockLitigationResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SnaplockLitigationResourceBodyDataModelONTAP struct {
+	Name   string            `mapstructure:"name"`
+	Path   string            `mapstructure:"path"`
+	Volume SnaplockVolume    `mapstructure:"volume"`
+	SVM    SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// GetSnaplockLitigation to get a litigation by name, returns nil if the litigation is not found
+func GetSnaplockLitigation(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, volumeName string, svmName string) (*SnaplockLitigationGetDataModelONTAP, error) {
+	api := "storage/snaplock/litigations"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("volume.name", volumeName)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"id", "name", "volume.name", "volume.uuid", "svm.name", "svm.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snaplock litigation", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SnaplockLitigationGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snaplock litigation: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSnaplockLitigation to place a legal hold on a path, the job is polled every interval seconds for up to timeout seconds
+func CreateSnaplockLitigation(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SnaplockLitigationResourceBodyDataModelONTAP, timeout int, interval int) error {
+	api := "storage/snaplock/litigations"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snaplock litigation body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, response, err := r.CallAsyncMethod("POST", api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating snaplock litigation", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// DeleteSnaplockLitigation to release all the legal holds of a litigation, the job is polled every interval seconds for up to timeout seconds
+func DeleteSnaplockLitigation(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string, timeout int, interval int) error {
+	// the id contains a colon, eg <volume uuid>:<litigation name>
+	api := "storage/snaplock/litigations/" + url.PathEscape(id)
+	statusCode, response, err := r.CallAsyncMethod("DELETE", api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting snaplock litigation", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
@@ -0,0 +1,167 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var snaplockLitigationRecord = SnaplockLitigationGetDataModelONTAP{
+	ID:     "volume-uuid:case1",
+	Name:   "case1",
+	Volume: SnaplockVolume{Name: "vol_worm", UUID: "volume-uuid"},
+	SVM:    SvmDataModelONTAP{Name: "svm1", UUID: "svm-uuid"},
+}
+
+func TestGetSnaplockLitigation(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(snaplockLitigationRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"id": 123}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "storage/snaplock/litigations"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SnaplockLitigationGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &snaplockLitigationRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSnaplockLitigation(errorHandler, *r, "case1", "vol_worm", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSnaplockLitigation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSnaplockLitigation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSnaplockLitigation(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "POST /api/storage/snaplock/litigations"}}}
+	}
+	genericError := errors.New("generic error for UT")
+	api := "storage/snaplock/litigations"
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_job_failure": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("failure"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "POST", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := SnaplockLitigationResourceBodyDataModelONTAP{
+		Name:   "case1",
+		Path:   "/",
+		Volume: SnaplockVolume{Name: "vol_worm"},
+		SVM:    SvmDataModelONTAP{Name: "svm1"},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_job_failure", responses: responses["test_job_failure"], wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateSnaplockLitigation(errorHandler, *r, body, 10, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSnaplockLitigation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteSnaplockLitigation(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobSuccess := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": "success"}}}
+	genericError := errors.New("generic error for UT")
+	api := "storage/snaplock/litigations/volume-uuid:case1"
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobSuccess, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteSnaplockLitigation(errorHandler, *r, "volume-uuid:case1", 10, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteSnaplockLitigation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
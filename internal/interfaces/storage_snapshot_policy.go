@@ -17,6 +17,7 @@ type SnapshotPolicyGetDataModelONTAP struct {
 	Copies  []CopyType `mapstructure:"copies"`
 	Comment string     `mapstructure:"comment,omitempty"`
 	Enabled bool       `mapstructure:"enabled"`
+	Scope   string     `mapstructure:"scope,omitempty"`
 }
 
 // SnapshotPolicyResourceBodyDataModelONTAP describes the body data model using go types for mapping.
@@ -77,12 +78,15 @@ func GetSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	return &dataONTAP, nil
 }
 
-// GetSnapshotPolicyByName to get storage_snapshot_policy info
-func GetSnapshotPolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*SnapshotPolicyGetDataModelONTAP, error) {
+// GetSnapshotPolicyByName to get storage_snapshot_policy info, svmName is optional and disambiguates policies with the same name
+func GetSnapshotPolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*SnapshotPolicyGetDataModelONTAP, error) {
 	api := "storage/snapshot-policies"
 	query := r.NewQuery()
 	query.Set("name", name)
-	query.Fields([]string{"name", "svm.name", "copies", "scope", "enabled", "comment"})
+	if svmName != "" {
+		query.Set("svm.name", svmName)
+	}
+	query.Fields([]string{"name", "svm.name", "copies", "scope", "enabled", "comment", "uuid"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -104,7 +108,7 @@ func GetSnapshotPolicyByName(errorHandler *utils.ErrorHandler, r restclient.Rest
 func GetSnapshotPolicies(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SnapshotPolicyGetDataFilterModel) ([]SnapshotPolicyGetDataModelONTAP, error) {
 	api := "storage/snapshot-policies"
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "copies", "scope", "enabled", "comment"})
+	query.Fields([]string{"name", "svm.name", "copies", "scope", "enabled", "comment", "uuid"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -27,6 +27,7 @@ var basicSnapshotPolicyRecord = SnapshotPolicyGetDataModelONTAP{
 	},
 	Comment: "string",
 	Enabled: true,
+	Scope:   "svm",
 }
 
 // two copies snapshot policy
@@ -480,7 +481,7 @@ func TestGetSnapshotPolicyByName(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetSnapshotPolicyByName(errorHandler, *r, "string")
+			got, err := GetSnapshotPolicyByName(errorHandler, *r, "string", "")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
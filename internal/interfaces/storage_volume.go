@@ -1,6 +1,7 @@
 package interfaces
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -31,6 +32,8 @@ type StorageVolumeGetDataModelONTAP struct {
 	Language       string
 	Aggregates     []Aggregate
 	UUID           string
+	// SnapshotDirectoryAccessEnabled is nil when not supported by the cluster version
+	SnapshotDirectoryAccessEnabled *bool `mapstructure:"snapshot_directory_access_enabled"`
 }
 
 // StorageVolumeResourceModel describes the resource data model.
@@ -52,6 +55,8 @@ type StorageVolumeResourceModel struct {
 	Analytics      Analytics                `mapstructure:"analytics,omitempty"`
 	Language       string                   `mapstructure:"language,omitempty"`
 	Aggregates     []map[string]interface{} `mapstructure:"aggregates,omitempty"`
+	// a pointer, so that false can be set
+	SnapshotDirectoryAccessEnabled *bool `mapstructure:"snapshot_directory_access_enabled,omitempty"`
 }
 
 // Aggregate describes the resource data model.
@@ -86,6 +91,17 @@ type Efficiency struct {
 // Snaplock describes the resource data model.
 type Snaplock struct {
 	Type string `mapstructure:"type,omitempty"`
+	// a pointer, so that the retention is not sent when not set
+	Retention *SnaplockRetention `mapstructure:"retention,omitempty"`
+	// none, or an ISO 8601 duration, eg PT4H
+	AutocommitPeriod string `mapstructure:"autocommit_period,omitempty"`
+}
+
+// SnaplockRetention describes the resource data model, with ISO 8601 durations, eg P30Y, or infinite.
+type SnaplockRetention struct {
+	Default string `mapstructure:"default,omitempty"`
+	Minimum string `mapstructure:"minimum,omitempty"`
+	Maximum string `mapstructure:"maximum,omitempty"`
 }
 
 // Policy describes the resource data model.
@@ -174,8 +190,10 @@ var POW2BYTEMAP = map[string]int{
 
 // StorageVolumeDataSourceFilterModel describes the data source data model for queries.
 type StorageVolumeDataSourceFilterModel struct {
-	Name    string `mapstructure:"name"`
-	SVMName string `mapstructure:"svm.name"`
+	Name          string `mapstructure:"name"`
+	SVMName       string `mapstructure:"svm.name"`
+	State         string `mapstructure:"state,omitempty"`
+	TieringPolicy string `mapstructure:"tiering.policy,omitempty"`
 }
 
 // GetUUIDVolumeByName get a volumes UUID by volume name
@@ -206,11 +224,12 @@ func GetUUIDVolumeByName(errorHandler *utils.ErrorHandler, r restclient.RestClie
 }
 
 // GetStorageVolume to get volume info by uuid
-func GetStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*StorageVolumeGetDataModelONTAP, error) {
+func GetStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, version versionModelONTAP) (*StorageVolumeGetDataModelONTAP, error) {
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "aggregates", "space.size", "state", "type", "nas.export_policy.name", "nas.path", "guarantee.type", "space.snapshot.reserve_percent",
+	fields := []string{"name", "svm.name", "aggregates", "space.size", "state", "type", "nas.export_policy.name", "nas.path", "guarantee.type", "space.snapshot.reserve_percent",
 		"nas.security_style", "encryption.enabled", "efficiency.policy.name", "nas.unix_permissions", "nas.gid", "nas.uid", "snapshot_policy.name", "language", "qos.policy.name",
-		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state"})
+		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "snaplock.retention", "snaplock.autocommit_period", "analytics.state"}
+	query.Fields(FieldsForVersion("storage/volumes", version, fields))
 	statusCode, response, err := r.GetNilOrOneRecord("storage/volumes/"+uuid, query, nil)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error reading volume info", fmt.Sprintf("error on GET storage/volumes: %s", err))
@@ -232,7 +251,7 @@ func GetStorageVolumeByName(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Add("return_records", "true")
 	query.Fields([]string{"name", "svm.name", "aggregates", "space.size", "state", "type", "nas.export_policy.name", "nas.path", "guarantee.type", "space.snapshot.reserve_percent",
 		"nas.security_style", "encryption.enabled", "efficiency.policy.name", "nas.unix_permissions", "nas.gid", "nas.uid", "snapshot_policy.name", "language", "qos.policy.name",
-		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state"})
+		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "snaplock.retention", "snaplock.autocommit_period", "analytics.state"})
 	statusCode, response, err := r.GetNilOrOneRecord("storage/volumes", query, nil)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error reading volume info by name", fmt.Sprintf("error on GET storage/volumes: %s", err))
@@ -256,7 +275,7 @@ func GetStorageVolumes(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	query := r.NewQuery()
 	query.Fields([]string{"name", "svm.name", "aggregates", "space.size", "state", "type", "nas.export_policy.name", "nas.path", "guarantee.type", "space.snapshot.reserve_percent",
 		"nas.security_style", "encryption.enabled", "efficiency.policy.name", "nas.unix_permissions", "nas.gid", "nas.uid", "snapshot_policy.name", "language", "qos.policy.name",
-		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state"})
+		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "snaplock.retention", "snaplock.autocommit_period", "analytics.state"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -286,18 +305,48 @@ func GetStorageVolumes(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	return dataONTAP, nil
 }
 
-// CreateStorageVolume to create volume
-func CreateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeResourceModel) (*StorageVolumeGetDataModelONTAP, error) {
+// unexpectedArgumentErrorCode is reported by ONTAP for an unknown query parameter, eg validate_only on older releases
+const unexpectedArgumentErrorCode = "262179"
+
+// ValidateStorageVolume sends the create request with validate_only, ONTAP checks it without creating the volume
+// It returns false if the cluster does not support validate_only for volumes
+func ValidateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeResourceModel) (bool, error) {
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return false, errorHandler.MakeAndReportError("error encoding volume body", fmt.Sprintf("error on encoding storage/volumes body: %s, body: %#v", err, data))
+	}
+	query := r.NewQuery()
+	query.Add("validate_only", "true")
+	statusCode, _, err := r.CallCreateMethod("storage/volumes", query, body)
+	var ontapErr *restclient.ONTAPError
+	if errors.As(err, &ontapErr) && ontapErr.Code == unexpectedArgumentErrorCode {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("validate_only is not supported for storage/volumes: %s", err))
+		return false, nil
+	}
+	if err != nil {
+		return false, errorHandler.MakeAndReportError("error validating volume", fmt.Sprintf("error on POST storage/volumes with validate_only: %s, statusCode %d", err, statusCode))
+	}
+	return true, nil
+}
+
+// CreateStorageVolume to create volume, the create job is polled every interval seconds for up to timeout seconds
+func CreateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeResourceModel, timeout int, interval int) (*StorageVolumeGetDataModelONTAP, error) {
 	var body map[string]interface{}
 	if err := mapstructure.Decode(data, &body); err != nil {
 		return nil, errorHandler.MakeAndReportError("error encoding volume body", fmt.Sprintf("error on encoding storage/volumes body: %s, body: %#v", err, data))
 	}
 	query := r.NewQuery()
 	query.Add("return_records", "true")
-	statusCode, response, err := r.CallCreateMethod("storage/volumes", query, body)
+	statusCode, response, err := r.CallAsyncMethod("POST", "storage/volumes", query, body)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("error on POST storage/volumes: %s, statusCode %d", err, statusCode))
 	}
+	if err := WaitForJobs(errorHandler, r, response, timeout, interval); err != nil {
+		return nil, err
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("no record returned on POST storage/volumes, statusCode %d", statusCode))
+	}
 
 	var dataONTAP StorageVolumeGetDataModelONTAP
 	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
@@ -307,15 +356,55 @@ func CreateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClie
 	return &dataONTAP, nil
 }
 
-// DeleteStorageVolume to delete volume
-func DeleteStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
-	statusCode, _, err := r.CallDeleteMethod("storage/volumes/"+uuid, nil, nil)
+// DeleteStorageVolume to delete volume, the delete job is polled every interval seconds for up to timeout seconds
+func DeleteStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, timeout int, interval int) error {
+	statusCode, response, err := r.CallAsyncMethod("DELETE", "storage/volumes/"+uuid, nil, nil)
 	if err != nil {
 		return errorHandler.MakeAndReportError("error deleting volume", fmt.Sprintf("error on DELETE storage/volumes: %s, statusCode %d", err, statusCode))
 	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// UpdateStorageVolumeState changes the state of a volume: online, offline, or restricted
+func UpdateStorageVolumeState(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, state string) error {
+	body := map[string]interface{}{"state": state}
+	statusCode, _, err := r.CallUpdateMethod("storage/volumes/"+uuid, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating volume state", fmt.Sprintf("error on PATCH storage/volumes/%s state %s: %s, statusCode %d", uuid, state, err, statusCode))
+	}
+	return nil
+}
+
+// UnmountStorageVolume removes the junction path of a volume, a volume is unmounted before it is taken offline
+func UnmountStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	body := map[string]interface{}{"nas": map[string]interface{}{"path": ""}}
+	statusCode, _, err := r.CallUpdateMethod("storage/volumes/"+uuid, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error unmounting volume", fmt.Sprintf("error on PATCH storage/volumes/%s nas.path: %s, statusCode %d", uuid, err, statusCode))
+	}
 	return nil
 }
 
+// MoveStorageVolume moves a volume to another aggregate, the move job is polled every interval seconds for up to timeout seconds
+func MoveStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, aggregate string, timeout int, interval int) error {
+	body := map[string]interface{}{"movement": map[string]interface{}{"destination_aggregate": map[string]interface{}{"name": aggregate}}}
+	statusCode, response, err := r.CallAsyncMethod("PATCH", "storage/volumes/"+uuid, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error moving volume", fmt.Sprintf("error on PATCH storage/volumes/%s movement to aggregate %s: %s, statusCode %d", uuid, aggregate, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
+// SplitStorageVolumeClone splits a FlexClone volume from its parent, the split job is polled every interval seconds for up to timeout seconds
+func SplitStorageVolumeClone(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, timeout int, interval int) error {
+	body := map[string]interface{}{"clone": map[string]interface{}{"split_initiated": true}}
+	statusCode, response, err := r.CallAsyncMethod("PATCH", "storage/volumes/"+uuid, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error splitting volume clone", fmt.Sprintf("error on PATCH storage/volumes/%s clone.split_initiated: %s, statusCode %d", uuid, err, statusCode))
+	}
+	return WaitForJobs(errorHandler, r, response, timeout, interval)
+}
+
 // UpddateStorageVolume to update volume
 func UpddateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeResourceModel, ID string) error {
 	var body map[string]interface{}
@@ -0,0 +1,154 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// VolumeComplianceGetDataModelONTAP describes the volume fields used to report compliance gaps.
+type VolumeComplianceGetDataModelONTAP struct {
+	Name           string              `mapstructure:"name"`
+	NAS            VolumeComplianceNAS `mapstructure:"nas"`
+	AntiRansomware VolumeComplianceARP `mapstructure:"anti_ransomware"`
+}
+
+// VolumeComplianceNAS describes the junction path of a volume.
+type VolumeComplianceNAS struct {
+	Path string `mapstructure:"path"`
+}
+
+// VolumeComplianceARP describes the autonomous ransomware protection state of a volume.
+type VolumeComplianceARP struct {
+	State string `mapstructure:"state"`
+}
+
+// VscanComplianceGetDataModelONTAP describes the vscan configuration of a SVM.
+type VscanComplianceGetDataModelONTAP struct {
+	Enabled          bool                  `mapstructure:"enabled"`
+	OnAccessPolicies []VscanOnAccessPolicy `mapstructure:"on_access_policies"`
+}
+
+// VscanOnAccessPolicy describes an on-access policy and the paths it excludes.
+type VscanOnAccessPolicy struct {
+	Name    string                   `mapstructure:"name"`
+	Enabled bool                     `mapstructure:"enabled"`
+	Scope   VscanOnAccessPolicyScope `mapstructure:"scope"`
+}
+
+// VscanOnAccessPolicyScope describes the scope of an on-access policy.
+type VscanOnAccessPolicyScope struct {
+	ExcludePaths []string `mapstructure:"exclude_paths"`
+}
+
+// AuditComplianceGetDataModelONTAP describes the NAS auditing configuration of a SVM.
+type AuditComplianceGetDataModelONTAP struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// GetListVolumeCompliance to get the read-write volumes of a SVM, with their junction path and ARP state
+func GetListVolumeCompliance(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) ([]VolumeComplianceGetDataModelONTAP, error) {
+	api := "storage/volumes"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("type", "rw")
+	// the SVM root volume is not exposed to clients
+	query.Set("is_svm_root", "false")
+	query.Fields([]string{"name", "nas.path", "anti_ransomware.state"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading volumes compliance info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []VolumeComplianceGetDataModelONTAP
+	for _, info := range response {
+		var record VolumeComplianceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volumes compliance: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// GetVscanCompliance to get the vscan configuration of a SVM, nil if vscan is not configured
+func GetVscanCompliance(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*VscanComplianceGetDataModelONTAP, error) {
+	api := "protocols/vscan"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"enabled", "on_access_policies.name", "on_access_policies.enabled", "on_access_policies.scope.exclude_paths"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading vscan info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP VscanComplianceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read vscan: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetAuditCompliance to get the NAS auditing configuration of a SVM, nil if auditing is not configured
+func GetAuditCompliance(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*AuditComplianceGetDataModelONTAP, error) {
+	api := "protocols/audit"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"enabled"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading audit info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP AuditComplianceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read audit: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// IsVolumeCoveredByVscan returns true if vscan is enabled and an enabled on-access policy does not exclude the junction path of the volume.
+// Volumes without a junction path can not be accessed by NAS clients, and are reported as covered.
+func IsVolumeCoveredByVscan(vscan *VscanComplianceGetDataModelONTAP, volume VolumeComplianceGetDataModelONTAP) bool {
+	if vscan == nil || !vscan.Enabled {
+		return false
+	}
+	if volume.NAS.Path == "" {
+		return true
+	}
+	for _, policy := range vscan.OnAccessPolicies {
+		if !policy.Enabled {
+			continue
+		}
+		excluded := false
+		for _, path := range policy.Scope.ExcludePaths {
+			if path == volume.NAS.Path || strings.HasPrefix(volume.NAS.Path, strings.TrimSuffix(path, "/")+"/") {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return true
+		}
+	}
+	return false
+}
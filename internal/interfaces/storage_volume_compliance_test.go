@@ -0,0 +1,99 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetVscanCompliance(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := VscanComplianceGetDataModelONTAP{
+		Enabled:          true,
+		OnAccessPolicies: []VscanOnAccessPolicy{{Name: "default_CIFS", Enabled: true, Scope: VscanOnAccessPolicyScope{ExcludePaths: []string{"/scratch"}}}},
+	}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(record, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/vscan", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/vscan", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/vscan", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *VscanComplianceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &record, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetVscanCompliance(errorHandler, *r, "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetVscanCompliance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetVscanCompliance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsVolumeCoveredByVscan(t *testing.T) {
+	vscan := &VscanComplianceGetDataModelONTAP{
+		Enabled:          true,
+		OnAccessPolicies: []VscanOnAccessPolicy{{Name: "default_CIFS", Enabled: true, Scope: VscanOnAccessPolicyScope{ExcludePaths: []string{"/scratch"}}}},
+	}
+	disabled := &VscanComplianceGetDataModelONTAP{Enabled: false, OnAccessPolicies: vscan.OnAccessPolicies}
+	tests := []struct {
+		name   string
+		vscan  *VscanComplianceGetDataModelONTAP
+		volume VolumeComplianceGetDataModelONTAP
+		want   bool
+	}{
+		{name: "not_configured", vscan: nil, volume: VolumeComplianceGetDataModelONTAP{Name: "vol1", NAS: VolumeComplianceNAS{Path: "/vol1"}}, want: false},
+		{name: "disabled", vscan: disabled, volume: VolumeComplianceGetDataModelONTAP{Name: "vol1", NAS: VolumeComplianceNAS{Path: "/vol1"}}, want: false},
+		{name: "covered", vscan: vscan, volume: VolumeComplianceGetDataModelONTAP{Name: "vol1", NAS: VolumeComplianceNAS{Path: "/vol1"}}, want: true},
+		{name: "excluded", vscan: vscan, volume: VolumeComplianceGetDataModelONTAP{Name: "scratch", NAS: VolumeComplianceNAS{Path: "/scratch"}}, want: false},
+		{name: "excluded_parent", vscan: vscan, volume: VolumeComplianceGetDataModelONTAP{Name: "tmp", NAS: VolumeComplianceNAS{Path: "/scratch/tmp"}}, want: false},
+		{name: "prefix_only", vscan: vscan, volume: VolumeComplianceGetDataModelONTAP{Name: "scratch2", NAS: VolumeComplianceNAS{Path: "/scratch2"}}, want: true},
+		{name: "not_mounted", vscan: vscan, volume: VolumeComplianceGetDataModelONTAP{Name: "lun_vol"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVolumeCoveredByVscan(tt.vscan, tt.volume); got != tt.want {
+				t.Errorf("IsVolumeCoveredByVscan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageVolumeFileGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageVolumeFileGetDataModelONTAP struct {
+	Path            string `mapstructure:"path"`
+	Type            string `mapstructure:"type"`
+	UnixPermissions int64  `mapstructure:"unix_permissions"`
+	OwnerID         int64  `mapstructure:"owner_id"`
+	GroupID         int64  `mapstructure:"group_id"`
+}
+
+// StorageVolumeFileResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+// Only the settings to set are included.
+type StorageVolumeFileResourceBodyDataModelONTAP struct {
+	Type            string `mapstructure:"type,omitempty"`
+	UnixPermissions *int64 `mapstructure:"unix_permissions,omitempty"`
+	OwnerID         *int64 `mapstructure:"owner_id,omitempty"`
+	GroupID         *int64 `mapstructure:"group_id,omitempty"`
+}
+
+// storageVolumeFileAPI returns the API for a path relative to the volume root, the path is encoded as a single segment, eg dir1%2Fdir2
+func storageVolumeFileAPI(volumeUUID string, path string) string {
+	return "storage/volumes/" + volumeUUID + "/files/" + url.PathEscape(path)
+}
+
+// GetStorageVolumeFile to get the metadata of a file or directory in a volume, returns nil if it does not exist
+func GetStorageVolumeFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string) (*StorageVolumeFileGetDataModelONTAP, error) {
+	api := storageVolumeFileAPI(volumeUUID, path)
+	query := r.NewQuery()
+	// without return_metadata, the content of the directory is returned
+	query.Set("return_metadata", "true")
+	query.Fields([]string{"path", "type", "unix_permissions", "owner_id", "group_id"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if statusCode == 404 {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("file %s not found", path))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading volume file info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP StorageVolumeFileGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volume file: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateStorageVolumeFile to create a file or directory in a volume, the parent directory must exist
+func CreateStorageVolumeFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string, body StorageVolumeFileResourceBodyDataModelONTAP) error {
+	api := storageVolumeFileAPI(volumeUUID, path)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding volume file body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating volume file", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateStorageVolumeFile to change the UNIX permissions or ownership of a file or directory in a volume
+func UpdateStorageVolumeFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string, body StorageVolumeFileResourceBodyDataModelONTAP) error {
+	api := storageVolumeFileAPI(volumeUUID, path)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding volume file body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	if len(bodyMap) == 0 {
+		return nil
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating volume file", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteStorageVolumeFile to delete a file or directory in a volume, a directory must be empty unless recurse is set
+func DeleteStorageVolumeFile(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, path string, recurse bool) error {
+	api := storageVolumeFileAPI(volumeUUID, path)
+	var query *restclient.RestQuery
+	if recurse {
+		query = r.NewQuery()
+		query.Set("recurse", "true")
+	}
+	statusCode, _, err := r.CallDeleteMethod(api, query, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting volume file", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var storageVolumeFileRecord = StorageVolumeFileGetDataModelONTAP{
+	Path:            "dir1/dir2",
+	Type:            "directory",
+	UnixPermissions: 755,
+	OwnerID:         1001,
+	GroupID:         100,
+}
+
+var badStorageVolumeFileRecord = struct{ Path int }{123}
+
+func TestGetStorageVolumeFile(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(storageVolumeFileRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badStorageVolumeFileRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	api := "storage/volumes/vol-uuid/files/dir1%2Fdir2"
+	responses := map[string][]restclient.MockResponse{
+		"test_not_found": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StorageVolumeFileGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_not_found", responses: responses["test_not_found"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &storageVolumeFileRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageVolumeFile(errorHandler, *r, "vol-uuid", "dir1/dir2")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageVolumeFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageVolumeFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateStorageVolumeFile(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "storage/volumes/vol-uuid/files/dir1%2Fdir2"
+	permissions := int64(750)
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_no_change": {},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		body      StorageVolumeFileResourceBodyDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], body: StorageVolumeFileResourceBodyDataModelONTAP{UnixPermissions: &permissions}, wantErr: false},
+		{name: "test_no_change", responses: responses["test_no_change"], body: StorageVolumeFileResourceBodyDataModelONTAP{}, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], body: StorageVolumeFileResourceBodyDataModelONTAP{UnixPermissions: &permissions}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateStorageVolumeFile(errorHandler, *r, "vol-uuid", "dir1/dir2", tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStorageVolumeFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteStorageVolumeFile(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	api := "storage/volumes/vol-uuid/files/dir1%2Fdir2"
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_delete_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: api, StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		recurse   bool
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], recurse: true, wantErr: false},
+		{name: "test_delete_error", responses: responses["test_delete_error"], recurse: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteStorageVolumeFile(errorHandler, *r, "vol-uuid", "dir1/dir2", tt.recurse)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteStorageVolumeFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
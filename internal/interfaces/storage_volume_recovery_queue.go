@@ -0,0 +1,102 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// The volume recovery queue is not available with the public REST API, the CLI passthrough is used instead.
+const volumeRecoveryQueueAPI = "private/cli/volume/recovery-queue"
+
+// StorageVolumeRecoveryQueueGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageVolumeRecoveryQueueGetDataModelONTAP struct {
+	Vserver string `mapstructure:"vserver"`
+	// name of the deleted volume, suffixed with its DSID, eg vol1_1026
+	Volume              string `mapstructure:"volume"`
+	DeletionRequestTime string `mapstructure:"deletion_request_time"`
+	RetentionHours      int64  `mapstructure:"retention_hours"`
+}
+
+// StorageVolumeDSIDGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageVolumeDSIDGetDataModelONTAP struct {
+	DSID int64 `mapstructure:"dsid"`
+}
+
+// GetStorageVolumeDSID to get the DSID of a volume, a deleted volume is renamed <name>_<dsid> in the recovery queue
+func GetStorageVolumeDSID(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeName string, svmName string) (int64, error) {
+	api := "private/cli/volume"
+	query := r.NewQuery()
+	query.Set("volume", volumeName)
+	query.Set("vserver", svmName)
+	query.Fields([]string{"dsid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no volume %s found in svm %s", volumeName, svmName)
+	}
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error reading volume DSID", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageVolumeDSIDGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return 0, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volume DSID: %#v", dataONTAP))
+	return dataONTAP.DSID, nil
+}
+
+// GetStorageVolumeRecoveryQueue to get the deleted volumes that can still be recovered, for all SVMs if svmName is empty
+func GetStorageVolumeRecoveryQueue(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) ([]StorageVolumeRecoveryQueueGetDataModelONTAP, error) {
+	api := volumeRecoveryQueueAPI
+	query := r.NewQuery()
+	if svmName != "" {
+		query.Set("vserver", svmName)
+	}
+	query.Fields([]string{"vserver", "volume", "deletion_request_time", "retention_hours"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading volume recovery queue", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []StorageVolumeRecoveryQueueGetDataModelONTAP
+	for _, info := range response {
+		var record StorageVolumeRecoveryQueueGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volume recovery queue: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// UpdateStorageVolumeRecoveryQueue to change how long a deleted volume is kept in the recovery queue
+func UpdateStorageVolumeRecoveryQueue(errorHandler *utils.ErrorHandler, r restclient.RestClient, deletedVolumeName string, svmName string, retentionHours int64) error {
+	api := volumeRecoveryQueueAPI
+	query := r.NewQuery()
+	query.Set("volume", deletedVolumeName)
+	query.Set("vserver", svmName)
+	body := map[string]interface{}{"retention_hours": retentionHours}
+	statusCode, _, err := r.CallUpdateMethod(api, query, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating volume recovery queue", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// PurgeStorageVolumeRecoveryQueue to remove a deleted volume from the recovery queue, the volume can no longer be recovered
+func PurgeStorageVolumeRecoveryQueue(errorHandler *utils.ErrorHandler, r restclient.RestClient, deletedVolumeName string, svmName string) error {
+	api := volumeRecoveryQueueAPI + "/purge"
+	body := map[string]interface{}{"volume": deletedVolumeName, "vserver": svmName}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error purging volume recovery queue", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
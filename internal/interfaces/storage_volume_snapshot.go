@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -36,6 +38,91 @@ type StorageVolumeSnapshotResourceModel struct {
 // StorageVolumeSnapshotDataSourceFilterModel describes filter model
 type StorageVolumeSnapshotDataSourceFilterModel struct {
 	Name string `tfsdk:"name"`
+	// Tags is applied locally, as tags are encoded in the comment. An empty value or * only checks the key is present.
+	Tags map[string]string `tfsdk:"tags"`
+}
+
+// Tags are stored at the end of the snapshot comment, eg: "nightly backup [owner=alice;ticket=CHG123]"
+const (
+	snapshotTagsStart     = "["
+	snapshotTagsEnd       = "]"
+	snapshotTagsSeparator = ";"
+	snapshotTagsAssign    = "="
+)
+
+// ValidateStorageVolumeSnapshotTags checks that tag keys and values do not contain the characters used for encoding
+func ValidateStorageVolumeSnapshotTags(errorHandler *utils.ErrorHandler, tags map[string]string) error {
+	reserved := snapshotTagsStart + snapshotTagsEnd + snapshotTagsSeparator + snapshotTagsAssign
+	for key, value := range tags {
+		if key == "" || strings.ContainsAny(key, reserved) || strings.ContainsAny(value, reserved) {
+			return errorHandler.MakeAndReportError("invalid snapshot tag",
+				fmt.Sprintf("tag %q=%q is not valid: key is required, and keys and values cannot contain any of %q", key, value, reserved))
+		}
+	}
+	return nil
+}
+
+// ValidateStorageVolumeSnapshotComment checks that the user comment does not end with a tag list, as it would be read back as tags
+func ValidateStorageVolumeSnapshotComment(errorHandler *utils.ErrorHandler, comment string) error {
+	if _, tags := StorageVolumeSnapshotTagsFromComment(comment); tags != nil {
+		return errorHandler.MakeAndReportError("invalid snapshot comment",
+			fmt.Sprintf("comment %q is not valid: it cannot end with %skey%svalue%s, which is reserved for tags, use tags instead", comment, snapshotTagsStart, snapshotTagsAssign, snapshotTagsEnd))
+	}
+	return nil
+}
+
+// StorageVolumeSnapshotCommentWithTags combines the user comment and tags into the ONTAP snapshot comment
+func StorageVolumeSnapshotCommentWithTags(comment string, tags map[string]string) string {
+	if len(tags) == 0 {
+		return comment
+	}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for index, key := range keys {
+		pairs[index] = key + snapshotTagsAssign + tags[key]
+	}
+	encoded := snapshotTagsStart + strings.Join(pairs, snapshotTagsSeparator) + snapshotTagsEnd
+	if comment == "" {
+		return encoded
+	}
+	return comment + " " + encoded
+}
+
+// StorageVolumeSnapshotTagsFromComment splits an ONTAP snapshot comment into the user comment and tags
+// If the comment does not end with a well formed tag list, it is returned as is with no tags.
+func StorageVolumeSnapshotTagsFromComment(fullComment string) (string, map[string]string) {
+	start := strings.LastIndex(fullComment, snapshotTagsStart)
+	if start < 0 || !strings.HasSuffix(fullComment, snapshotTagsEnd) {
+		return fullComment, nil
+	}
+	tags := map[string]string{}
+	for _, pair := range strings.Split(fullComment[start+1:len(fullComment)-1], snapshotTagsSeparator) {
+		key, value, found := strings.Cut(pair, snapshotTagsAssign)
+		if !found || key == "" {
+			return fullComment, nil
+		}
+		tags[key] = value
+	}
+	return strings.TrimSuffix(fullComment[:start], " "), tags
+}
+
+// storageVolumeSnapshotMatchesTags returns true if every tag in filter is present in the snapshot comment
+func storageVolumeSnapshotMatchesTags(comment string, filter map[string]string) bool {
+	_, tags := StorageVolumeSnapshotTagsFromComment(comment)
+	for key, value := range filter {
+		actual, ok := tags[key]
+		if !ok {
+			return false
+		}
+		if value != "" && value != "*" && value != actual {
+			return false
+		}
+	}
+	return true
 }
 
 // GetUUIDStorageVolumeSnapshotsByName get a snapshot UUID based off name
@@ -117,6 +204,10 @@ func GetListStorageVolumeSnapshots(errorHandler *utils.ErrorHandler, r restclien
 		if filter.Name != "" {
 			query.Add("name", filter.Name)
 		}
+		if len(filter.Tags) != 0 {
+			// narrow down the records server side, the exact match is done below
+			query.Add("comment", "*"+snapshotTagsEnd)
+		}
 	}
 
 	query.Fields([]string{"name", "svm.name", "create_time", "expiry_time", "state", "size", "comment", "volume", "volume.uuid", "snapmirror_label"})
@@ -139,6 +230,9 @@ func GetListStorageVolumeSnapshots(errorHandler *utils.ErrorHandler, r restclien
 			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
 				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
 		}
+		if filter != nil && !storageVolumeSnapshotMatchesTags(record.Comment, filter.Tags) {
+			continue
+		}
 		dataONTAP = append(dataONTAP, record)
 	}
 
@@ -146,6 +240,26 @@ func GetListStorageVolumeSnapshots(errorHandler *utils.ErrorHandler, r restclien
 	return dataONTAP, nil
 }
 
+// GetListStorageVolumesSnapshots to get snapshots info for several volumes, the volumes are read concurrently
+func GetListStorageVolumesSnapshots(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUIDs []string, filter *StorageVolumeSnapshotDataSourceFilterModel) ([]StorageVolumeSnapshotGetDataModelONTAP, error) {
+	snapshotsByVolume := make([][]StorageVolumeSnapshotGetDataModelONTAP, len(volumeUUIDs))
+	err := getRecordDetails(errorHandler, len(volumeUUIDs), func(errorHandler *utils.ErrorHandler, index int) error {
+		snapshots, err := GetListStorageVolumeSnapshots(errorHandler, r, volumeUUIDs[index], filter)
+		snapshotsByVolume[index] = snapshots
+		return err
+	})
+	if err != nil {
+		// error reporting done inside GetListStorageVolumeSnapshots
+		return nil, err
+	}
+
+	var dataONTAP []StorageVolumeSnapshotGetDataModelONTAP
+	for _, snapshots := range snapshotsByVolume {
+		dataONTAP = append(dataONTAP, snapshots...)
+	}
+	return dataONTAP, nil
+}
+
 // CreateStorageVolumeSnapshot to create a snapshot
 func CreateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeSnapshotResourceModel, volumeUUID string) (*StorageVolumeSnapshotGetDataModelONTAP, error) {
 	var body map[string]interface{}
@@ -172,11 +286,15 @@ func CreateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.
 }
 
 // UpdateStorageVolumeSnapshot updates snapshot
-func UpdateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeSnapshotResourceModel, volumeUUID string, UUID string) error {
+// clearFields lists attributes to be set to an empty string, as empty values are otherwise omitted, eg comment or snapmirror_label
+func UpdateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeSnapshotResourceModel, clearFields []string, volumeUUID string, UUID string) error {
 	var body map[string]interface{}
 	if err := mapstructure.Decode(data, &body); err != nil {
 		return errorHandler.MakeAndReportError("error encoding snapshot body", fmt.Sprintf("error on encoding storage/volumes/%s/snapshots/%s body: %s, body: %#v", volumeUUID, UUID, err, data))
 	}
+	for _, field := range clearFields {
+		body[field] = ""
+	}
 	query := r.NewQuery()
 	query.Add("return_records", "true")
 
@@ -214,6 +214,54 @@ func TestGetListStorageVolumeSnapshots(t *testing.T) {
 	}
 }
 
+func TestGetListStorageVolumesSnapshots(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicStorageVolumeSnapshotRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+
+	// each volume is read by a copy of the client, so each copy receives the first mocked response
+	responses := map[string][]restclient.MockResponse{
+		"test_one_record_per_volume": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234/snapshots", StatusCode: 200, Response: oneRecordResponse, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234/snapshots", StatusCode: 200, Response: restclient.RestResponse{}, Err: errors.New("generic error for UT")},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		uuids     []string
+		want      []StorageVolumeSnapshotGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_volumes", responses: nil, uuids: nil, want: nil, wantErr: false},
+		{name: "test_one_record_per_volume", responses: responses["test_one_record_per_volume"], uuids: []string{"1234", "5678", "9012"},
+			want: []StorageVolumeSnapshotGetDataModelONTAP{basicStorageVolumeSnapshotRecord, basicStorageVolumeSnapshotRecord, basicStorageVolumeSnapshotRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], uuids: []string{"1234", "5678"}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListStorageVolumesSnapshots(errorHandler, *r, tt.uuids, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListStorageVolumesSnapshots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListStorageVolumesSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCreateStorageVolumeSnapshot(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 
@@ -327,6 +375,9 @@ func TestUpdateStorageVolumeSnapshot(t *testing.T) {
 		"test_update_comment_snapshot": {
 			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234/snapshots/5678", StatusCode: 200, Response: noRecords, Err: nil},
 		},
+		"test_update_clear_comment_snapshot": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234/snapshots/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
 		"test_update_error_1": {
 			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234/snapshots/5678", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
@@ -335,10 +386,12 @@ func TestUpdateStorageVolumeSnapshot(t *testing.T) {
 		name        string
 		responses   []restclient.MockResponse
 		requestbody StorageVolumeSnapshotResourceModel
+		clearFields []string
 		wantErr     bool
 	}{
 		{name: "test_update_rename_snapshot", responses: responses["test_update_rename_snapshot"], requestbody: renameStorageVolumeSnapshotBody, wantErr: false},
 		{name: "test_update_comment_snapshot", responses: responses["test_update_comment_snapshot"], requestbody: updateStorageVolumeSnapshotCommentBody, wantErr: false},
+		{name: "test_update_clear_comment_snapshot", responses: responses["test_update_clear_comment_snapshot"], requestbody: StorageVolumeSnapshotResourceModel{}, clearFields: []string{"comment", "snapmirror_label"}, wantErr: false},
 		{name: "test_update_error_1", responses: responses["test_update_error_1"], requestbody: updateStorageVolumeSnapshotErrorBody, wantErr: true},
 	}
 	for _, tt := range tests {
@@ -347,7 +400,7 @@ func TestUpdateStorageVolumeSnapshot(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			err = UpdateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "string", "string")
+			err = UpdateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, tt.clearFields, "string", "string")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -358,3 +411,111 @@ func TestUpdateStorageVolumeSnapshot(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageVolumeSnapshotTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		comment     string
+		tags        map[string]string
+		wantComment string
+	}{
+		{name: "test_no_tags", comment: "my comment", tags: nil, wantComment: "my comment"},
+		{name: "test_tags_only", comment: "", tags: map[string]string{"owner": "alice"}, wantComment: "[owner=alice]"},
+		{name: "test_comment_and_tags", comment: "nightly", tags: map[string]string{"ticket": "CHG123", "owner": "alice"}, wantComment: "nightly [owner=alice;ticket=CHG123]"},
+		{name: "test_empty_value", comment: "nightly", tags: map[string]string{"purpose": ""}, wantComment: "nightly [purpose=]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StorageVolumeSnapshotCommentWithTags(tt.comment, tt.tags)
+			if got != tt.wantComment {
+				t.Errorf("StorageVolumeSnapshotCommentWithTags() = %v, want %v", got, tt.wantComment)
+			}
+			comment, tags := StorageVolumeSnapshotTagsFromComment(got)
+			if comment != tt.comment {
+				t.Errorf("StorageVolumeSnapshotTagsFromComment() comment = %v, want %v", comment, tt.comment)
+			}
+			if !reflect.DeepEqual(tags, tt.tags) {
+				t.Errorf("StorageVolumeSnapshotTagsFromComment() tags = %v, want %v", tags, tt.tags)
+			}
+		})
+	}
+}
+
+func TestStorageVolumeSnapshotTagsFromCommentNotTagged(t *testing.T) {
+	for _, comment := range []string{"", "plain comment", "unbalanced ]", "see [docs]", "[=value]"} {
+		got, tags := StorageVolumeSnapshotTagsFromComment(comment)
+		if got != comment || tags != nil {
+			t.Errorf("StorageVolumeSnapshotTagsFromComment(%q) = %q, %v, want comment unchanged and no tags", comment, got, tags)
+		}
+	}
+}
+
+func TestValidateStorageVolumeSnapshotComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		wantErr bool
+	}{
+		{name: "empty", comment: "", wantErr: false},
+		{name: "plain", comment: "nightly backup", wantErr: false},
+		{name: "brackets", comment: "see [docs]", wantErr: false},
+		{name: "inner tags", comment: "moved [a=b] to archive", wantErr: false},
+		{name: "tags", comment: "nightly [owner=alice]", wantErr: true},
+		{name: "only tags", comment: "[owner=alice;ticket=CHG123]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			if err := ValidateStorageVolumeSnapshotComment(errorHandler, tt.comment); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStorageVolumeSnapshotComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetListStorageVolumeSnapshotsTagFilter(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	tagged := fullStorageVolumeSnapshotRecord
+	tagged.Comment = "nightly [owner=alice;ticket=CHG123]"
+	other := fullStorageVolumeSnapshotRecord
+	other.Comment = "weekly [owner=bob]"
+	var taggedInterface, otherInterface map[string]any
+	if err := mapstructure.Decode(tagged, &taggedInterface); err != nil {
+		panic(err)
+	}
+	if err := mapstructure.Decode(other, &otherInterface); err != nil {
+		panic(err)
+	}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{taggedInterface, otherInterface}}
+
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		want    []StorageVolumeSnapshotGetDataModelONTAP
+		wantErr bool
+	}{
+		{name: "test_key_and_value", tags: map[string]string{"owner": "alice"}, want: []StorageVolumeSnapshotGetDataModelONTAP{tagged}},
+		{name: "test_key_only", tags: map[string]string{"owner": "*"}, want: []StorageVolumeSnapshotGetDataModelONTAP{tagged, other}},
+		{name: "test_all_keys", tags: map[string]string{"owner": "", "ticket": ""}, want: []StorageVolumeSnapshotGetDataModelONTAP{tagged}},
+		{name: "test_no_match", tags: map[string]string{"purpose": ""}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient([]restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234/snapshots", StatusCode: 200, Response: twoRecords, Err: nil},
+			})
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetListStorageVolumeSnapshots(errorHandler, *r, "1234", &StorageVolumeSnapshotDataSourceFilterModel{Tags: tt.tags})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetListStorageVolumeSnapshots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetListStorageVolumeSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageVolumeSpaceGetDataModelONTAP describes the space fields of a volume, in bytes.
+type StorageVolumeSpaceGetDataModelONTAP struct {
+	Name  string             `mapstructure:"name"`
+	UUID  string             `mapstructure:"uuid"`
+	Space StorageVolumeSpace `mapstructure:"space"`
+}
+
+// StorageVolumeSpace describes the space usage of a volume.
+type StorageVolumeSpace struct {
+	Size         int64                          `mapstructure:"size"`
+	Available    int64                          `mapstructure:"available"`
+	Used         int64                          `mapstructure:"used"`
+	Footprint    int64                          `mapstructure:"footprint"`
+	Snapshot     StorageVolumeSpaceSnapshot     `mapstructure:"snapshot"`
+	LogicalSpace StorageVolumeSpaceLogicalSpace `mapstructure:"logical_space"`
+}
+
+// StorageVolumeSpaceSnapshot describes the space used by the snapshot copies of a volume.
+type StorageVolumeSpaceSnapshot struct {
+	ReservePercent int64 `mapstructure:"reserve_percent"`
+	ReserveSize    int64 `mapstructure:"reserve_size"`
+	Used           int64 `mapstructure:"used"`
+}
+
+// StorageVolumeSpaceLogicalSpace describes the logical space of a volume, before storage efficiency savings.
+type StorageVolumeSpaceLogicalSpace struct {
+	Used        int64 `mapstructure:"used"`
+	Available   int64 `mapstructure:"available"`
+	Enforcement bool  `mapstructure:"enforcement"`
+	Reporting   bool  `mapstructure:"reporting"`
+}
+
+// GetStorageVolumeSpace to get the space usage of a volume by name and svm_name
+func GetStorageVolumeSpace(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*StorageVolumeSpaceGetDataModelONTAP, error) {
+	api := "storage/volumes"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "uuid", "space.size", "space.available", "space.used", "space.footprint", "space.snapshot", "space.logical_space"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no volume %s found in svm %s", name, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading volume space info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageVolumeSpaceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volume space: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
@@ -144,6 +144,53 @@ func TestGetStorageVolumeByName(t *testing.T) {
 	}
 }
 
+func TestGetStorageVolume(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	snapdirRecord := basicStorageVolumeRecord
+	enabled := true
+	snapdirRecord.SnapshotDirectoryAccessEnabled = &enabled
+	var recordInterface map[string]any
+	err := mapstructure.Decode(snapdirRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234", StatusCode: 500, Response: oneRecord, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *StorageVolumeGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &snapdirRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageVolume(errorHandler, *r, "1234", versionModelONTAP{Generation: 9, Major: 13, Minor: 1})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageVolume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetStorageVolumes(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 	var recordInterface map[string]any
@@ -213,3 +260,209 @@ func TestGetStorageVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStorageVolume(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	unexpectedArgument := &restclient.ONTAPError{RestError: restclient.RestError{Code: "262179", Message: "Unexpected argument \"validate_only\"."}, StatusCode: 400}
+	aggregateNotFound := &restclient.ONTAPError{RestError: restclient.RestError{Code: "917927", Message: "Aggregate \"aggr1\" does not exist."}, StatusCode: 400}
+
+	responses := map[string][]restclient.MockResponse{
+		"test_valid": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/volumes", StatusCode: 202, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_not_supported": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/volumes", StatusCode: 400, Response: restclient.RestResponse{}, Err: unexpectedArgument},
+		},
+		"test_invalid": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/volumes", StatusCode: 400, Response: restclient.RestResponse{}, Err: aggregateNotFound},
+		},
+	}
+	tests := []struct {
+		name          string
+		responses     []restclient.MockResponse
+		wantSupported bool
+		wantErr       bool
+	}{
+		{name: "test_valid", responses: responses["test_valid"], wantSupported: true, wantErr: false},
+		{name: "test_not_supported", responses: responses["test_not_supported"], wantSupported: false, wantErr: false},
+		{name: "test_invalid", responses: responses["test_invalid"], wantSupported: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			supported, err := ValidateStorageVolume(errorHandler, *r, StorageVolumeResourceModel{Name: "vol1"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStorageVolume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if supported != tt.wantSupported {
+				t.Errorf("ValidateStorageVolume() = %v, want %v", supported, tt.wantSupported)
+			}
+		})
+	}
+}
+
+func TestUpdateStorageVolumeState(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_offline": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_offline", responses: responses["test_offline"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateStorageVolumeState(errorHandler, *r, "1234", "offline")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStorageVolumeState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnmountStorageVolume(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_unmount": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_unmount", responses: responses["test_unmount"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UnmountStorageVolume(errorHandler, *r, "1234")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmountStorageVolume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoveStorageVolume(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "PATCH /api/storage/volumes/1234"}}}
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_move": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_job_failure": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("failure"), Err: nil},
+		},
+		"test_job_timeout": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("running"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		timeout   int
+		wantErr   bool
+	}{
+		{name: "test_move", responses: responses["test_move"], timeout: 10, wantErr: false},
+		{name: "test_job_failure", responses: responses["test_job_failure"], timeout: 10, wantErr: true},
+		{name: "test_job_timeout", responses: responses["test_job_timeout"], timeout: 0, wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], timeout: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			// poll without sleeping
+			err = MoveStorageVolume(errorHandler, *r, "1234", "aggr2", tt.timeout, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MoveStorageVolume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSplitStorageVolumeClone(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	job := restclient.RestResponse{Job: map[string]any{"uuid": "job-uuid"}}
+	jobRecord := func(state string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "job-uuid", "state": state, "description": "PATCH /api/storage/volumes/1234"}}}
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_split": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("success"), Err: nil},
+		},
+		"test_job_failure": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 202, Response: job, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/job-uuid", StatusCode: 200, Response: jobRecord("failure"), Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_split", responses: responses["test_split"], wantErr: false},
+		{name: "test_job_failure", responses: responses["test_job_failure"], wantErr: true},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			// poll without sleeping
+			err = SplitStorageVolumeClone(errorHandler, *r, "1234", 10, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitStorageVolumeClone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EmsDestinationGetDataModelONTAP describes the GET record data model using go types for mapping.
+type EmsDestinationGetDataModelONTAP struct {
+	Name          string                 `mapstructure:"name"`
+	Type          string                 `mapstructure:"type"`
+	Destination   string                 `mapstructure:"destination"`
+	Filters       []EmsDestinationFilter `mapstructure:"filters"`
+	Syslog        *EmsDestinationSyslog  `mapstructure:"syslog,omitempty"`
+	SystemDefined bool                   `mapstructure:"system_defined"`
+}
+
+// EmsDestinationFilter describes a filter attached to a destination.
+type EmsDestinationFilter struct {
+	Name string `mapstructure:"name"`
+}
+
+// EmsDestinationSyslog describes the syslog transport settings.
+type EmsDestinationSyslog struct {
+	Port      int64  `mapstructure:"port,omitempty"`
+	Transport string `mapstructure:"transport,omitempty"`
+}
+
+// EmsDestinationResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type EmsDestinationResourceBodyDataModelONTAP struct {
+	Name        string                `mapstructure:"name,omitempty"`
+	Type        string                `mapstructure:"type,omitempty"`
+	Destination string                `mapstructure:"destination,omitempty"`
+	Filters     []map[string]string   `mapstructure:"filters"`
+	Syslog      *EmsDestinationSyslog `mapstructure:"syslog,omitempty"`
+}
+
+// GetEmsDestination to get ems_destination info
+func GetEmsDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*EmsDestinationGetDataModelONTAP, error) {
+	api := "support/ems/destinations"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "type", "destination", "filters.name", "syslog", "system_defined"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ems_destination info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP EmsDestinationGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ems_destination: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateEmsDestination to create ems_destination
+func CreateEmsDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsDestinationResourceBodyDataModelONTAP) error {
+	api := "support/ems/destinations"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_destination body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating ems_destination", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create ems_destination: %#v", body))
+	return nil
+}
+
+// UpdateEmsDestination to update ems_destination
+func UpdateEmsDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsDestinationResourceBodyDataModelONTAP, name string) error {
+	api := "support/ems/destinations/" + name
+	// name and type can not be modified
+	body.Name = ""
+	body.Type = ""
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_destination body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating ems_destination", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteEmsDestination to delete ems_destination
+func DeleteEmsDestination(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) error {
+	api := "support/ems/destinations"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+name, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting ems_destination", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EmsEventGetDataModelONTAP describes the GET record data model using go types for mapping.
+type EmsEventGetDataModelONTAP struct {
+	Index      int64           `mapstructure:"index"`
+	Time       string          `mapstructure:"time"`
+	Node       NameDataModel   `mapstructure:"node"`
+	Message    EmsEventMessage `mapstructure:"message"`
+	LogMessage string          `mapstructure:"log_message"`
+}
+
+// EmsEventMessage describes the message of an EMS event.
+type EmsEventMessage struct {
+	Name     string `mapstructure:"name"`
+	Severity string `mapstructure:"severity"`
+}
+
+// EmsEventDataSourceFilterModel describes the filter of the EMS events query.
+type EmsEventDataSourceFilterModel struct {
+	Severities []string
+	// Since is an ISO 8601 timestamp, only the events logged after it are returned.
+	Since string
+}
+
+// GetEmsEvents to get the EMS events matching a filter, the most recent first
+func GetEmsEvents(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter EmsEventDataSourceFilterModel) ([]EmsEventGetDataModelONTAP, error) {
+	api := "support/ems/events"
+	query := r.NewQuery()
+	if len(filter.Severities) > 0 {
+		query.Set("message.severity", strings.Join(filter.Severities, "|"))
+	}
+	if filter.Since != "" {
+		query.Set("time", ">"+filter.Since)
+	}
+	query.Set("order_by", "time desc")
+	query.Fields([]string{"index", "time", "node.name", "message.name", "message.severity", "log_message"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ems events", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []EmsEventGetDataModelONTAP
+	for _, info := range response {
+		var record EmsEventGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ems events: %d events", len(dataONTAP)))
+	return dataONTAP, nil
+}
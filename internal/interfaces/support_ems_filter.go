@@ -0,0 +1,107 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EmsFilterGetDataModelONTAP describes the GET record data model using go types for mapping.
+type EmsFilterGetDataModelONTAP struct {
+	Name          string          `mapstructure:"name"`
+	Rules         []EmsFilterRule `mapstructure:"rules"`
+	SystemDefined bool            `mapstructure:"system_defined"`
+}
+
+// EmsFilterRule describes a filter rule, rules are evaluated in index order.
+type EmsFilterRule struct {
+	Index           int64                    `mapstructure:"index"`
+	Type            string                   `mapstructure:"type"`
+	MessageCriteria EmsFilterMessageCriteria `mapstructure:"message_criteria"`
+}
+
+// EmsFilterMessageCriteria describes the events matched by a filter rule.
+type EmsFilterMessageCriteria struct {
+	NamePattern   string `mapstructure:"name_pattern,omitempty"`
+	Severities    string `mapstructure:"severities,omitempty"`
+	SnmpTrapTypes string `mapstructure:"snmp_trap_types,omitempty"`
+}
+
+// EmsFilterResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type EmsFilterResourceBodyDataModelONTAP struct {
+	Name  string                   `mapstructure:"name,omitempty"`
+	Rules []map[string]interface{} `mapstructure:"rules"`
+}
+
+// IsEmsFilterDefaultRule returns true for the exclude all rule that ONTAP adds at the end of every filter
+func IsEmsFilterDefaultRule(rule EmsFilterRule) bool {
+	return rule.Type == "exclude" && rule.MessageCriteria.NamePattern == "*" && rule.MessageCriteria.Severities == "*" &&
+		(rule.MessageCriteria.SnmpTrapTypes == "" || rule.MessageCriteria.SnmpTrapTypes == "*")
+}
+
+// GetEmsFilter to get ems_filter info
+func GetEmsFilter(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*EmsFilterGetDataModelONTAP, error) {
+	api := "support/ems/filters"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "rules", "system_defined"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ems_filter info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP EmsFilterGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ems_filter: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateEmsFilter to create ems_filter
+func CreateEmsFilter(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsFilterResourceBodyDataModelONTAP) error {
+	api := "support/ems/filters"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_filter body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating ems_filter", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create ems_filter: %#v", body))
+	return nil
+}
+
+// UpdateEmsFilter to replace the rules of an ems_filter
+func UpdateEmsFilter(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsFilterResourceBodyDataModelONTAP, name string) error {
+	api := "support/ems/filters/" + name
+	// the filter is identified by the URL, the name can not be modified
+	body.Name = ""
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_filter body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating ems_filter", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteEmsFilter to delete ems_filter
+func DeleteEmsFilter(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) error {
+	api := "support/ems/filters"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+name, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting ems_filter", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package interfaces
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EmsFilterRuleResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type EmsFilterRuleResourceBodyDataModelONTAP struct {
+	Index           int64                     `mapstructure:"index,omitempty"`
+	Type            string                    `mapstructure:"type,omitempty"`
+	MessageCriteria *EmsFilterMessageCriteria `mapstructure:"message_criteria,omitempty"`
+}
+
+func emsFilterRulesAPI(filterName string) string {
+	return "support/ems/filters/" + filterName + "/rules"
+}
+
+// CreateEmsFilterRule to add a rule to an ems_filter, the rule is added before the final exclude all rule when no index is set
+func CreateEmsFilterRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, filterName string, body EmsFilterRuleResourceBodyDataModelONTAP) (*EmsFilterRule, error) {
+	api := emsFilterRulesAPI(filterName)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding ems_filter rule body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && len(response.Records) == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating ems_filter rule", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP EmsFilterRule
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from POST %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create ems_filter rule: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateEmsFilterRule to modify the type or the message criteria of an ems_filter rule
+func UpdateEmsFilterRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, filterName string, index int64, body EmsFilterRuleResourceBodyDataModelONTAP) error {
+	api := emsFilterRulesAPI(filterName) + "/" + strconv.FormatInt(index, 10)
+	// the rule is identified by the URL
+	body.Index = 0
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_filter rule body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating ems_filter rule", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteEmsFilterRule to delete an ems_filter rule, the following rules move up by one
+func DeleteEmsFilterRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, filterName string, index int64) error {
+	api := emsFilterRulesAPI(filterName) + "/" + strconv.FormatInt(index, 10)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting ems_filter rule", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestCreateEmsFilterRule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(emsFilterRecord.Rules[0], &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "support/ems/filters/critical-events/rules", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_create_no_record": {
+			{ExpectedMethod: "POST", ExpectedURL: "support/ems/filters/critical-events/rules", StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "support/ems/filters/critical-events/rules", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := EmsFilterRuleResourceBodyDataModelONTAP{Type: "include", MessageCriteria: &EmsFilterMessageCriteria{NamePattern: "*", Severities: "emergency,alert"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *EmsFilterRule
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &emsFilterRecord.Rules[0], wantErr: false},
+		{name: "test_create_no_record", responses: responses["test_create_no_record"], want: nil, wantErr: true},
+		{name: "test_create_error", responses: responses["test_create_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateEmsFilterRule(errorHandler, *r, "critical-events", body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateEmsFilterRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateEmsFilterRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateEmsFilterRule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "support/ems/filters/critical-events/rules/1", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "support/ems/filters/critical-events/rules/1", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := EmsFilterRuleResourceBodyDataModelONTAP{Type: "exclude", MessageCriteria: &EmsFilterMessageCriteria{NamePattern: "callhome.*", Severities: "*"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateEmsFilterRule(errorHandler, *r, "critical-events", 1, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateEmsFilterRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteEmsFilterRule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "support/ems/filters/critical-events/rules/2", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_delete_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "support/ems/filters/critical-events/rules/2", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_delete_error", responses: responses["test_delete_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteEmsFilterRule(errorHandler, *r, "critical-events", 2)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteEmsFilterRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
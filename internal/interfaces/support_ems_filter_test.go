@@ -0,0 +1,132 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var emsFilterRecord = EmsFilterGetDataModelONTAP{
+	Name: "critical-events",
+	Rules: []EmsFilterRule{
+		{Index: 1, Type: "include", MessageCriteria: EmsFilterMessageCriteria{NamePattern: "*", Severities: "emergency,alert", SnmpTrapTypes: "*"}},
+		{Index: 2, Type: "exclude", MessageCriteria: EmsFilterMessageCriteria{NamePattern: "*", Severities: "*", SnmpTrapTypes: "*"}},
+	},
+}
+
+var badEmsFilterRecord = struct{ Name int }{123}
+
+func TestGetEmsFilter(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(emsFilterRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badEmsFilterRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "support/ems/filters", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "support/ems/filters", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "support/ems/filters", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "support/ems/filters", StatusCode: 200, Response: badRecordResponse, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *EmsFilterGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &emsFilterRecord, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetEmsFilter(errorHandler, *r, "critical-events")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetEmsFilter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetEmsFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateEmsFilter(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "support/ems/filters", StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "support/ems/filters", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	body := EmsFilterResourceBodyDataModelONTAP{Name: "critical-events", Rules: []map[string]interface{}{{"index": 1, "type": "include", "message_criteria": map[string]interface{}{"name_pattern": "*", "severities": "emergency,alert"}}}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_create_error", responses: responses["test_create_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateEmsFilter(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateEmsFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsEmsFilterDefaultRule(t *testing.T) {
+	if !IsEmsFilterDefaultRule(emsFilterRecord.Rules[1]) {
+		t.Errorf("IsEmsFilterDefaultRule() = false for %v", emsFilterRecord.Rules[1])
+	}
+	if IsEmsFilterDefaultRule(emsFilterRecord.Rules[0]) {
+		t.Errorf("IsEmsFilterDefaultRule() = true for %v", emsFilterRecord.Rules[0])
+	}
+}
@@ -0,0 +1,93 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// EmsRoleConfigGetDataModelONTAP describes the GET record data model using go types for mapping.
+type EmsRoleConfigGetDataModelONTAP struct {
+	AccessControlRole          EmsRoleConfigName `mapstructure:"access_control_role"`
+	EventFilter                EmsRoleConfigName `mapstructure:"event_filter"`
+	LimitAccessToGlobalConfigs bool              `mapstructure:"limit_access_to_global_configs"`
+}
+
+// EmsRoleConfigName describes a role or a filter referenced by name.
+type EmsRoleConfigName struct {
+	Name string `mapstructure:"name,omitempty"`
+}
+
+// EmsRoleConfigResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type EmsRoleConfigResourceBodyDataModelONTAP struct {
+	AccessControlRole          *EmsRoleConfigName `mapstructure:"access_control_role,omitempty"`
+	EventFilter                *EmsRoleConfigName `mapstructure:"event_filter,omitempty"`
+	LimitAccessToGlobalConfigs *bool              `mapstructure:"limit_access_to_global_configs,omitempty"`
+}
+
+// GetEmsRoleConfig to get ems_role_config info
+func GetEmsRoleConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, roleName string) (*EmsRoleConfigGetDataModelONTAP, error) {
+	api := "support/ems/role-configs"
+	query := r.NewQuery()
+	query.Set("access_control_role.name", roleName)
+	query.Fields([]string{"access_control_role.name", "event_filter.name", "limit_access_to_global_configs"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ems_role_config info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP EmsRoleConfigGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ems_role_config: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateEmsRoleConfig to create ems_role_config
+func CreateEmsRoleConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsRoleConfigResourceBodyDataModelONTAP) error {
+	api := "support/ems/role-configs"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_role_config body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating ems_role_config", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create ems_role_config: %#v", body))
+	return nil
+}
+
+// UpdateEmsRoleConfig to update ems_role_config
+func UpdateEmsRoleConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, body EmsRoleConfigResourceBodyDataModelONTAP, roleName string) error {
+	api := "support/ems/role-configs/" + roleName
+	// the role is identified by the URL, it can not be modified
+	body.AccessControlRole = nil
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ems_role_config body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating ems_role_config", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteEmsRoleConfig to delete ems_role_config
+func DeleteEmsRoleConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, roleName string) error {
+	api := "support/ems/role-configs"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+roleName, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting ems_role_config", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
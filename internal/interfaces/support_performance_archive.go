@@ -0,0 +1,59 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// PerformanceArchiveGetDataModelONTAP describes the AutoSupport message record returned on POST.
+type PerformanceArchiveGetDataModelONTAP struct {
+	Node    NameDataModel `mapstructure:"node"`
+	Index   int64         `mapstructure:"index"`
+	Subject string        `mapstructure:"subject"`
+}
+
+// PerformanceArchiveResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type PerformanceArchiveResourceBodyDataModelONTAP struct {
+	Node    PerformanceArchiveNode `mapstructure:"node"`
+	Type    string                 `mapstructure:"type"`
+	Message string                 `mapstructure:"message,omitempty"`
+}
+
+// PerformanceArchiveNode describes the node the performance data is collected from.
+type PerformanceArchiveNode struct {
+	Name string `mapstructure:"name"`
+}
+
+// CreatePerformanceArchive to invoke an AutoSupport performance message, which uploads the performance archive of a node
+func CreatePerformanceArchive(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string, message string) (*PerformanceArchiveGetDataModelONTAP, error) {
+	api := "support/autosupport/messages"
+	body := PerformanceArchiveResourceBodyDataModelONTAP{
+		Node:    PerformanceArchiveNode{Name: nodeName},
+		Type:    "performance",
+		Message: message,
+	}
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding performance_archive body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating performance_archive", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response.NumRecords == 0 || len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating performance_archive", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP PerformanceArchiveGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding performance_archive info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create performance_archive - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SnmpTraphostGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SnmpTraphostGetDataModelONTAP struct {
+	Host      string    `mapstructure:"host"`
+	IPAddress string    `mapstructure:"ip_address"`
+	Community *SnmpName `mapstructure:"community,omitempty"`
+	User      *SnmpName `mapstructure:"user,omitempty"`
+}
+
+// SnmpName describes a community or a user referenced by name.
+type SnmpName struct {
+	Name string `mapstructure:"name"`
+}
+
+// SnmpTraphostResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SnmpTraphostResourceBodyDataModelONTAP struct {
+	Host      string    `mapstructure:"host"`
+	Community *SnmpName `mapstructure:"community,omitempty"`
+	User      *SnmpName `mapstructure:"user,omitempty"`
+}
+
+// GetSnmpTraphost to get snmp_traphost info, returns nil if the traphost is not found
+func GetSnmpTraphost(errorHandler *utils.ErrorHandler, r restclient.RestClient, host string) (*SnmpTraphostGetDataModelONTAP, error) {
+	api := "support/snmp/traphosts"
+	query := r.NewQuery()
+	query.Set("host", host)
+	query.Fields([]string{"host", "ip_address", "community.name", "user.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snmp_traphost info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SnmpTraphostGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snmp_traphost: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSnmpTraphost to create snmp_traphost
+func CreateSnmpTraphost(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SnmpTraphostResourceBodyDataModelONTAP) error {
+	api := "support/snmp/traphosts"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snmp_traphost body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating snmp_traphost", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create snmp_traphost: %s", body.Host))
+	return nil
+}
+
+// DeleteSnmpTraphost to delete snmp_traphost
+func DeleteSnmpTraphost(errorHandler *utils.ErrorHandler, r restclient.RestClient, host string) error {
+	api := "support/snmp/traphosts"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+host, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting snmp_traphost", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SnmpUserGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SnmpUserGetDataModelONTAP struct {
+	Name                 string      `mapstructure:"name"`
+	EngineID             string      `mapstructure:"engine_id"`
+	AuthenticationMethod string      `mapstructure:"authentication_method"`
+	SwitchAddress        string      `mapstructure:"switch_address,omitempty"`
+	Comment              string      `mapstructure:"comment,omitempty"`
+	Owner                SnmpName    `mapstructure:"owner"`
+	Scope                string      `mapstructure:"scope"`
+	Snmpv3               *SnmpUserV3 `mapstructure:"snmpv3,omitempty"`
+}
+
+// SnmpUserV3 describes the USM settings of an SNMPv3 user, the passwords are never returned by ONTAP.
+type SnmpUserV3 struct {
+	AuthenticationProtocol string `mapstructure:"authentication_protocol,omitempty"`
+	AuthenticationPassword string `mapstructure:"authentication_password,omitempty"`
+	PrivacyProtocol        string `mapstructure:"privacy_protocol,omitempty"`
+	PrivacyPassword        string `mapstructure:"privacy_password,omitempty"`
+}
+
+// SnmpUserResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type SnmpUserResourceBodyDataModelONTAP struct {
+	Name                 string      `mapstructure:"name"`
+	EngineID             string      `mapstructure:"engine_id,omitempty"`
+	AuthenticationMethod string      `mapstructure:"authentication_method"`
+	SwitchAddress        string      `mapstructure:"switch_address,omitempty"`
+	Comment              string      `mapstructure:"comment,omitempty"`
+	Owner                *SnmpName   `mapstructure:"owner,omitempty"`
+	Snmpv3               *SnmpUserV3 `mapstructure:"snmpv3,omitempty"`
+}
+
+// SnmpUserUpdateBodyDataModelONTAP describes the PATCH body data model, only the comment can be modified.
+type SnmpUserUpdateBodyDataModelONTAP struct {
+	Comment string `mapstructure:"comment"`
+}
+
+// GetSnmpUser to get snmp_user info, returns nil if the user is not found
+func GetSnmpUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, engineID string, name string) (*SnmpUserGetDataModelONTAP, error) {
+	api := "support/snmp/users"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("engine_id", engineID)
+	query.Fields([]string{"name", "engine_id", "authentication_method", "switch_address", "comment", "owner.name", "scope", "snmpv3"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snmp_user info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var dataONTAP SnmpUserGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snmp_user: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSnmpUser to create snmp_user, and return the user with its engine ID
+func CreateSnmpUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SnmpUserResourceBodyDataModelONTAP) (*SnmpUserGetDataModelONTAP, error) {
+	api := "support/snmp/users"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding snmp_user body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err == nil && len(response.Records) == 0 {
+		err = fmt.Errorf("no record returned")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating snmp_user", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SnmpUserGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from POST %s", api),
+			fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create snmp_user: %s, engine ID %s", dataONTAP.Name, dataONTAP.EngineID))
+	return &dataONTAP, nil
+}
+
+// UpdateSnmpUser to update the comment of snmp_user
+func UpdateSnmpUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, engineID string, name string, body SnmpUserUpdateBodyDataModelONTAP) error {
+	api := "support/snmp/users/" + engineID + "/" + name
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snmp_user body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating snmp_user", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSnmpUser to delete snmp_user
+func DeleteSnmpUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, engineID string, name string) error {
+	api := "support/snmp/users"
+	statusCode, _, err := r.CallDeleteMethod(api+"/"+engineID+"/"+name, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting snmp_user", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
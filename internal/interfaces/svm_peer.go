@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SvmPeerGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SvmPeerGetDataModelONTAP struct {
+	UUID         string            `mapstructure:"uuid"`
+	SVM          SvmDataModelONTAP `mapstructure:"svm"`
+	Peer         SvmPeerPeer       `mapstructure:"peer"`
+	State        string            `mapstructure:"state"`
+	Applications []string          `mapstructure:"applications"`
+}
+
+// SvmPeerPeer describes the remote SVM and cluster of a peer relationship.
+type SvmPeerPeer struct {
+	SVM     SvmDataModelONTAP `mapstructure:"svm"`
+	Cluster NameDataModel     `mapstructure:"cluster"`
+}
+
+// SvmPeerDataSourceFilterModel describes the data source filter model.
+type SvmPeerDataSourceFilterModel struct {
+	SVMName         string `mapstructure:"svm.name,omitempty"`
+	PeerSVMName     string `mapstructure:"peer.svm.name,omitempty"`
+	PeerClusterName string `mapstructure:"peer.cluster.name,omitempty"`
+	State           string `mapstructure:"state,omitempty"`
+}
+
+// GetListSvmPeers to get svm_peer info for all peer relationships matching a filter
+func GetListSvmPeers(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SvmPeerDataSourceFilterModel) ([]SvmPeerGetDataModelONTAP, error) {
+	api := "svm/peers"
+	query := r.NewQuery()
+	query.Fields([]string{"uuid", "svm.name", "svm.uuid", "peer.svm.name", "peer.svm.uuid", "peer.cluster.name", "state", "applications"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding svm_peer filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading svm_peer info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []SvmPeerGetDataModelONTAP
+	for _, info := range response {
+		var record SvmPeerGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read svm_peer data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
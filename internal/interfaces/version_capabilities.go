@@ -0,0 +1,113 @@
+package interfaces
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// versionedFields describes fields of an API only available starting with an ONTAP release
+type versionedFields struct {
+	minimum versionModelONTAP
+	fields  []string
+}
+
+// ipRoutesCollection is the registry key for GET network/ip/routes without a uuid, the fields differ from a single route
+const ipRoutesCollection = "network/ip/routes collection"
+
+// ontapCapabilities is the registry of API fields introduced after ONTAP 9.6, keyed by API.
+// Resources and data sources consult it, rather than comparing versions, to select the fields to read.
+// Resources setting one of these fields also validate it at plan time with CheckFieldsSupported, this is the case of
+// network/ip/routes, protocols/nfs/export-policies/rules, protocols/nfs/services, snapmirror/policies, and storage/volumes.
+// The snapmirror/relationships fields are only read.
+var ontapCapabilities = map[string][]versionedFields{
+	"network/ip/routes": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 11}, fields: []string{"metric"}},
+	},
+	// listing the routes only returns the metric starting with 9.12
+	ipRoutesCollection: {
+		{minimum: versionModelONTAP{Generation: 9, Major: 12}, fields: []string{"metric"}},
+	},
+	"protocols/nfs/export-policies/rules": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 11}, fields: []string{"ntfs_unix_security"}},
+	},
+	"protocols/nfs/services": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 11}, fields: []string{"root.ignore_nt_acl", "root.skip_write_permission_check",
+			"security.chown_mode", "security.nt_acl_display_permission", "security.ntfs_unix_security", "security.rpcsec_context_idle",
+			"windows.default_user", "windows.map_unknown_uid_to_default_user", "windows.v3_ms_dos_client_enabled", "transport.tcp_max_transfer_size"}},
+	},
+	"snapmirror/policies": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 10}, fields: []string{"copy_all_source_snapshots"}},
+		{minimum: versionModelONTAP{Generation: 9, Major: 11}, fields: []string{"create_snapshot_on_source", "copy_latest_source_snapshot"}},
+	},
+	"snapmirror/relationships": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 8}, fields: []string{"consistency_group_failover"}},
+		{minimum: versionModelONTAP{Generation: 9, Major: 11}, fields: []string{"identity_preservation", "throttle", "transfer_schedule", "group_type", "last_transfer_type"}},
+		{minimum: versionModelONTAP{Generation: 9, Major: 13}, fields: []string{"total_transfer_duration", "last_transfer_network_compression_ratio", "total_transfer_bytes", "svmdr_volumes"}},
+	},
+	"storage/volumes": {
+		{minimum: versionModelONTAP{Generation: 9, Major: 13}, fields: []string{"snapshot_directory_access_enabled"}},
+	},
+}
+
+// isAtLeast returns true if the version is the same or later than minimum
+func (v versionModelONTAP) isAtLeast(minimum versionModelONTAP) bool {
+	if v.Generation != minimum.Generation {
+		return v.Generation > minimum.Generation
+	}
+	if v.Major != minimum.Major {
+		return v.Major > minimum.Major
+	}
+	return v.Minor >= minimum.Minor
+}
+
+// String returns the version as generation.major.minor
+func (v versionModelONTAP) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Generation, v.Major, v.Minor)
+}
+
+// FieldsForVersion appends the versioned fields of api that are supported by the cluster version to fields
+func FieldsForVersion(api string, version versionModelONTAP, fields []string) []string {
+	for _, versioned := range ontapCapabilities[api] {
+		if version.isAtLeast(versioned.minimum) {
+			fields = append(fields, versioned.fields...)
+		}
+	}
+	return fields
+}
+
+// SupportsField returns true if the field of api is supported by the cluster version.
+// Fields that are not in the registry are available with all versions supported by the provider.
+func SupportsField(api string, version versionModelONTAP, field string) bool {
+	for _, versioned := range ontapCapabilities[api] {
+		for _, name := range versioned.fields {
+			if name == field {
+				return version.isAtLeast(versioned.minimum)
+			}
+		}
+	}
+	return true
+}
+
+// CheckFieldsSupported reports an error listing the attributes that are not supported by the cluster version.
+// attributes maps the Terraform attribute names set in the configuration to API fields.
+func CheckFieldsSupported(errorHandler *utils.ErrorHandler, api string, version versionModelONTAP, attributes map[string]string) error {
+	var unsupported []string
+	for attribute, field := range attributes {
+		for _, versioned := range ontapCapabilities[api] {
+			for _, name := range versioned.fields {
+				if name == field && !version.isAtLeast(versioned.minimum) {
+					unsupported = append(unsupported, fmt.Sprintf("%s requires ONTAP %d.%d or later", attribute, versioned.minimum.Generation, versioned.minimum.Major))
+				}
+			}
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	sort.Strings(unsupported)
+	return errorHandler.MakeAndReportError("unsupported attributes for the ONTAP version",
+		fmt.Sprintf("the cluster is running ONTAP %s: %s. Remove these attributes from the configuration, or upgrade the cluster.", version.String(), strings.Join(unsupported, ", ")))
+}
@@ -0,0 +1,81 @@
+package interfaces
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestFieldsForVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		api     string
+		version versionModelONTAP
+		want    []string
+	}{
+		{name: "older_version", api: "network/ip/routes", version: versionModelONTAP{Generation: 9, Major: 10, Minor: 1}, want: []string{"gateway"}},
+		{name: "minimum_version", api: "network/ip/routes", version: versionModelONTAP{Generation: 9, Major: 11}, want: []string{"gateway", "metric"}},
+		{name: "later_generation", api: "network/ip/routes", version: versionModelONTAP{Generation: 10, Major: 0}, want: []string{"gateway", "metric"}},
+		{name: "collection_older_version", api: ipRoutesCollection, version: versionModelONTAP{Generation: 9, Major: 11, Minor: 1}, want: []string{"gateway"}},
+		{name: "collection_minimum_version", api: ipRoutesCollection, version: versionModelONTAP{Generation: 9, Major: 12}, want: []string{"gateway", "metric"}},
+		{name: "several_releases", api: "snapmirror/policies", version: versionModelONTAP{Generation: 9, Major: 10}, want: []string{"gateway", "copy_all_source_snapshots"}},
+		{name: "unknown_api", api: "cluster", version: versionModelONTAP{Generation: 9, Major: 6}, want: []string{"gateway"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FieldsForVersion(tt.api, tt.version, []string{"gateway"}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FieldsForVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportsField(t *testing.T) {
+	tests := []struct {
+		name    string
+		version versionModelONTAP
+		field   string
+		want    bool
+	}{
+		{name: "supported", version: versionModelONTAP{Generation: 9, Major: 13, Minor: 1}, field: "throttle", want: true},
+		{name: "not_supported", version: versionModelONTAP{Generation: 9, Major: 9, Minor: 1}, field: "throttle", want: false},
+		{name: "not_versioned", version: versionModelONTAP{Generation: 9, Major: 6}, field: "state", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SupportsField("snapmirror/relationships", tt.version, tt.field); got != tt.want {
+				t.Errorf("SupportsField() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckFieldsSupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    versionModelONTAP
+		attributes map[string]string
+		wantErr    bool
+	}{
+		{name: "no_attributes", version: versionModelONTAP{Generation: 9, Major: 9}, attributes: map[string]string{}, wantErr: false},
+		{name: "supported", version: versionModelONTAP{Generation: 9, Major: 11}, attributes: map[string]string{"security.chown_mode": "security.chown_mode"}, wantErr: false},
+		{name: "not_supported", version: versionModelONTAP{Generation: 9, Major: 9}, attributes: map[string]string{"security.chown_mode": "security.chown_mode"}, wantErr: true},
+		{name: "not_versioned", version: versionModelONTAP{Generation: 9, Major: 9}, attributes: map[string]string{"enabled": "enabled"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			err := CheckFieldsSupported(errorHandler, "protocols/nfs/services", tt.version, tt.attributes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckFieldsSupported() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("CheckFieldsSupported() diagnostics = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
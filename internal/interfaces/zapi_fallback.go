@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/zapiclient"
+)
+
+// UseZAPIFallback returns true if ZAPI should be used instead of REST.
+// This requires a ZAPI client, which is only created when zapi_fallback is set in the connection profile,
+// and the cluster not serving the REST API, as with ONTAP 9.5 or earlier.
+// The probe shares the cached cluster record with GetCluster, so REST clusters are only queried once.
+func UseZAPIFallback(errorHandler *utils.ErrorHandler, r restclient.RestClient, z *zapiclient.ZapiClient) bool {
+	if z == nil {
+		return false
+	}
+	statusCode, _, err := getCachedClusterRecord(r)
+	if err != nil && statusCode == 404 {
+		tflog.Info(errorHandler.Ctx, fmt.Sprintf("REST API is not available, using ZAPI: %s", err))
+		return true
+	}
+	return false
+}
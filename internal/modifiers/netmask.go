@@ -0,0 +1,42 @@
+package modifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// netmaskEquivalentModifier implements planmodifier.String
+type netmaskEquivalentModifier struct{}
+
+// NetmaskEquivalent keeps the netmask in state when the configuration sets the same netmask in another form,
+// eg 255.255.255.0 and 24, as ONTAP reports netmasks as a length.
+// It must be set before RequiresReplace, so that an equivalent netmask does not replace the resource.
+func NetmaskEquivalent() planmodifier.String {
+	return netmaskEquivalentModifier{}
+}
+
+// Description is the method required to implement planmodifier.String
+func (m netmaskEquivalentModifier) Description(_ context.Context) string {
+	return "A netmask length and the equivalent IPv4 mask, eg 24 and 255.255.255.0, are the same value."
+}
+
+// MarkdownDescription is the method required to implement planmodifier.String
+func (m netmaskEquivalentModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString is the method required to implement planmodifier.String
+func (m netmaskEquivalentModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on create, on destroy, or if a value is not known yet.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+	if utils.NetmaskLength(req.PlanValue.ValueString()) == utils.NetmaskLength(req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
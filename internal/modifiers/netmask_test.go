@@ -0,0 +1,36 @@
+package modifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNetmaskEquivalent(t *testing.T) {
+	tests := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  types.String
+	}{
+		{name: "test_create", state: types.StringNull(), plan: types.StringValue("255.255.255.0"), want: types.StringValue("255.255.255.0")},
+		{name: "test_unknown", state: types.StringValue("24"), plan: types.StringUnknown(), want: types.StringUnknown()},
+		{name: "test_same", state: types.StringValue("24"), plan: types.StringValue("24"), want: types.StringValue("24")},
+		{name: "test_mask_in_plan", state: types.StringValue("24"), plan: types.StringValue("255.255.255.0"), want: types.StringValue("24")},
+		{name: "test_length_in_plan", state: types.StringValue("255.255.0.0"), plan: types.StringValue("16"), want: types.StringValue("255.255.0.0")},
+		{name: "test_different", state: types.StringValue("24"), plan: types.StringValue("255.255.0.0"), want: types.StringValue("255.255.0.0")},
+		{name: "test_ipv6", state: types.StringValue("64"), plan: types.StringValue("48"), want: types.StringValue("48")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan, ConfigValue: tt.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+			NetmaskEquivalent().PlanModifyString(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("NetmaskEquivalent() plan = %v, want %v", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
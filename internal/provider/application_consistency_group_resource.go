@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ConsistencyGroupResource{}
+var _ resource.ResourceWithImportState = &ConsistencyGroupResource{}
+
+// NewConsistencyGroupResource is a helper function to simplify the provider implementation.
+func NewConsistencyGroupResource() resource.Resource {
+	return &ConsistencyGroupResource{
+		config: resourceOrDataSourceConfig{
+			name: "application_consistency_group_resource",
+		},
+	}
+}
+
+// ConsistencyGroupResource defines the resource implementation.
+type ConsistencyGroupResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ConsistencyGroupResourceModel describes the resource data model.
+type ConsistencyGroupResourceModel struct {
+	CxProfileName  types.String   `tfsdk:"cx_profile_name"`
+	SVMName        types.String   `tfsdk:"svm_name"`
+	Name           types.String   `tfsdk:"name"`
+	Volumes        []types.String `tfsdk:"volumes"`
+	SnapshotPolicy types.String   `tfsdk:"snapshot_policy"`
+	QOSPolicy      types.String   `tfsdk:"qos_policy"`
+	Luns           types.List     `tfsdk:"luns"`
+	ID             types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ConsistencyGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ConsistencyGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Consistency group resource, to protect existing volumes and their LUNs with consistent snapshots",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Consistency group name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"volumes": schema.ListAttribute{
+				MarkdownDescription: "Names of the existing volumes in the consistency group. The LUNs in these volumes are part of the group",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"snapshot_policy": schema.StringAttribute{
+				MarkdownDescription: "Snapshot policy applied to the consistency group",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"qos_policy": schema.StringAttribute{
+				MarkdownDescription: "QoS policy group applied to the consistency group",
+				Optional:            true,
+			},
+			"luns": schema.ListAttribute{
+				MarkdownDescription: "Names of the LUNs in the consistency group",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Consistency group UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConsistencyGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ConsistencyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConsistencyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetConsistencyGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetConsistencyGroupByName
+		return
+	}
+
+	imported := data.ID.IsNull()
+	resp.Diagnostics.Append(r.flatten(ctx, &data, restInfo, imported)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a consistency group from existing volumes
+func (r *ConsistencyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ConsistencyGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.ConsistencyGroupResourceBodyDataModelONTAP{
+		Name:    data.Name.ValueString(),
+		SVM:     &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+		Volumes: interfaces.ConsistencyGroupVolumes(expandTypesStringList(data.Volumes), "add"),
+	}
+	if !data.SnapshotPolicy.IsUnknown() && !data.SnapshotPolicy.IsNull() {
+		body.SnapshotPolicy = map[string]string{"name": data.SnapshotPolicy.ValueString()}
+	}
+	if !data.QOSPolicy.IsNull() {
+		body.QOS = map[string]interface{}{"policy": map[string]string{"name": data.QOSPolicy.ValueString()}}
+	}
+	if err = interfaces.CreateConsistencyGroup(errorHandler, *client, body); err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetConsistencyGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	resp.Diagnostics.Append(r.flatten(ctx, data, restInfo, false)...)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the policies, then removes and adds volumes.
+func (r *ConsistencyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ConsistencyGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	id := state.ID.ValueString()
+	body := interfaces.ConsistencyGroupResourceBodyDataModelONTAP{}
+	if !data.SnapshotPolicy.IsUnknown() && !data.SnapshotPolicy.Equal(state.SnapshotPolicy) {
+		body.SnapshotPolicy = map[string]string{"name": data.SnapshotPolicy.ValueString()}
+	}
+	if !data.QOSPolicy.IsNull() && !data.QOSPolicy.Equal(state.QOSPolicy) {
+		body.QOS = map[string]interface{}{"policy": map[string]string{"name": data.QOSPolicy.ValueString()}}
+	}
+	if body.SnapshotPolicy != nil || body.QOS != nil {
+		if err = interfaces.UpdateConsistencyGroup(errorHandler, *client, body, id); err != nil {
+			return
+		}
+	}
+
+	// ONTAP does not accept to add and remove volumes in the same request
+	var removed, added []string
+	for _, volume := range state.Volumes {
+		if !StringInSlice(volume.ValueString(), data.Volumes) {
+			removed = append(removed, volume.ValueString())
+		}
+	}
+	for _, volume := range data.Volumes {
+		if !StringInSlice(volume.ValueString(), state.Volumes) {
+			added = append(added, volume.ValueString())
+		}
+	}
+	if len(removed) > 0 {
+		body = interfaces.ConsistencyGroupResourceBodyDataModelONTAP{Volumes: interfaces.ConsistencyGroupVolumes(removed, "remove")}
+		if err = interfaces.UpdateConsistencyGroup(errorHandler, *client, body, id); err != nil {
+			return
+		}
+	}
+	if len(added) > 0 {
+		body = interfaces.ConsistencyGroupResourceBodyDataModelONTAP{Volumes: interfaces.ConsistencyGroupVolumes(added, "add")}
+		if err = interfaces.UpdateConsistencyGroup(errorHandler, *client, body, id); err != nil {
+			return
+		}
+	}
+
+	restInfo, err := interfaces.GetConsistencyGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	resp.Diagnostics.Append(r.flatten(ctx, data, restInfo, false)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the consistency group, the volumes and LUNs are left in place.
+func (r *ConsistencyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ConsistencyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteConsistencyGroup(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ConsistencyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a consistency group resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// flatten sets the model from the ONTAP record, qos_policy is only reported when managed by terraform, or on import
+func (r *ConsistencyGroupResource) flatten(ctx context.Context, data *ConsistencyGroupResourceModel, restInfo *interfaces.ConsistencyGroupGetDataModelONTAP, imported bool) diag.Diagnostics {
+	volumes := make([]string, len(restInfo.Volumes))
+	for index, volume := range restInfo.Volumes {
+		volumes[index] = volume.Name
+	}
+	data.Volumes = flattenUnorderedStringList(data.Volumes, volumes)
+	data.SnapshotPolicy = types.StringValue(restInfo.SnapshotPolicy.Name)
+	if (imported || !data.QOSPolicy.IsNull()) && restInfo.QOS.Policy.Name != "" {
+		data.QOSPolicy = types.StringValue(restInfo.QOS.Policy.Name)
+	}
+	luns := make([]string, len(restInfo.Luns))
+	for index, lun := range restInfo.Luns {
+		luns[index] = lun.Name
+	}
+	var diags diag.Diagnostics
+	data.Luns, diags = types.ListValueFrom(ctx, types.StringType, luns)
+	data.ID = types.StringValue(restInfo.UUID)
+	return diags
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConsistencyGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConsistencyGroupResourceConfig(`["non_existant_volume"]`),
+				ExpectError: regexp.MustCompile("error creating consistency group"),
+			},
+			// Create and read testing
+			{
+				Config: testAccConsistencyGroupResourceConfig(`["ansibleVolume"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_application_consistency_group_resource.example", "name", "acc_test_cg"),
+					resource.TestCheckResourceAttr("netapp-ontap_application_consistency_group_resource.example", "volumes.#", "1"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_application_consistency_group_resource.example", "snapshot_policy"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_application_consistency_group_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_cg", "ansibleSVM", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_application_consistency_group_resource.example", "name", "acc_test_cg"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConsistencyGroupResourceConfig(volumes string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_application_consistency_group_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "ansibleSVM"
+  name = "acc_test_cg"
+  volumes = %s
+}`, host, admin, password, volumes)
+}
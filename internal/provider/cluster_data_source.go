@@ -63,7 +63,7 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				Computed:            true,
@@ -134,7 +134,19 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	zapiClient, err := getZAPIClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewZAPIClient
+		return
+	}
+	useZAPI := interfaces.UseZAPIFallback(errorHandler, *client, zapiClient)
+
+	var cluster *interfaces.ClusterGetDataModelONTAP
+	if useZAPI {
+		cluster, err = interfaces.GetClusterZAPI(errorHandler, zapiClient)
+	} else {
+		cluster, err = interfaces.GetCluster(errorHandler, *client)
+	}
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -149,18 +161,25 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		Full: types.StringValue(cluster.Version.Full),
 	}
 
-	nodes, err := interfaces.GetClusterNodes(errorHandler, *client)
+	var nodes []interfaces.ClusterNodeGetDataModelONTAP
+	if useZAPI {
+		nodes, err = interfaces.GetClusterNodesZAPI(errorHandler, zapiClient)
+	} else {
+		nodes, err = interfaces.GetClusterNodes(errorHandler, *client)
+	}
 	if err != nil {
 		return
 	}
-	if nodes == nil {
+	if len(nodes) == 0 {
 		errorHandler.MakeAndReportError("Cluster Nodes Not found", fmt.Sprintf("cluster nodes not found."))
 		return
 	}
 
 	data.Nodes = make([]NodeDataSourceModel, 1)
-	ipAddressesIn := make([]string, 1)
-	ipAddressesIn[0] = nodes[0].ManagementInterfaces[0].IP.Address
+	ipAddressesIn := []string{}
+	if len(nodes[0].ManagementInterfaces) > 0 {
+		ipAddressesIn = append(ipAddressesIn, nodes[0].ManagementInterfaces[0].IP.Address)
+	}
 	ipAddressesOut, _ := types.ListValueFrom(ctx, types.StringType, ipAddressesIn)
 	data.Nodes[0] = NodeDataSourceModel{
 		Name:            types.StringValue(nodes[0].Name),
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterHealthDataSource{}
+
+// defaultHealthEventSeverities are the EMS severities reported when severities is not set.
+var defaultHealthEventSeverities = []string{"emergency", "alert", "error"}
+
+// defaultHealthEventPeriod is how far back the EMS events are read when since is not set.
+const defaultHealthEventPeriod = 24 * time.Hour
+
+// NewClusterHealthDataSource is a helper function to simplify the provider implementation.
+func NewClusterHealthDataSource() datasource.DataSource {
+	return &ClusterHealthDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_health_data_source",
+		},
+	}
+}
+
+// ClusterHealthDataSource defines the data source implementation.
+type ClusterHealthDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterHealthDataSourceModel describes the data source data model.
+type ClusterHealthDataSourceModel struct {
+	CxProfileName types.String                        `tfsdk:"cx_profile_name"`
+	Severities    []types.String                      `tfsdk:"severities"`
+	Since         types.String                        `tfsdk:"since"`
+	Healthy       types.Bool                          `tfsdk:"healthy"`
+	Events        []ClusterHealthEventDataSourceModel `tfsdk:"events"`
+	Alerts        []ClusterHealthAlertDataSourceModel `tfsdk:"alerts"`
+}
+
+// ClusterHealthEventDataSourceModel describes an EMS event.
+type ClusterHealthEventDataSourceModel struct {
+	Index      types.Int64  `tfsdk:"index"`
+	Time       types.String `tfsdk:"time"`
+	Node       types.String `tfsdk:"node"`
+	Name       types.String `tfsdk:"name"`
+	Severity   types.String `tfsdk:"severity"`
+	LogMessage types.String `tfsdk:"log_message"`
+}
+
+// ClusterHealthAlertDataSourceModel describes a system health alert.
+type ClusterHealthAlertDataSourceModel struct {
+	Node              types.String `tfsdk:"node"`
+	Monitor           types.String `tfsdk:"monitor"`
+	AlertID           types.String `tfsdk:"alert_id"`
+	AlertingResource  types.String `tfsdk:"alerting_resource"`
+	Severity          types.String `tfsdk:"severity"`
+	ProbableCause     types.String `tfsdk:"probable_cause"`
+	PossibleEffect    types.String `tfsdk:"possible_effect"`
+	CorrectiveActions types.String `tfsdk:"corrective_actions"`
+	Acknowledged      types.Bool   `tfsdk:"acknowledged"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster health data source, to read the recent EMS events and the active system health alerts",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"severities": schema.ListAttribute{
+				MarkdownDescription: "Only return the EMS events with these severities, among emergency, alert, error, notice, informational, and debug. Defaults to emergency, alert, and error",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("emergency", "alert", "error", "notice", "informational", "debug")),
+				},
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return the EMS events logged after this time, in RFC 3339 format, eg 2024-03-01T00:00:00Z. Defaults to 24 hours ago",
+				Optional:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "True when no EMS event matches the filter, and there is no unacknowledged system health alert",
+				Computed:            true,
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "EMS events matching the filter, the most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "Index of the event, unique per node",
+							Computed:            true,
+						},
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Time of the event, in ISO 8601 format",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node name",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Message name, eg disk.outOfService",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Message severity",
+							Computed:            true,
+						},
+						"log_message": schema.StringAttribute{
+							MarkdownDescription: "Message text",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"alerts": schema.ListNestedAttribute{
+				MarkdownDescription: "Active system health alerts, including the acknowledged ones",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node name",
+							Computed:            true,
+						},
+						"monitor": schema.StringAttribute{
+							MarkdownDescription: "Health monitor that raised the alert",
+							Computed:            true,
+						},
+						"alert_id": schema.StringAttribute{
+							MarkdownDescription: "Alert ID",
+							Computed:            true,
+						},
+						"alerting_resource": schema.StringAttribute{
+							MarkdownDescription: "Resource the alert applies to",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Perceived severity",
+							Computed:            true,
+						},
+						"probable_cause": schema.StringAttribute{
+							MarkdownDescription: "Probable cause",
+							Computed:            true,
+						},
+						"possible_effect": schema.StringAttribute{
+							MarkdownDescription: "Possible effect",
+							Computed:            true,
+						},
+						"corrective_actions": schema.StringAttribute{
+							MarkdownDescription: "Corrective actions",
+							Computed:            true,
+						},
+						"acknowledged": schema.BoolAttribute{
+							MarkdownDescription: "Whether the alert was acknowledged",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterHealthDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := interfaces.EmsEventDataSourceFilterModel{Severities: defaultHealthEventSeverities}
+	if data.Severities != nil {
+		filter.Severities = nil
+		for _, severity := range data.Severities {
+			filter.Severities = append(filter.Severities, severity.ValueString())
+		}
+	}
+	if data.Since.IsNull() {
+		filter.Since = time.Now().UTC().Add(-defaultHealthEventPeriod).Format(time.RFC3339)
+	} else {
+		since, err := time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), "Invalid since value", fmt.Sprintf("expecting a time in RFC 3339 format, eg 2024-03-01T00:00:00Z: %s", err))
+			return
+		}
+		filter.Since = since.UTC().Format(time.RFC3339)
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	events, err := interfaces.GetEmsEvents(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetEmsEvents
+		return
+	}
+	alerts, err := interfaces.GetClusterHealthAlerts(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterHealthAlerts
+		return
+	}
+
+	healthy := len(events) == 0
+	data.Events = make([]ClusterHealthEventDataSourceModel, 0, len(events))
+	for _, event := range events {
+		data.Events = append(data.Events, ClusterHealthEventDataSourceModel{
+			Index:      types.Int64Value(event.Index),
+			Time:       types.StringValue(event.Time),
+			Node:       types.StringValue(event.Node.Name),
+			Name:       types.StringValue(event.Message.Name),
+			Severity:   types.StringValue(event.Message.Severity),
+			LogMessage: types.StringValue(event.LogMessage),
+		})
+	}
+	data.Alerts = make([]ClusterHealthAlertDataSourceModel, 0, len(alerts))
+	for _, alert := range alerts {
+		if !alert.Acknowledge {
+			healthy = false
+		}
+		data.Alerts = append(data.Alerts, ClusterHealthAlertDataSourceModel{
+			Node:              types.StringValue(alert.Node),
+			Monitor:           types.StringValue(alert.Monitor),
+			AlertID:           types.StringValue(alert.AlertID),
+			AlertingResource:  types.StringValue(alert.AlertingResource),
+			Severity:          types.StringValue(alert.PerceivedSeverity),
+			ProbableCause:     types.StringValue(alert.ProbableCause),
+			PossibleEffect:    types.StringValue(alert.PossibleEffect),
+			CorrectiveActions: types.StringValue(alert.CorrectiveActions),
+			Acknowledged:      types.BoolValue(alert.Acknowledge),
+		})
+	}
+	data.Healthy = types.BoolValue(healthy)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %d events, %d alerts, healthy %t", len(data.Events), len(data.Alerts), healthy))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -84,7 +84,7 @@ func (d *ClusterLicensingLicenseDataSource) Schema(ctx context.Context, req data
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "ClusterLicensingLicense name",
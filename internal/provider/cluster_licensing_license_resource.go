@@ -57,7 +57,7 @@ func (r *ClusterLicensingLicenseResource) Schema(ctx context.Context, req resour
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"keys": schema.SetAttribute{
 				Required:            true,
@@ -261,7 +261,7 @@ func (r *ClusterLicensingLicenseResource) ImportState(ctx context.Context, req r
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
 		)
 		return
 	}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterMetroclusterDataSource{}
+
+// NewClusterMetroclusterDataSource is a helper function to simplify the provider implementation.
+func NewClusterMetroclusterDataSource() datasource.DataSource {
+	return &ClusterMetroclusterDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_metrocluster_data_source",
+		},
+	}
+}
+
+// ClusterMetroclusterDataSource defines the data source implementation.
+type ClusterMetroclusterDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterMetroclusterDataSourceModel describes the data source data model.
+type ClusterMetroclusterDataSourceModel struct {
+	CxProfileName     types.String                            `tfsdk:"cx_profile_name"`
+	ConfigurationType types.String                            `tfsdk:"configuration_type"`
+	Local             *ClusterMetroclusterSiteDataSourceModel `tfsdk:"local"`
+	Remote            *ClusterMetroclusterSiteDataSourceModel `tfsdk:"remote"`
+	SwitchoverReady   types.Bool                              `tfsdk:"switchover_ready"`
+}
+
+// ClusterMetroclusterSiteDataSourceModel describes the data model for a MetroCluster site.
+type ClusterMetroclusterSiteDataSourceModel struct {
+	ClusterName             types.String `tfsdk:"cluster_name"`
+	ConfigurationState      types.String `tfsdk:"configuration_state"`
+	Mode                    types.String `tfsdk:"mode"`
+	PeriodicCheckEnabled    types.Bool   `tfsdk:"periodic_check_enabled"`
+	PartnerClusterReachable types.Bool   `tfsdk:"partner_cluster_reachable"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterMetroclusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+func clusterMetroclusterSiteSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Cluster name",
+				Computed:            true,
+			},
+			"configuration_state": schema.StringAttribute{
+				MarkdownDescription: "Configuration state, eg configured, not_configured, partially_configured",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Mode, eg normal, switchover, partial_switchover",
+				Computed:            true,
+			},
+			"periodic_check_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the periodic MetroCluster check is enabled",
+				Computed:            true,
+			},
+			"partner_cluster_reachable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the partner cluster is reachable",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterMetroclusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "MetroCluster configuration state data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"configuration_type": schema.StringAttribute{
+				MarkdownDescription: "MetroCluster configuration type, eg fc, ip_fabric",
+				Computed:            true,
+			},
+			"local":  clusterMetroclusterSiteSchema("State of the local site"),
+			"remote": clusterMetroclusterSiteSchema("State of the remote site"),
+			"switchover_ready": schema.BoolAttribute{
+				MarkdownDescription: "True when MetroCluster is configured, both sites are in normal mode and the partner cluster is reachable",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterMetroclusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+func flattenClusterMetroclusterSite(site interfaces.MetroclusterSite) *ClusterMetroclusterSiteDataSourceModel {
+	return &ClusterMetroclusterSiteDataSourceModel{
+		ClusterName:             types.StringValue(site.Cluster.Name),
+		ConfigurationState:      types.StringValue(site.ConfigurationState),
+		Mode:                    types.StringValue(site.Mode),
+		PeriodicCheckEnabled:    types.BoolValue(site.PeriodicCheckEnabled),
+		PartnerClusterReachable: types.BoolValue(site.PartnerClusterReachable),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterMetroclusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterMetroclusterDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetMetrocluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetMetrocluster
+		return
+	}
+
+	data.ConfigurationType = types.StringValue(restInfo.ConfigurationType)
+	data.Local = flattenClusterMetroclusterSite(restInfo.Local)
+	data.Remote = flattenClusterMetroclusterSite(restInfo.Remote)
+	data.SwitchoverReady = types.BoolValue(restInfo.IsReadyForSwitchover())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterMetroclusterDrGroupsDataSource{}
+
+// NewClusterMetroclusterDrGroupsDataSource is a helper function to simplify the provider implementation.
+func NewClusterMetroclusterDrGroupsDataSource() datasource.DataSource {
+	return &ClusterMetroclusterDrGroupsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_metrocluster_dr_groups_data_source",
+		},
+	}
+}
+
+// ClusterMetroclusterDrGroupsDataSource defines the data source implementation.
+type ClusterMetroclusterDrGroupsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterMetroclusterDrGroupsDataSourceModel describes the data source data model.
+type ClusterMetroclusterDrGroupsDataSourceModel struct {
+	CxProfileName types.String                                `tfsdk:"cx_profile_name"`
+	DrGroups      []ClusterMetroclusterDrGroupDataSourceModel `tfsdk:"dr_groups"`
+}
+
+// ClusterMetroclusterDrGroupDataSourceModel describes the data model for a DR group.
+type ClusterMetroclusterDrGroupDataSourceModel struct {
+	ID                 types.Int64                                `tfsdk:"id"`
+	PartnerClusterName types.String                               `tfsdk:"partner_cluster_name"`
+	DrPairs            []ClusterMetroclusterDrPairDataSourceModel `tfsdk:"dr_pairs"`
+}
+
+// ClusterMetroclusterDrPairDataSourceModel describes the data model for a node and its DR partner.
+type ClusterMetroclusterDrPairDataSourceModel struct {
+	NodeName        types.String `tfsdk:"node_name"`
+	PartnerNodeName types.String `tfsdk:"partner_node_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterMetroclusterDrGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterMetroclusterDrGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "MetroCluster DR groups data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"dr_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "DR group ID",
+							Computed:            true,
+						},
+						"partner_cluster_name": schema.StringAttribute{
+							MarkdownDescription: "Partner cluster name",
+							Computed:            true,
+						},
+						"dr_pairs": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"node_name": schema.StringAttribute{
+										MarkdownDescription: "Local node name",
+										Computed:            true,
+									},
+									"partner_node_name": schema.StringAttribute{
+										MarkdownDescription: "DR partner node name",
+										Computed:            true,
+									},
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "Nodes and their DR partner",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterMetroclusterDrGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterMetroclusterDrGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterMetroclusterDrGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetListMetroclusterDrGroups(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetListMetroclusterDrGroups
+		return
+	}
+
+	data.DrGroups = make([]ClusterMetroclusterDrGroupDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		drPairs := make([]ClusterMetroclusterDrPairDataSourceModel, len(record.DrPairs))
+		for pairIndex, pair := range record.DrPairs {
+			drPairs[pairIndex] = ClusterMetroclusterDrPairDataSourceModel{
+				NodeName:        types.StringValue(pair.Node.Name),
+				PartnerNodeName: types.StringValue(pair.Partner.Name),
+			}
+		}
+		data.DrGroups[index] = ClusterMetroclusterDrGroupDataSourceModel{
+			ID:                 types.Int64Value(int64(record.ID)),
+			PartnerClusterName: types.StringValue(record.PartnerCluster.Name),
+			DrPairs:            drPairs,
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
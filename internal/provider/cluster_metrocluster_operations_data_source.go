@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterMetroclusterOperationsDataSource{}
+
+// NewClusterMetroclusterOperationsDataSource is a helper function to simplify the provider implementation.
+func NewClusterMetroclusterOperationsDataSource() datasource.DataSource {
+	return &ClusterMetroclusterOperationsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_metrocluster_operations_data_source",
+		},
+	}
+}
+
+// ClusterMetroclusterOperationsDataSource defines the data source implementation.
+type ClusterMetroclusterOperationsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterMetroclusterOperationsDataSourceModel describes the data source data model.
+type ClusterMetroclusterOperationsDataSourceModel struct {
+	CxProfileName types.String                                       `tfsdk:"cx_profile_name"`
+	Operations    []ClusterMetroclusterOperationDataSourceModel      `tfsdk:"operations"`
+	Filter        *ClusterMetroclusterOperationDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ClusterMetroclusterOperationDataSourceModel describes the data model for a MetroCluster operation.
+type ClusterMetroclusterOperationDataSourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Type      types.String   `tfsdk:"type"`
+	State     types.String   `tfsdk:"state"`
+	StartTime types.String   `tfsdk:"start_time"`
+	EndTime   types.String   `tfsdk:"end_time"`
+	Errors    []types.String `tfsdk:"errors"`
+}
+
+// ClusterMetroclusterOperationDataSourceFilterModel describes the data source data model for queries.
+type ClusterMetroclusterOperationDataSourceFilterModel struct {
+	Type  types.String `tfsdk:"type"`
+	State types.String `tfsdk:"state"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterMetroclusterOperationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterMetroclusterOperationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "MetroCluster operations data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Operation type, eg check, switchover, switchback, heal_aggregates",
+						Optional:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Operation state, eg running, successful, failed",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"operations": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Operation UUID",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Operation type",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Operation state",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start time",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End time",
+							Computed:            true,
+						},
+						"errors": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Errors reported by the operation",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterMetroclusterOperationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterMetroclusterOperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterMetroclusterOperationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.MetroclusterOperationDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.MetroclusterOperationDataSourceFilterModel{
+			Type:  data.Filter.Type.ValueString(),
+			State: data.Filter.State.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListMetroclusterOperations(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListMetroclusterOperations
+		return
+	}
+
+	data.Operations = make([]ClusterMetroclusterOperationDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.Operations[index] = ClusterMetroclusterOperationDataSourceModel{
+			ID:        types.StringValue(record.UUID),
+			Type:      types.StringValue(record.Type),
+			State:     types.StringValue(record.State),
+			StartTime: types.StringValue(record.StartTime),
+			EndTime:   types.StringValue(record.EndTime),
+			Errors:    flattenTypesStringList(record.Errors),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterNodeDataSource{}
+
+// NewClusterNodeDataSource is a helper function to simplify the provider implementation.
+func NewClusterNodeDataSource() datasource.DataSource {
+	return &ClusterNodeDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_node_data_source",
+		},
+	}
+}
+
+// ClusterNodeDataSource defines the data source implementation.
+type ClusterNodeDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterNodeDataSourceModel describes the data source data model.
+type ClusterNodeDataSourceModel struct {
+	CxProfileName        types.String                              `tfsdk:"cx_profile_name"`
+	Name                 types.String                              `tfsdk:"name"`
+	ID                   types.String                              `tfsdk:"id"`
+	Model                types.String                              `tfsdk:"model"`
+	SerialNumber         types.String                              `tfsdk:"serial_number"`
+	Location             types.String                              `tfsdk:"location"`
+	State                types.String                              `tfsdk:"state"`
+	Uptime               types.Int64                               `tfsdk:"uptime"`
+	Version              types.String                              `tfsdk:"version"`
+	ManagementInterfaces []ClusterNodeManagementInterfaceDataModel `tfsdk:"management_interfaces"`
+	HAEnabled            types.Bool                                `tfsdk:"ha_enabled"`
+	HAPartnerName        types.String                              `tfsdk:"ha_partner_name"`
+}
+
+// ClusterNodeManagementInterfaceDataModel describes the data model for a node management interface.
+type ClusterNodeManagementInterfaceDataModel struct {
+	Name      types.String `tfsdk:"name"`
+	IPAddress types.String `tfsdk:"ip_address"`
+}
+
+// ClusterNodeDataSourceFilterModel describes the data source data model for queries.
+type ClusterNodeDataSourceFilterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Model types.String `tfsdk:"model"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterNodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterNodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster node data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Node UUID",
+				Computed:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Node model",
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Node serial number",
+				Computed:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Node location",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Node state, eg up, down, taken_over",
+				Computed:            true,
+			},
+			"uptime": schema.Int64Attribute{
+				MarkdownDescription: "Node uptime in seconds",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "ONTAP version of the node",
+				Computed:            true,
+			},
+			"management_interfaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Node management interfaces",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Interface name",
+							Computed:            true,
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "Interface IP address",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ha_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether storage failover is enabled",
+				Computed:            true,
+			},
+			"ha_partner_name": schema.StringAttribute{
+				MarkdownDescription: "HA partner node name, empty for a single node cluster",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterNodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// flattenClusterNode sets the computed attributes from a node record
+func flattenClusterNode(data *ClusterNodeDataSourceModel, record interfaces.NodeGetDataModelONTAP) {
+	data.Name = types.StringValue(record.Name)
+	data.ID = types.StringValue(record.UUID)
+	data.Model = types.StringValue(record.Model)
+	data.SerialNumber = types.StringValue(record.SerialNumber)
+	data.Location = types.StringValue(record.Location)
+	data.State = types.StringValue(record.State)
+	data.Uptime = types.Int64Value(record.Uptime)
+	data.Version = types.StringValue(record.Version.Full)
+	data.ManagementInterfaces = make([]ClusterNodeManagementInterfaceDataModel, len(record.ManagementInterfaces))
+	for index, mgmtInterface := range record.ManagementInterfaces {
+		data.ManagementInterfaces[index] = ClusterNodeManagementInterfaceDataModel{
+			Name:      types.StringValue(mgmtInterface.Name),
+			IPAddress: types.StringValue(mgmtInterface.IP.Address),
+		}
+	}
+	data.HAEnabled = types.BoolValue(record.HA.Enabled)
+	data.HAPartnerName = types.StringValue(record.HAPartnerName())
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterNodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterNodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetClusterNodeByName(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetClusterNodeByName
+		return
+	}
+	flattenClusterNode(&data, *restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterNodesDataSource{}
+
+// NewClusterNodesDataSource is a helper function to simplify the provider implementation.
+func NewClusterNodesDataSource() datasource.DataSource {
+	return &ClusterNodesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_nodes_data_source",
+		},
+	}
+}
+
+// ClusterNodesDataSource defines the data source implementation.
+type ClusterNodesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterNodesDataSourceModel describes the data source data model.
+type ClusterNodesDataSourceModel struct {
+	CxProfileName types.String                      `tfsdk:"cx_profile_name"`
+	ClusterNodes  []ClusterNodeDataSourceModel      `tfsdk:"cluster_nodes"`
+	Filter        *ClusterNodeDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster nodes data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Node name, wildcards are supported",
+						Optional:            true,
+					},
+					"model": schema.StringAttribute{
+						MarkdownDescription: "Node model",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"cluster_nodes": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Node name",
+							Required:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Node UUID",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Node model",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "Node serial number",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "Node location",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Node state, eg up, down, taken_over",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							MarkdownDescription: "Node uptime in seconds",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "ONTAP version of the node",
+							Computed:            true,
+						},
+						"management_interfaces": schema.ListNestedAttribute{
+							MarkdownDescription: "Node management interfaces",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Interface name",
+										Computed:            true,
+									},
+									"ip_address": schema.StringAttribute{
+										MarkdownDescription: "Interface IP address",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"ha_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether storage failover is enabled",
+							Computed:            true,
+						},
+						"ha_partner_name": schema.StringAttribute{
+							MarkdownDescription: "HA partner node name, empty for a single node cluster",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterNodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.NodeDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.NodeDataSourceFilterModel{
+			Name:  data.Filter.Name.ValueString(),
+			Model: data.Filter.Model.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListClusterNodes(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListClusterNodes
+		return
+	}
+
+	data.ClusterNodes = make([]ClusterNodeDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ClusterNodes[index] = ClusterNodeDataSourceModel{
+			CxProfileName: data.CxProfileName,
+		}
+		flattenClusterNode(&data.ClusterNodes[index], record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ClusterResource{}
+var _ resource.ResourceWithImportState = &ClusterResource{}
+
+// NewClusterResource is a helper function to simplify the provider implementation.
+func NewClusterResource() resource.Resource {
+	return &ClusterResource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_resource",
+		},
+	}
+}
+
+// ClusterResource defines the resource implementation.
+type ClusterResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterResourceModel describes the resource data model.
+type ClusterResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	Name          types.String   `tfsdk:"name"`
+	Location      types.String   `tfsdk:"location"`
+	Contact       types.String   `tfsdk:"contact"`
+	DNSDomains    []types.String `tfsdk:"dns_domains"`
+	NameServers   []types.String `tfsdk:"name_servers"`
+	Timezone      types.String   `tfsdk:"timezone"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster resource, to manage the identity and time zone settings of an existing cluster. The cluster is not created or deleted, the settings are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Cluster name, the cluster is renamed when it is changed",
+				Optional:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Location of the cluster",
+				Optional:            true,
+			},
+			"contact": schema.StringAttribute{
+				MarkdownDescription: "Contact information for the cluster, such as an email address",
+				Optional:            true,
+			},
+			"dns_domains": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "DNS domains of the cluster, in search order",
+				Optional:            true,
+			},
+			"name_servers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IP addresses of the DNS servers of the cluster, in query order",
+				Optional:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Time zone of the cluster, in the IANA format such as Etc/UTC or Europe/Paris",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Cluster identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetClusterIdentity(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterIdentity
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	if imported || !data.Name.IsNull() {
+		data.Name = types.StringValue(restInfo.Name)
+	}
+	if imported || !data.Location.IsNull() {
+		data.Location = types.StringValue(restInfo.Location)
+	}
+	if imported || !data.Contact.IsNull() {
+		data.Contact = types.StringValue(restInfo.Contact)
+	}
+	if imported || data.DNSDomains != nil {
+		data.DNSDomains = flattenTypesStringList(restInfo.DNSDomains)
+	}
+	if imported || data.NameServers != nil {
+		data.NameServers = flattenTypesStringList(restInfo.NameServers)
+	}
+	if imported || !data.Timezone.IsNull() {
+		data.Timezone = types.StringValue(restInfo.Timezone.Name)
+	}
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "cluster")...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the cluster settings, as the cluster always exists
+func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClusterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ClusterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "cluster")...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings, the other settings are left unchanged.
+func (r *ClusterResource) apply(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *ClusterResourceModel) error {
+	body := interfaces.ClusterResourceBodyDataModelONTAP{
+		Name:        data.Name.ValueString(),
+		Location:    data.Location.ValueStringPointer(),
+		Contact:     data.Contact.ValueStringPointer(),
+		DNSDomains:  expandTypesStringList(data.DNSDomains),
+		NameServers: expandTypesStringList(data.NameServers),
+	}
+	if !data.Timezone.IsNull() {
+		body.Timezone = &interfaces.ClusterTimezone{Name: data.Timezone.ValueString()}
+	}
+	return interfaces.UpdateCluster(errorHandler, client, body)
+}
+
+// Delete removes the resource from the Terraform state, the cluster settings are left unchanged.
+func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClusterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("cluster %s removed from state, cluster settings are left unchanged", data.ID.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
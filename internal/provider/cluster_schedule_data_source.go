@@ -63,7 +63,7 @@ func (d *ClusterScheduleDataSource) Schema(ctx context.Context, req datasource.S
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Schedule name",
@@ -69,7 +69,7 @@ func (r *ClusterScheduleResource) Schema(ctx context.Context, req resource.Schem
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the cluster schedule",
@@ -220,6 +220,7 @@ func (r *ClusterScheduleResource) Read(ctx context.Context, req resource.ReadReq
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "cluster/schedules/"+data.ID.ValueString())...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -300,6 +301,7 @@ func (r *ClusterScheduleResource) Update(ctx context.Context, req resource.Updat
 	if err != nil {
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	var request interfaces.ClusterScheduleResourceBodyDataModelONTAP
 	if !data.Interval.IsNull() {
@@ -333,6 +335,7 @@ func (r *ClusterScheduleResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "cluster/schedules/"+data.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
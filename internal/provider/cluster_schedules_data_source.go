@@ -55,7 +55,7 @@ func (d *ClusterSchedulesDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ClusterServiceProcessorResource{}
+var _ resource.ResourceWithImportState = &ClusterServiceProcessorResource{}
+
+// NewClusterServiceProcessorResource is a helper function to simplify the provider implementation.
+func NewClusterServiceProcessorResource() resource.Resource {
+	return &ClusterServiceProcessorResource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_service_processor_resource",
+		},
+	}
+}
+
+// ClusterServiceProcessorResource defines the resource implementation.
+type ClusterServiceProcessorResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterServiceProcessorResourceIPv4 describes the resource data model for the service processor IPv4 interface.
+type ClusterServiceProcessorResourceIPv4 struct {
+	Address types.String `tfsdk:"address"`
+	Netmask types.String `tfsdk:"netmask"`
+	Gateway types.String `tfsdk:"gateway"`
+}
+
+// ClusterServiceProcessorResourceModel describes the resource data model.
+type ClusterServiceProcessorResourceModel struct {
+	CxProfileName       types.String                         `tfsdk:"cx_profile_name"`
+	NodeName            types.String                         `tfsdk:"node_name"`
+	DHCPEnabled         types.Bool                           `tfsdk:"dhcp_enabled"`
+	IPv4Interface       *ClusterServiceProcessorResourceIPv4 `tfsdk:"ipv4_interface"`
+	SSHAllowedAddresses []types.String                       `tfsdk:"ssh_allowed_addresses"`
+	ID                  types.String                         `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ClusterServiceProcessorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ClusterServiceProcessorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Service processor network and SSH access resource. The settings are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dhcp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service processor uses DHCP to configure its IPv4 interface",
+				Optional:            true,
+			},
+			"ipv4_interface": schema.SingleNestedAttribute{
+				MarkdownDescription: "Static IPv4 configuration, when DHCP is not enabled",
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						MarkdownDescription: "IPv4 address",
+						Required:            true,
+					},
+					"netmask": schema.StringAttribute{
+						MarkdownDescription: "IPv4 mask, eg 255.255.255.0",
+						Required:            true,
+					},
+					"gateway": schema.StringAttribute{
+						MarkdownDescription: "IPv4 gateway",
+						Required:            true,
+					},
+				},
+				Optional: true,
+			},
+			"ssh_allowed_addresses": schema.ListAttribute{
+				MarkdownDescription: "Addresses allowed to connect to the service processor with SSH, in address/mask format. Use 0.0.0.0/0 and ::/0 to allow all",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Node UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClusterServiceProcessorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClusterServiceProcessorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterServiceProcessorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetClusterServiceProcessor(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		// error reporting done inside GetClusterServiceProcessor
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	data.ID = types.StringValue(restInfo.UUID)
+	if imported || !data.DHCPEnabled.IsNull() {
+		data.DHCPEnabled = types.BoolValue(restInfo.ServiceProcessor.DHCPEnabled)
+	}
+	if (imported && !restInfo.ServiceProcessor.DHCPEnabled) || data.IPv4Interface != nil {
+		data.IPv4Interface = &ClusterServiceProcessorResourceIPv4{
+			Address: types.StringValue(restInfo.ServiceProcessor.IPv4Interface.Address),
+			Netmask: types.StringValue(restInfo.ServiceProcessor.IPv4Interface.Netmask),
+			Gateway: types.StringValue(restInfo.ServiceProcessor.IPv4Interface.Gateway),
+		}
+	}
+	if imported || data.SSHAllowedAddresses != nil {
+		data.SSHAllowedAddresses = flattenTypesStringList(restInfo.ServiceProcessor.SSHInfo.AllowedAddresses)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the service processor settings, as the service processor always exists on the node
+func (r *ClusterServiceProcessorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClusterServiceProcessorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	node, err := interfaces.GetClusterServiceProcessor(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		return
+	}
+
+	body, err := r.buildBody(errorHandler, data)
+	if err != nil {
+		return
+	}
+	if err = interfaces.UpdateClusterServiceProcessor(errorHandler, *client, body, node.UUID); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(node.UUID)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ClusterServiceProcessorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ClusterServiceProcessorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body, err := r.buildBody(errorHandler, data)
+	if err != nil {
+		return
+	}
+	if err = interfaces.UpdateClusterServiceProcessor(errorHandler, *client, body, data.ID.ValueString()); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildBody only includes the settings managed by terraform
+func (r *ClusterServiceProcessorResource) buildBody(errorHandler *utils.ErrorHandler, data *ClusterServiceProcessorResourceModel) (interfaces.ClusterServiceProcessorResourceBodyDataModelONTAP, error) {
+	var body interfaces.ClusterServiceProcessorResourceBodyDataModelONTAP
+	if data.DHCPEnabled.ValueBool() && data.IPv4Interface != nil {
+		return body, errorHandler.MakeAndReportError("invalid service processor config", "ipv4_interface cannot be set when dhcp_enabled is true")
+	}
+	if !data.DHCPEnabled.IsNull() {
+		dhcpEnabled := data.DHCPEnabled.ValueBool()
+		body.ServiceProcessor.DHCPEnabled = &dhcpEnabled
+	}
+	if data.IPv4Interface != nil {
+		body.ServiceProcessor.IPv4Interface = &interfaces.ClusterServiceProcessorIPv4{
+			Address: data.IPv4Interface.Address.ValueString(),
+			Netmask: data.IPv4Interface.Netmask.ValueString(),
+			Gateway: data.IPv4Interface.Gateway.ValueString(),
+		}
+	}
+	if data.SSHAllowedAddresses != nil {
+		addresses := make([]string, len(data.SSHAllowedAddresses))
+		for index, address := range data.SSHAllowedAddresses {
+			addresses[index] = address.ValueString()
+		}
+		body.ServiceProcessor.SSHInfo = &interfaces.ClusterServiceProcessorSSHInfo{AllowedAddresses: addresses}
+	}
+	return body, nil
+}
+
+// Delete removes the resource from the Terraform state, the service processor settings are left unchanged.
+func (r *ClusterServiceProcessorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClusterServiceProcessorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("service processor for node %s removed from state, settings are left unchanged", data.NodeName.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ClusterServiceProcessorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a service processor resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: node_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccClusterServiceProcessorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccClusterServiceProcessorResourceConfig(`["0.0.0.0/0", "::/0"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_service_processor_resource.example", "node_name", "swenjun-vsim1"),
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_service_processor_resource.example", "ssh_allowed_addresses.#", "2"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccClusterServiceProcessorResourceConfig(`["10.193.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_service_processor_resource.example", "ssh_allowed_addresses.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_service_processor_resource.example", "ssh_allowed_addresses.0", "10.193.0.0/16"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_cluster_service_processor_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "swenjun-vsim1", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_service_processor_resource.example", "node_name", "swenjun-vsim1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterServiceProcessorResourceConfig(allowedAddresses string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_cluster_service_processor_resource" "example" {
+  cx_profile_name = "cluster4"
+  node_name = "swenjun-vsim1"
+  ssh_allowed_addresses = %s
+}`, host, admin, password, allowedAddresses)
+}
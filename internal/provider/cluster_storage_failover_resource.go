@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ClusterStorageFailoverResource{}
+var _ resource.ResourceWithImportState = &ClusterStorageFailoverResource{}
+
+// NewClusterStorageFailoverResource is a helper function to simplify the provider implementation.
+func NewClusterStorageFailoverResource() resource.Resource {
+	return &ClusterStorageFailoverResource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_storage_failover_resource",
+		},
+	}
+}
+
+// ClusterStorageFailoverResource defines the resource implementation.
+type ClusterStorageFailoverResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterStorageFailoverResourceModel describes the resource data model.
+type ClusterStorageFailoverResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	AutoGiveback  types.Bool   `tfsdk:"auto_giveback"`
+	Hwassist      types.Bool   `tfsdk:"hwassist"`
+	PartnerName   types.String `tfsdk:"partner_name"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ClusterStorageFailoverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ClusterStorageFailoverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage failover resource, for a node in an HA pair. The settings are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether storage failover is enabled. This applies to both nodes in the HA pair",
+				Optional:            true,
+			},
+			"auto_giveback": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node automatically gives back storage to its partner once the partner is back up after a takeover",
+				Optional:            true,
+			},
+			"hwassist": schema.BoolAttribute{
+				MarkdownDescription: "Whether hardware-assisted takeover is enabled, so the partner takes over faster when the node fails",
+				Optional:            true,
+			},
+			"partner_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HA partner node",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClusterStorageFailoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClusterStorageFailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterStorageFailoverResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetClusterStorageFailover(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		// error reporting done inside GetClusterStorageFailover
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	data.ID = types.StringValue(restInfo.Node)
+	data.PartnerName = types.StringValue(restInfo.PartnerName)
+	if imported || !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(restInfo.Enabled)
+	}
+	if imported || !data.AutoGiveback.IsNull() {
+		data.AutoGiveback = types.BoolValue(restInfo.AutoGiveback)
+	}
+	if imported || !data.Hwassist.IsNull() {
+		data.Hwassist = types.BoolValue(restInfo.Hwassist)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the storage failover settings, as storage failover always exists on a node in an HA pair
+func (r *ClusterStorageFailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClusterStorageFailoverResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	node, err := interfaces.GetClusterStorageFailover(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		return
+	}
+
+	if err = interfaces.UpdateClusterStorageFailover(errorHandler, *client, r.buildBody(data), node.Node); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(node.Node)
+	data.PartnerName = types.StringValue(node.PartnerName)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ClusterStorageFailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ClusterStorageFailoverResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateClusterStorageFailover(errorHandler, *client, r.buildBody(data), data.ID.ValueString()); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildBody only includes the settings managed by terraform
+func (r *ClusterStorageFailoverResource) buildBody(data *ClusterStorageFailoverResourceModel) interfaces.ClusterStorageFailoverResourceBodyDataModelONTAP {
+	var body interfaces.ClusterStorageFailoverResourceBodyDataModelONTAP
+	body.Enabled = data.Enabled.ValueBoolPointer()
+	body.AutoGiveback = data.AutoGiveback.ValueBoolPointer()
+	body.Hwassist = data.Hwassist.ValueBoolPointer()
+	return body
+}
+
+// Delete removes the resource from the Terraform state, the storage failover settings are left unchanged.
+func (r *ClusterStorageFailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClusterStorageFailoverResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("storage failover for node %s removed from state, settings are left unchanged", data.NodeName.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ClusterStorageFailoverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a storage failover resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: node_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ClusterTimeDataSource{}
+
+// NewClusterTimeDataSource is a helper function to simplify the provider implementation.
+func NewClusterTimeDataSource() datasource.DataSource {
+	return &ClusterTimeDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_time_data_source",
+		},
+	}
+}
+
+// ClusterTimeDataSource defines the data source implementation.
+type ClusterTimeDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterTimeDataSourceModel describes the data source data model.
+type ClusterTimeDataSourceModel struct {
+	CxProfileName   types.String                     `tfsdk:"cx_profile_name"`
+	Timezone        types.String                     `tfsdk:"timezone"`
+	NtpSynchronized types.Bool                       `tfsdk:"ntp_synchronized"`
+	Nodes           []ClusterTimeNodeDataSourceModel `tfsdk:"nodes"`
+	NtpServers      []ClusterTimeNtpDataSourceModel  `tfsdk:"ntp_servers"`
+}
+
+// ClusterTimeNodeDataSourceModel describes the time of a node.
+type ClusterTimeNodeDataSourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Date            types.String `tfsdk:"date"`
+	NtpSynchronized types.Bool   `tfsdk:"ntp_synchronized"`
+}
+
+// ClusterTimeNtpDataSourceModel describes the status of an NTP server, as seen by a node.
+type ClusterTimeNtpDataSourceModel struct {
+	Node      types.String `tfsdk:"node"`
+	Server    types.String `tfsdk:"server"`
+	Reachable types.Bool   `tfsdk:"reachable"`
+	Selected  types.Bool   `tfsdk:"selected"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterTimeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterTimeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster time data source, to check the time zone, the time of each node, and the NTP synchronization status",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Time zone of the cluster",
+				Computed:            true,
+			},
+			"ntp_synchronized": schema.BoolAttribute{
+				MarkdownDescription: "Whether every node is synchronized with an NTP server",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Time of each node",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Node name",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Current time of the node, in ISO 8601 format with the time zone offset",
+							Computed:            true,
+						},
+						"ntp_synchronized": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node is synchronized with an NTP server",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ntp_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "Status of each NTP server, as seen by each node",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node name",
+							Computed:            true,
+						},
+						"server": schema.StringAttribute{
+							MarkdownDescription: "NTP server name or IP address",
+							Computed:            true,
+						},
+						"reachable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the server is reachable from the node",
+							Computed:            true,
+						},
+						"selected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node is synchronized with this server",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterTimeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterTimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterTimeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	cluster, err := interfaces.GetClusterIdentity(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterIdentity
+		return
+	}
+	nodes, err := interfaces.GetClusterNodeDates(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterNodeDates
+		return
+	}
+	ntpStatus, err := interfaces.GetClusterNtpStatus(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterNtpStatus
+		return
+	}
+
+	data.Timezone = types.StringValue(cluster.Timezone.Name)
+	synchronizedNodes := map[string]bool{}
+	data.NtpServers = make([]ClusterTimeNtpDataSourceModel, len(ntpStatus))
+	for index, record := range ntpStatus {
+		data.NtpServers[index] = ClusterTimeNtpDataSourceModel{
+			Node:      types.StringValue(record.Node),
+			Server:    types.StringValue(record.Server),
+			Reachable: types.BoolValue(record.IsPeerReachable),
+			Selected:  types.BoolValue(record.IsSelected),
+		}
+		if record.IsSelected {
+			synchronizedNodes[record.Node] = true
+		}
+	}
+	allSynchronized := len(nodes) > 0
+	data.Nodes = make([]ClusterTimeNodeDataSourceModel, len(nodes))
+	for index, record := range nodes {
+		data.Nodes[index] = ClusterTimeNodeDataSourceModel{
+			Name:            types.StringValue(record.Name),
+			Date:            types.StringValue(record.Date),
+			NtpSynchronized: types.BoolValue(synchronizedNodes[record.Name]),
+		}
+		allSynchronized = allSynchronized && synchronizedNodes[record.Name]
+	}
+	data.NtpSynchronized = types.BoolValue(allSynchronized)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ClusterWebResource{}
+var _ resource.ResourceWithImportState = &ClusterWebResource{}
+
+// NewClusterWebResource is a helper function to simplify the provider implementation.
+func NewClusterWebResource() resource.Resource {
+	return &ClusterWebResource{
+		config: resourceOrDataSourceConfig{
+			name: "cluster_web_resource",
+		},
+	}
+}
+
+// ClusterWebResource defines the resource implementation.
+type ClusterWebResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ClusterWebResourceModel describes the resource data model.
+type ClusterWebResourceModel struct {
+	CxProfileName         types.String `tfsdk:"cx_profile_name"`
+	SVMName               types.String `tfsdk:"svm_name"`
+	HTTPEnabled           types.Bool   `tfsdk:"http_enabled"`
+	HTTPPort              types.Int64  `tfsdk:"http_port"`
+	HTTPSPort             types.Int64  `tfsdk:"https_port"`
+	CSRFProtectionEnabled types.Bool   `tfsdk:"csrf_protection_enabled"`
+	CertificateName       types.String `tfsdk:"certificate_name"`
+	ClientEnabled         types.Bool   `tfsdk:"client_enabled"`
+	OcspEnabled           types.Bool   `tfsdk:"ocsp_enabled"`
+	ID                    types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ClusterWebResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ClusterWebResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	clusterOnly := []validator.Bool{
+		boolvalidator.ConflictsWith(path.Expressions{
+			path.MatchRoot("svm_name"),
+		}...),
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Web services resource, to manage the HTTP engine and certificate of the cluster or of an SVM. The settings always exist and are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name, to manage the web services of a data SVM rather than the cluster",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"http_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether HTTP is enabled, otherwise HTTP requests are redirected to HTTPS. Cluster only",
+				Optional:            true,
+				Validators:          clusterOnly,
+			},
+			"http_port": schema.Int64Attribute{
+				MarkdownDescription: "HTTP port of the cluster. Changing it restarts the web services. Cluster only",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("svm_name"),
+					}...),
+				},
+			},
+			"https_port": schema.Int64Attribute{
+				MarkdownDescription: "HTTPS port of the cluster. Changing it restarts the web services. Cluster only",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("svm_name"),
+					}...),
+				},
+			},
+			"csrf_protection_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether cross-site request forgery protection is enabled. Cluster only",
+				Optional:            true,
+				Validators:          clusterOnly,
+			},
+			"certificate_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the server certificate used by the web services, installed for the cluster or SVM",
+				Optional:            true,
+			},
+			"client_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether client certificate authentication is enabled",
+				Optional:            true,
+			},
+			"ocsp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether online certificate status protocol verification is enabled",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Web services identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClusterWebResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// getSvmUUID returns the UUID of svm_name, or an empty string for the cluster
+func (r *ClusterWebResource) getSvmUUID(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *ClusterWebResourceModel) (string, error) {
+	if data.SVMName.IsNull() {
+		return "", nil
+	}
+	svm, err := interfaces.GetSvmByName(errorHandler, client, data.SVMName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return svm.UUID, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClusterWebResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterWebResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svmUUID, err := r.getSvmUUID(errorHandler, *client, &data)
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetClusterWeb(errorHandler, *client, svmUUID)
+	if err != nil {
+		// error reporting done inside GetClusterWeb
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	if svmUUID == "" {
+		if imported || !data.HTTPEnabled.IsNull() {
+			data.HTTPEnabled = types.BoolValue(restInfo.HTTPEnabled)
+		}
+		if imported || !data.HTTPPort.IsNull() {
+			data.HTTPPort = types.Int64Value(restInfo.HTTPPort)
+		}
+		if imported || !data.HTTPSPort.IsNull() {
+			data.HTTPSPort = types.Int64Value(restInfo.HTTPSPort)
+		}
+		if (imported || !data.CSRFProtectionEnabled.IsNull()) && restInfo.CSRF != nil {
+			data.CSRFProtectionEnabled = types.BoolValue(restInfo.CSRF.ProtectionEnabled)
+		}
+	}
+	if imported || !data.CertificateName.IsNull() {
+		data.CertificateName = types.StringValue(restInfo.Certificate.Name)
+	}
+	if imported || !data.ClientEnabled.IsNull() {
+		data.ClientEnabled = types.BoolValue(restInfo.ClientEnabled)
+	}
+	if imported || !data.OcspEnabled.IsNull() {
+		data.OcspEnabled = types.BoolValue(restInfo.OcspEnabled)
+	}
+	data.ID = types.StringValue(r.id(&data))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// id returns the SVM name, or the connection profile name for the cluster
+func (r *ClusterWebResource) id(data *ClusterWebResourceModel) string {
+	if !data.SVMName.IsNull() {
+		return data.SVMName.ValueString()
+	}
+	return data.CxProfileName.ValueString()
+}
+
+// Create applies the web services settings, as they always exist on the cluster
+func (r *ClusterWebResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClusterWebResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(r.id(data))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ClusterWebResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ClusterWebResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings, the other settings are left unchanged.
+func (r *ClusterWebResource) apply(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *ClusterWebResourceModel) error {
+	svmUUID, err := r.getSvmUUID(errorHandler, client, data)
+	if err != nil {
+		return err
+	}
+	body := interfaces.ClusterWebResourceBodyDataModelONTAP{
+		HTTPEnabled:   data.HTTPEnabled.ValueBoolPointer(),
+		HTTPPort:      data.HTTPPort.ValueInt64(),
+		HTTPSPort:     data.HTTPSPort.ValueInt64(),
+		ClientEnabled: data.ClientEnabled.ValueBoolPointer(),
+		OcspEnabled:   data.OcspEnabled.ValueBoolPointer(),
+	}
+	if !data.CSRFProtectionEnabled.IsNull() {
+		body.CSRF = &interfaces.ClusterWebCSRFBodyONTAP{ProtectionEnabled: data.CSRFProtectionEnabled.ValueBoolPointer()}
+	}
+	if !data.CertificateName.IsNull() {
+		body.Certificate = &interfaces.WebCertificate{Name: data.CertificateName.ValueString()}
+	}
+	return interfaces.UpdateClusterWeb(errorHandler, client, svmUUID, body)
+}
+
+// Delete removes the resource from the Terraform state, the web services settings are left unchanged.
+func (r *ClusterWebResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClusterWebResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("web services %s removed from state, settings are left unchanged", data.ID.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ID is cx_profile_name for the cluster, or svm_name,cx_profile_name for an SVM.
+func (r *ClusterWebResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a web services resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	switch {
+	case len(idParts) == 1 && idParts[0] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[0])...)
+	case len(idParts) == 2 && idParts[0] != "" && idParts[1] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: cx_profile_name or svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+	}
+}
@@ -7,60 +7,98 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/zapiclient"
 	"golang.org/x/exp/maps"
 )
 
 // ConnectionProfile describes how to reach a cluster or svm
 type ConnectionProfile struct {
-	// TODO: add certs in addition to basic authentication
-	// TODO: Add Timeout (currently hardcoded to 10 seconds)
 	Hostname              string
 	Username              string
 	Password              string
 	ValidateCerts         bool
 	MaxConcurrentRequests int
+	// PEM encoded, used for mutual TLS authentication instead of Username and Password
+	ClientCertificate string
+	ClientPrivateKey  string
+	// OAuth 2.0 access token, sent as a bearer token instead of Username and Password
+	AccessToken string
+	// PEM encoded CA certificates to trust, and whether to only verify the certificate chain, not the hostname
+	CACertificates           string
+	SkipHostnameVerification bool
+	// Amazon FSx for NetApp ONTAP, Hostname is the management endpoint of the file system or SVM
+	FSx bool
+	// use ZAPI when the cluster does not serve the REST API, for the resources and data sources in zapiFallbackResources
+	ZAPIFallback bool
+	// in seconds, 0 for the defaults. OperationDeadline bounds a whole operation, including retries and job polling
+	ConnectTimeout    int
+	ReadTimeout       int
+	OperationDeadline int
 }
 
 // Config is created by the provide configure method
 type Config struct {
 	ConnectionProfiles   map[string]ConnectionProfile
+	DefaultProfileName   string
 	Version              string
 	JobCompletionTimeOut int
+	JobPollInterval      int
+	// send create requests with validate_only during plan, for resources supporting it
+	ValidateOnly bool
+	// GET cluster records, by connection profile name, shared by all clients for the lifetime of the provider
+	clusterCaches map[string]*restclient.ClusterCache
+	// request slots, by connection profile name, to limit concurrent requests to max_concurrent_requests for each profile
+	requestSlots map[string]restclient.RequestSlots
 }
 
 // GetConnectionProfile retrieves a connection profile based on name
-// If name is empty and only one profile is defined, it is returned
+// If name is empty, the default profile is returned, or the only profile if just one is defined
 func (c *Config) GetConnectionProfile(name string) (*ConnectionProfile, error) {
+	name, err := c.getConnectionProfileName(name)
+	if err != nil {
+		return nil, err
+	}
+	profile := c.ConnectionProfiles[name]
+	return &profile, nil
+}
+
+// getConnectionProfileName returns the name of a defined connection profile, resolving an empty name as GetConnectionProfile does
+func (c *Config) getConnectionProfileName(name string) (string, error) {
 	if c == nil {
-		return nil, fmt.Errorf("internal error, config is not initialized")
+		return "", fmt.Errorf("internal error, config is not initialized")
 	}
 	if len(c.ConnectionProfiles) == 0 {
-		return nil, fmt.Errorf("error, at least one connection profile is required to connect to ONTAP")
+		return "", fmt.Errorf("error, at least one connection profile is required to connect to ONTAP")
+	}
+	if name == "" {
+		name = c.DefaultProfileName
 	}
 	if name == "" && len(c.ConnectionProfiles) == 1 {
 		name = maps.Keys(c.ConnectionProfiles)[0]
 	}
 	if name == "" {
-		return nil, fmt.Errorf("error, connection profile name is required if more than one profile is defined")
+		return "", fmt.Errorf("error, cx_profile_name is required if more than one profile is defined and none is marked as default")
 	}
-	if profile, ok := c.ConnectionProfiles[name]; ok {
-		return &profile, nil
+	if _, ok := c.ConnectionProfiles[name]; !ok {
+		return "", fmt.Errorf("connection profile with name %s is not defined", name)
 	}
-	return nil, fmt.Errorf("connection profile with name %s is not defined", name)
+	return name, nil
 }
 
 // NewClient creates a RestClient based on the connection profile identified by cxProfileName
 func (c *Config) NewClient(errorHandler *utils.ErrorHandler, cxProfileName string, resName string) (*restclient.RestClient, error) {
-	connectionProfile, err := c.GetConnectionProfile(cxProfileName)
+	cxProfileName, err := c.getConnectionProfileName(cxProfileName)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("failed to set connection profile", err.Error())
 	}
+	connectionProfile := c.ConnectionProfiles[cxProfileName]
 	var profile restclient.ConnectionProfile
 	err = mapstructure.Decode(connectionProfile, &profile)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
-			fmt.Sprintf("decode error on ConnectionProfile %#v to restclient.ConnectionProfile", connectionProfile))
+			fmt.Sprintf("decode error on ConnectionProfile for %s to restclient.ConnectionProfile: %s", connectionProfile.Hostname, err))
 	}
 	// the tag resource_name/version will be used for telemetry
 
@@ -70,5 +108,35 @@ func (c *Config) NewClient(errorHandler *utils.ErrorHandler, cxProfileName strin
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
 			fmt.Sprintf("error creating REST client: %s", err))
 	}
+	client.SetClusterCache(c.clusterCaches[cxProfileName])
+	if slots, ok := c.requestSlots[cxProfileName]; ok {
+		client.SetRequestSlots(slots)
+	}
 	return client, err
 }
+
+// zapiFallbackResources lists the resources and data sources with a ZAPI implementation in the interfaces layer.
+// A resource or data source is added once it reads and writes all its attributes with ZAPI when UseZAPIFallback is true.
+var zapiFallbackResources = map[string]bool{
+	"cluster_data_source": true,
+}
+
+// NewZAPIClient creates a ZapiClient based on the connection profile identified by cxProfileName
+// It returns nil if ZAPI fallback is not enabled for the profile, or not supported by resName
+func (c *Config) NewZAPIClient(errorHandler *utils.ErrorHandler, cxProfileName string, resName string) (*zapiclient.ZapiClient, error) {
+	connectionProfile, err := c.GetConnectionProfile(cxProfileName)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to set connection profile", err.Error())
+	}
+	if !connectionProfile.ZAPIFallback || !zapiFallbackResources[resName] {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("ZAPI fallback not used for %s, enabled for the connection profile: %t", resName, connectionProfile.ZAPIFallback))
+		return nil, nil
+	}
+	var profile httpclient.HTTPProfile
+	err = mapstructure.Decode(connectionProfile, &profile)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("unable to create ZAPI client",
+			fmt.Sprintf("decode error on ConnectionProfile for %s to httpclient.HTTPProfile: %s", connectionProfile.Hostname, err))
+	}
+	return zapiclient.NewClient(errorHandler.Ctx, profile, strings.Join([]string{"TerraformONTAP", resName, c.Version}, "/")), nil
+}
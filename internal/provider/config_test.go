@@ -13,6 +13,7 @@ import (
 func TestConfig_GetConnectionProfile(t *testing.T) {
 	type fields struct {
 		ConnectionProfiles map[string]ConnectionProfile
+		DefaultProfileName string
 		Version            string
 	}
 	type args struct {
@@ -21,6 +22,8 @@ func TestConfig_GetConnectionProfile(t *testing.T) {
 	cxProfile := ConnectionProfile{}
 	cxProfiles := map[string]ConnectionProfile{"empty": cxProfile}
 	cxProfilesTwo := map[string]ConnectionProfile{"empty1": cxProfile, "empty2": cxProfile}
+	defaultProfile := ConnectionProfile{Hostname: "default"}
+	cxProfilesWithDefault := map[string]ConnectionProfile{"empty": cxProfile, "default": defaultProfile}
 	tests := []struct {
 		name    string
 		fields  fields
@@ -34,11 +37,14 @@ func TestConfig_GetConnectionProfile(t *testing.T) {
 		{name: "test_no_config", fields: fields{ConnectionProfiles: cxProfiles, Version: "v1.2.3"}, args: args{name: "other"}, want: nil, wantErr: true},
 		{name: "test_no_profiles", fields: fields{ConnectionProfiles: map[string]ConnectionProfile{}, Version: "v1.2.3"}, args: args{name: "other"}, want: nil, wantErr: true},
 		{name: "test_two_profiles_no_name", fields: fields{ConnectionProfiles: cxProfilesTwo, Version: "v1.2.3"}, args: args{name: ""}, want: nil, wantErr: true},
+		{name: "test_default_profile_no_name", fields: fields{ConnectionProfiles: cxProfilesWithDefault, DefaultProfileName: "default", Version: "v1.2.3"}, args: args{name: ""}, want: &defaultProfile, wantErr: false},
+		{name: "test_default_profile_with_name", fields: fields{ConnectionProfiles: cxProfilesWithDefault, DefaultProfileName: "default", Version: "v1.2.3"}, args: args{name: "empty"}, want: &cxProfile, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &Config{
 				ConnectionProfiles: tt.fields.ConnectionProfiles,
+				DefaultProfileName: tt.fields.DefaultProfileName,
 				Version:            tt.fields.Version,
 			}
 			if tt.name == "test_no_config" {
@@ -104,3 +110,39 @@ func TestConfig_NewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_NewZAPIClient(t *testing.T) {
+	cxProfiles := map[string]ConnectionProfile{
+		"zapi": {Hostname: "10.10.10.10", ZAPIFallback: true},
+		"rest": {Hostname: "10.10.10.10"},
+	}
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	tests := []struct {
+		name          string
+		cxProfileName string
+		resName       string
+		wantClient    bool
+		wantErr       bool
+	}{
+		{name: "test_supported", cxProfileName: "zapi", resName: "cluster_data_source", wantClient: true, wantErr: false},
+		{name: "test_not_supported", cxProfileName: "zapi", resName: "storage_volume_resource", wantClient: false, wantErr: false},
+		{name: "test_not_enabled", cxProfileName: "rest", resName: "cluster_data_source", wantClient: false, wantErr: false},
+		{name: "test_not_found", cxProfileName: "other", resName: "cluster_data_source", wantClient: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				ConnectionProfiles: cxProfiles,
+				Version:            "v1.2.3",
+			}
+			got, err := c.NewZAPIClient(errorHandler, tt.cxProfileName, tt.resName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Config.NewZAPIClient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got != nil) != tt.wantClient {
+				t.Errorf("Config.NewZAPIClient() = %v, wantClient %v", got, tt.wantClient)
+			}
+		})
+	}
+}
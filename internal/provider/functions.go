@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &SizeFunction{}
+var _ function.Function = &CIDRToNetmaskFunction{}
+var _ function.Function = &NetmaskToPrefixFunction{}
+
+// Provider functions are available with Terraform 1.8 or later, eg provider::netapp-ontap::ontap_size("500GiB")
+
+var sizeRegexp = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)\s*$`)
+
+// ParseSize converts a size with a unit, eg 500GiB, 500g or 1.5tb, to bytes. Units are powers of 1024, as in ONTAP
+func ParseSize(size string) (int64, error) {
+	matches := sizeRegexp.FindStringSubmatch(size)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid size %q, expecting a number followed by an optional unit, eg 500GiB", size)
+	}
+	unit := strings.ToLower(matches[2])
+	if unit == "" {
+		unit = "b"
+	}
+	// GiB, KiB, ... are the same as gb, kb, ...
+	unit = strings.Replace(unit, "ib", "b", 1)
+	multiplier, ok := interfaces.POW2BYTEMAP[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid unit %q in size %q", matches[2], size)
+	}
+	if multiplier <= 0 {
+		// zb and yb do not fit in 64 bits
+		return 0, fmt.Errorf("size %q is too large", size)
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %s", size, err)
+	}
+	bytes := value * float64(multiplier)
+	if bytes != math.Trunc(bytes) {
+		return 0, fmt.Errorf("size %q is not a whole number of bytes", size)
+	}
+	if bytes >= math.MaxInt64 {
+		return 0, fmt.Errorf("size %q is too large", size)
+	}
+	return int64(bytes), nil
+}
+
+// CIDRToNetmask converts an IPv4 prefix length, as 24, /24 or 10.0.0.0/24, to a netmask, as 255.255.255.0
+func CIDRToNetmask(cidr string) (string, error) {
+	prefix := cidr
+	if index := strings.Index(cidr, "/"); index >= 0 {
+		prefix = cidr[index+1:]
+	}
+	length, err := strconv.Atoi(prefix)
+	if err != nil || length < 0 || length > 32 {
+		return "", fmt.Errorf("invalid IPv4 prefix length in %q, expecting 0 to 32", cidr)
+	}
+	mask := net.CIDRMask(length, 32)
+	return net.IP(mask).String(), nil
+}
+
+// NetmaskToPrefix converts an IPv4 netmask, as 255.255.255.0, to a prefix length, as 24
+func NetmaskToPrefix(netmask string) (int64, error) {
+	ip := net.ParseIP(netmask).To4()
+	if ip == nil {
+		return 0, fmt.Errorf("invalid IPv4 netmask %q", netmask)
+	}
+	length, bits := net.IPMask(ip).Size()
+	if bits == 0 {
+		return 0, fmt.Errorf("invalid IPv4 netmask %q, the mask bits are not contiguous", netmask)
+	}
+	return int64(length), nil
+}
+
+// SizeFunction defines the ontap_size function.
+type SizeFunction struct{}
+
+// NewSizeFunction is a helper function to simplify the provider implementation.
+func NewSizeFunction() function.Function {
+	return &SizeFunction{}
+}
+
+// Metadata returns the function name.
+func (f *SizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ontap_size"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert a size with a unit to bytes",
+		MarkdownDescription: "Converts a size with a unit, eg 500GiB, 500g or 1.5tb, to bytes. Units are powers of 1024, as in ONTAP",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "size",
+				MarkdownDescription: "Number followed by an optional unit, b, kb, mb, gb, tb, pb, or eb, the i in KiB, MiB, ... is optional",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run converts the size.
+func (f *SizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+	bytes, err := ParseSize(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, bytes))
+}
+
+// CIDRToNetmaskFunction defines the cidr_to_netmask function.
+type CIDRToNetmaskFunction struct{}
+
+// NewCIDRToNetmaskFunction is a helper function to simplify the provider implementation.
+func NewCIDRToNetmaskFunction() function.Function {
+	return &CIDRToNetmaskFunction{}
+}
+
+// Metadata returns the function name.
+func (f *CIDRToNetmaskFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_to_netmask"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *CIDRToNetmaskFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an IPv4 prefix length to a netmask",
+		MarkdownDescription: "Converts an IPv4 prefix length, as 24, /24 or 10.0.0.0/24, to a netmask, as 255.255.255.0",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "Prefix length, optionally preceded by / or by a network address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run converts the prefix length.
+func (f *CIDRToNetmaskFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+	netmask, err := CIDRToNetmask(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, netmask))
+}
+
+// NetmaskToPrefixFunction defines the netmask_to_prefix function.
+type NetmaskToPrefixFunction struct{}
+
+// NewNetmaskToPrefixFunction is a helper function to simplify the provider implementation.
+func NewNetmaskToPrefixFunction() function.Function {
+	return &NetmaskToPrefixFunction{}
+}
+
+// Metadata returns the function name.
+func (f *NetmaskToPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "netmask_to_prefix"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NetmaskToPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an IPv4 netmask to a prefix length",
+		MarkdownDescription: "Converts an IPv4 netmask, as 255.255.255.0, to a prefix length, as 24",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "netmask",
+				MarkdownDescription: "IPv4 netmask, the mask bits must be contiguous",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run converts the netmask.
+func (f *NetmaskToPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var netmask string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &netmask))
+	if resp.Error != nil {
+		return
+	}
+	length, err := NetmaskToPrefix(netmask)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, length))
+}
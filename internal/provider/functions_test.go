@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    int64
+		wantErr bool
+	}{
+		{name: "test_gib", size: "500GiB", want: 500 * 1024 * 1024 * 1024, wantErr: false},
+		{name: "test_gb_lower_case", size: "500gb", want: 500 * 1024 * 1024 * 1024, wantErr: false},
+		{name: "test_short_unit", size: "20m", want: 20 * 1024 * 1024, wantErr: false},
+		{name: "test_fraction", size: "1.5 TiB", want: 3 * 512 * 1024 * 1024 * 1024, wantErr: false},
+		{name: "test_no_unit", size: "4096", want: 4096, wantErr: false},
+		{name: "test_bytes", size: "4096bytes", want: 4096, wantErr: false},
+		{name: "test_unknown_unit", size: "10xb", want: 0, wantErr: true},
+		{name: "test_not_a_number", size: "GiB", want: 0, wantErr: true},
+		{name: "test_partial_byte", size: "1.5", want: 0, wantErr: true},
+		{name: "test_too_large", size: "9000000eb", want: 0, wantErr: true},
+		{name: "test_unit_too_large", size: "1yb", want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSize(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRToNetmask(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{name: "test_prefix", cidr: "24", want: "255.255.255.0", wantErr: false},
+		{name: "test_slash_prefix", cidr: "/16", want: "255.255.0.0", wantErr: false},
+		{name: "test_cidr", cidr: "10.0.0.0/20", want: "255.255.240.0", wantErr: false},
+		{name: "test_zero", cidr: "0", want: "0.0.0.0", wantErr: false},
+		{name: "test_too_long", cidr: "33", want: "", wantErr: true},
+		{name: "test_not_a_number", cidr: "10.0.0.0", want: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRToNetmask(tt.cidr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CIDRToNetmask() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CIDRToNetmask() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetmaskToPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		netmask string
+		want    int64
+		wantErr bool
+	}{
+		{name: "test_netmask", netmask: "255.255.255.0", want: 24, wantErr: false},
+		{name: "test_short_netmask", netmask: "255.255.240.0", want: 20, wantErr: false},
+		{name: "test_host", netmask: "255.255.255.255", want: 32, wantErr: false},
+		{name: "test_not_contiguous", netmask: "255.0.255.0", want: 0, wantErr: true},
+		{name: "test_ipv6", netmask: "ffff::", want: 0, wantErr: true},
+		{name: "test_invalid", netmask: "24", want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NetmaskToPrefix(tt.netmask)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NetmaskToPrefix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NetmaskToPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderFunctionsRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		function function.Function
+		argument string
+		want     attr.Value
+		wantErr  bool
+	}{
+		{name: "test_ontap_size", function: NewSizeFunction(), argument: "500GiB", want: types.Int64Value(500 * 1024 * 1024 * 1024), wantErr: false},
+		{name: "test_ontap_size_error", function: NewSizeFunction(), argument: "10xb", want: types.Int64Unknown(), wantErr: true},
+		{name: "test_cidr_to_netmask", function: NewCIDRToNetmaskFunction(), argument: "10.0.0.0/20", want: types.StringValue("255.255.240.0"), wantErr: false},
+		{name: "test_cidr_to_netmask_error", function: NewCIDRToNetmaskFunction(), argument: "33", want: types.StringUnknown(), wantErr: true},
+		{name: "test_netmask_to_prefix", function: NewNetmaskToPrefixFunction(), argument: "255.255.255.0", want: types.Int64Value(24), wantErr: false},
+		{name: "test_netmask_to_prefix_error", function: NewNetmaskToPrefixFunction(), argument: "255.0.255.0", want: types.Int64Unknown(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition := function.DefinitionResponse{}
+			tt.function.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.argument)}),
+			}
+			result, _ := definition.Definition.Return.NewResultData(context.Background())
+			resp := function.RunResponse{
+				Result: result,
+			}
+			tt.function.Run(context.Background(), req, &resp)
+			if (resp.Error != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+				return
+			}
+			if !resp.Result.Value().Equal(tt.want) {
+				t.Errorf("Run() = %v, want %v", resp.Result.Value(), tt.want)
+			}
+		})
+	}
+}
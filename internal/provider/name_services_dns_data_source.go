@@ -52,7 +52,7 @@ func (d *NameServicesDNSDataSource) Schema(ctx context.Context, req datasource.S
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface svm name",
@@ -62,7 +62,7 @@ func (r *NameServicesDNSResource) Schema(ctx context.Context, req resource.Schem
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface svm name",
@@ -256,7 +256,7 @@ func (r *NameServicesDNSResource) ImportState(ctx context.Context, req resource.
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
 		)
 		return
 	}
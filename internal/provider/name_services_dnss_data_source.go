@@ -56,7 +56,7 @@ func (d *NameServicesDNSsDataSource) Schema(ctx context.Context, req datasource.
 
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				MarkdownDescription: "Connection profile name",
 			},
 			"filter": schema.SingleNestedAttribute{
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BroadcastDomainDataSource{}
+
+// NewBroadcastDomainDataSource is a helper function to simplify the provider implementation.
+func NewBroadcastDomainDataSource() datasource.DataSource {
+	return &BroadcastDomainDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "networking_broadcast_domain_data_source",
+		},
+	}
+}
+
+// BroadcastDomainDataSource defines the data source implementation.
+type BroadcastDomainDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// BroadcastDomainDataSourceModel describes the data source data model.
+type BroadcastDomainDataSourceModel struct {
+	CxProfileName types.String                         `tfsdk:"cx_profile_name"`
+	Name          types.String                         `tfsdk:"name"`
+	Ipspace       types.String                         `tfsdk:"ipspace"`
+	ID            types.String                         `tfsdk:"id"`
+	MTU           types.Int64                          `tfsdk:"mtu"`
+	Ports         []BroadcastDomainPortDataSourceModel `tfsdk:"ports"`
+}
+
+// BroadcastDomainPortDataSourceModel describes a member port of a broadcast domain.
+type BroadcastDomainPortDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	NodeName types.String `tfsdk:"node_name"`
+}
+
+// BroadcastDomainDataSourceFilterModel describes the data source data model for queries.
+type BroadcastDomainDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	Ipspace types.String `tfsdk:"ipspace"`
+}
+
+// Metadata returns the data source type name.
+func (d *BroadcastDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *BroadcastDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Broadcast domain data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain name",
+				Required:            true,
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace of the broadcast domain, defaults to Default",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain UUID",
+				Computed:            true,
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "Maximum transmission unit, in bytes",
+				Computed:            true,
+			},
+			"ports": schema.ListNestedAttribute{
+				MarkdownDescription: "Member ports of the broadcast domain",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Port name",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Node owning the port",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BroadcastDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// flattenBroadcastDomain sets the computed attributes from a broadcast domain record
+func flattenBroadcastDomain(data *BroadcastDomainDataSourceModel, record interfaces.BroadcastDomainGetDataModelONTAP) {
+	data.Name = types.StringValue(record.Name)
+	data.Ipspace = types.StringValue(record.Ipspace.Name)
+	data.ID = types.StringValue(record.UUID)
+	data.MTU = types.Int64Value(record.MTU)
+	data.Ports = make([]BroadcastDomainPortDataSourceModel, len(record.Ports))
+	for index, port := range record.Ports {
+		data.Ports[index] = BroadcastDomainPortDataSourceModel{
+			Name:     types.StringValue(port.Name),
+			NodeName: types.StringValue(port.Node.Name),
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BroadcastDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BroadcastDomainDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	ipspace := "Default"
+	if !data.Ipspace.IsNull() && !data.Ipspace.IsUnknown() {
+		ipspace = data.Ipspace.ValueString()
+	}
+	restInfo, err := interfaces.GetBroadcastDomainByName(errorHandler, *client, data.Name.ValueString(), ipspace)
+	if err != nil {
+		// error reporting done inside GetBroadcastDomainByName
+		return
+	}
+	flattenBroadcastDomain(&data, *restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
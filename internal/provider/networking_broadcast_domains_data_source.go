@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BroadcastDomainsDataSource{}
+
+// NewBroadcastDomainsDataSource is a helper function to simplify the provider implementation.
+func NewBroadcastDomainsDataSource() datasource.DataSource {
+	return &BroadcastDomainsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "networking_broadcast_domains_data_source",
+		},
+	}
+}
+
+// BroadcastDomainsDataSource defines the data source implementation.
+type BroadcastDomainsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// BroadcastDomainsDataSourceModel describes the data source data model.
+type BroadcastDomainsDataSourceModel struct {
+	CxProfileName    types.String                          `tfsdk:"cx_profile_name"`
+	BroadcastDomains []BroadcastDomainDataSourceModel      `tfsdk:"networking_broadcast_domains"`
+	Filter           *BroadcastDomainDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// Metadata returns the data source type name.
+func (d *BroadcastDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *BroadcastDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Broadcast domains data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Broadcast domain name, wildcards are supported",
+						Optional:            true,
+					},
+					"ipspace": schema.StringAttribute{
+						MarkdownDescription: "IPspace of the broadcast domain",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"networking_broadcast_domains": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain name",
+							Required:            true,
+						},
+						"ipspace": schema.StringAttribute{
+							MarkdownDescription: "IPspace of the broadcast domain",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain UUID",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "Maximum transmission unit, in bytes",
+							Computed:            true,
+						},
+						"ports": schema.ListNestedAttribute{
+							MarkdownDescription: "Member ports of the broadcast domain",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Port name",
+										Computed:            true,
+									},
+									"node_name": schema.StringAttribute{
+										MarkdownDescription: "Node owning the port",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BroadcastDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BroadcastDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BroadcastDomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.BroadcastDomainDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.BroadcastDomainDataSourceFilterModel{
+			Name:        data.Filter.Name.ValueString(),
+			IpspaceName: data.Filter.Ipspace.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListBroadcastDomains(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListBroadcastDomains
+		return
+	}
+
+	data.BroadcastDomains = make([]BroadcastDomainDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.BroadcastDomains[index] = BroadcastDomainDataSourceModel{
+			CxProfileName: data.CxProfileName,
+		}
+		flattenBroadcastDomain(&data.BroadcastDomains[index], record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
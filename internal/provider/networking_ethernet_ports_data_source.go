@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &EthernetPortsDataSource{}
+
+// NewEthernetPortsDataSource is a helper function to simplify the provider implementation.
+func NewEthernetPortsDataSource() datasource.DataSource {
+	return &EthernetPortsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "networking_ethernet_ports_data_source",
+		},
+	}
+}
+
+// EthernetPortsDataSource defines the data source implementation.
+type EthernetPortsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// EthernetPortsDataSourceModel describes the data source data model.
+type EthernetPortsDataSourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	EthernetPorts []EthernetPortDataSourceModel      `tfsdk:"networking_ethernet_ports"`
+	Filter        *EthernetPortDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// EthernetPortDataSourceModel describes the data model for an ethernet port.
+type EthernetPortDataSourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	ID              types.String `tfsdk:"id"`
+	NodeName        types.String `tfsdk:"node_name"`
+	Type            types.String `tfsdk:"type"`
+	State           types.String `tfsdk:"state"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	MTU             types.Int64  `tfsdk:"mtu"`
+	Speed           types.Int64  `tfsdk:"speed"`
+	MacAddress      types.String `tfsdk:"mac_address"`
+	BroadcastDomain types.String `tfsdk:"broadcast_domain"`
+	Ipspace         types.String `tfsdk:"ipspace"`
+	VlanTag         types.Int64  `tfsdk:"vlan_tag"`
+	VlanBasePort    types.String `tfsdk:"vlan_base_port"`
+}
+
+// EthernetPortDataSourceFilterModel describes the data source data model for queries.
+type EthernetPortDataSourceFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	NodeName types.String `tfsdk:"node_name"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *EthernetPortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *EthernetPortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Ethernet ports data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Port name, wildcards are supported, eg e0*",
+						Optional:            true,
+					},
+					"node_name": schema.StringAttribute{
+						MarkdownDescription: "Node owning the port",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Port type, one of physical, vlan, lag",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("physical", "vlan", "lag"),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"networking_ethernet_ports": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Port name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Port UUID",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Node owning the port",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Port type, physical, vlan or lag",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Operational state of the port, up or down",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the port is administratively enabled",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "Maximum transmission unit, in bytes",
+							Computed:            true,
+						},
+						"speed": schema.Int64Attribute{
+							MarkdownDescription: "Operational speed, in Mbps",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the port",
+							Computed:            true,
+						},
+						"broadcast_domain": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain the port belongs to, empty when there is none",
+							Computed:            true,
+						},
+						"ipspace": schema.StringAttribute{
+							MarkdownDescription: "IPspace of the broadcast domain",
+							Computed:            true,
+						},
+						"vlan_tag": schema.Int64Attribute{
+							MarkdownDescription: "VLAN tag, for vlan ports only",
+							Computed:            true,
+						},
+						"vlan_base_port": schema.StringAttribute{
+							MarkdownDescription: "Port the VLAN is built on, for vlan ports only",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EthernetPortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *EthernetPortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EthernetPortsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.EthernetPortDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.EthernetPortDataSourceFilterModel{
+			Name:     data.Filter.Name.ValueString(),
+			NodeName: data.Filter.NodeName.ValueString(),
+			Type:     data.Filter.Type.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListEthernetPorts(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListEthernetPorts
+		return
+	}
+
+	data.EthernetPorts = make([]EthernetPortDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.EthernetPorts[index] = EthernetPortDataSourceModel{
+			Name:            types.StringValue(record.Name),
+			ID:              types.StringValue(record.UUID),
+			NodeName:        types.StringValue(record.Node.Name),
+			Type:            types.StringValue(record.Type),
+			State:           types.StringValue(record.State),
+			Enabled:         types.BoolValue(record.Enabled),
+			MTU:             types.Int64Value(record.MTU),
+			Speed:           types.Int64Value(record.Speed),
+			MacAddress:      types.StringValue(record.MacAddress),
+			BroadcastDomain: types.StringValue(record.BroadcastDomain.Name),
+			Ipspace:         types.StringValue(record.BroadcastDomain.Ipspace.Name),
+			VlanTag:         types.Int64Null(),
+			VlanBasePort:    types.StringNull(),
+		}
+		if record.Type == "vlan" {
+			data.EthernetPorts[index].VlanTag = types.Int64Value(record.Vlan.Tag)
+			data.EthernetPorts[index].VlanBasePort = types.StringValue(record.Vlan.BasePort.Name)
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
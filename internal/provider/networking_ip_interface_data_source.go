@@ -65,7 +65,7 @@ func (d *IPInterfaceDataSource) Schema(ctx context.Context, req datasource.Schem
 
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				MarkdownDescription: "Connection profile name",
 			},
 			"name": schema.StringAttribute{
@@ -3,17 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -50,8 +55,10 @@ type IPInterfaceResourceIP struct {
 
 // IPInterfaceResourceLocation describes the resource data model for home node/port.
 type IPInterfaceResourceLocation struct {
-	HomeNode types.String `tfsdk:"home_node"`
-	HomePort types.String `tfsdk:"home_port"`
+	HomeNode   types.String `tfsdk:"home_node"`
+	HomePort   types.String `tfsdk:"home_port"`
+	Failover   types.String `tfsdk:"failover"`
+	AutoRevert types.Bool   `tfsdk:"auto_revert"`
 }
 
 // IPInterfaceResourceModel describes the resource data model.
@@ -61,6 +68,9 @@ type IPInterfaceResourceModel struct {
 	SVMName       types.String                 `tfsdk:"svm_name"`
 	IP            *IPInterfaceResourceIP       `tfsdk:"ip"`
 	Location      *IPInterfaceResourceLocation `tfsdk:"location"`
+	IPSpace       types.String                 `tfsdk:"ipspace"`
+	ServicePolicy types.String                 `tfsdk:"service_policy"`
+	CurrentPort   types.String                 `tfsdk:"current_port"`
 	UUID          types.String                 `tfsdk:"id"`
 }
 
@@ -78,16 +88,29 @@ func (r *IPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface name",
 				Required:            true,
 			},
-			// TODO: Make svm_name optional for cluster scoped interface
 			"svm_name": schema.StringAttribute{
-				MarkdownDescription: "IPInterface svm name",
-				Required:            true,
+				MarkdownDescription: "IPInterface svm name. Omit it for a cluster scoped interface, such as a node management interface",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPInterface ipspace, for a cluster scoped interface, eg Default",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_policy": schema.StringAttribute{
+				MarkdownDescription: "IPInterface service policy, eg default-management for a node management interface",
+				Optional:            true,
 			},
 			// TODO: Make IP optional once subnet is supported
 			"ip": schema.SingleNestedAttribute{
@@ -114,9 +137,37 @@ func (r *IPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "IPInterface home port",
 						Required:            true,
 					},
+					"failover": schema.StringAttribute{
+						MarkdownDescription: "Failover scope, where the interface may fail over: home_port_only, default, home_node_only, sfo_partners_only, or broadcast_domain_only",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("home_port_only", "default", "home_node_only", "sfo_partners_only", "broadcast_domain_only"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"auto_revert": schema.BoolAttribute{
+						MarkdownDescription: "Whether the interface automatically reverts to its home port when the port is available",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
 				},
 				Required: true,
 			},
+			"current_port": schema.StringAttribute{
+				MarkdownDescription: "Port the interface is currently on, as node:port. It differs from the home port after a failover or a migration. " +
+					"When set, the interface is migrated to this port, set it to the home node and port to revert the interface",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+:[^:]+$`), "must be node:port"),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "IPInterface UUID",
 				Computed:            true,
@@ -144,6 +195,67 @@ func (r *IPInterfaceResource) Configure(ctx context.Context, req resource.Config
 	r.config.providerConfig = config
 }
 
+// setLocation sets the home and current location, and the failover options, from the ONTAP record
+func (data *IPInterfaceResourceModel) setLocation(restInfo *interfaces.IPInterfaceGetDataModelONTAP) {
+	var location IPInterfaceResourceLocation
+	location.HomeNode = types.StringValue(restInfo.Location.HomeNode.Name)
+	location.HomePort = types.StringValue(restInfo.Location.HomePort.Name)
+	location.Failover = types.StringValue(restInfo.Location.Failover)
+	location.AutoRevert = types.BoolPointerValue(restInfo.Location.AutoRevert)
+	data.Location = &location
+	if restInfo.Location.Port != nil {
+		data.CurrentPort = types.StringValue(ipInterfaceCurrentPort(restInfo))
+	} else {
+		data.CurrentPort = types.StringNull()
+	}
+}
+
+// ipInterfaceCurrentPort returns the port the interface is on, as node:port
+func ipInterfaceCurrentPort(restInfo *interfaces.IPInterfaceGetDataModelONTAP) string {
+	if restInfo.Location.Port == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", restInfo.Location.Port.Node.Name, restInfo.Location.Port.Name)
+}
+
+// migrate moves the interface to currentPort, as node:port, unless it is already on this port or currentPort is not set
+func (data *IPInterfaceResourceModel) migrate(errorHandler *utils.ErrorHandler, client restclient.RestClient, currentPort types.String) error {
+	if currentPort.IsUnknown() || currentPort.IsNull() {
+		return nil
+	}
+	restInfo, err := interfaces.GetIPInterface(errorHandler, client, data.UUID.ValueString())
+	if err != nil {
+		// error reporting done inside GetIPInterface
+		return err
+	}
+	if ipInterfaceCurrentPort(restInfo) == currentPort.ValueString() {
+		return nil
+	}
+	node, port, _ := strings.Cut(currentPort.ValueString(), ":")
+	return interfaces.MigrateIPInterface(errorHandler, client, data.UUID.ValueString(), node, port)
+}
+
+// locationBody sets the failover options in the request body, when they are set in the plan
+func (data *IPInterfaceResourceModel) locationBody(body *interfaces.IPInterfaceResourceBodyDataModelONTAP) {
+	if !data.Location.Failover.IsUnknown() && !data.Location.Failover.IsNull() {
+		body.Location.Failover = data.Location.Failover.ValueString()
+	}
+	if !data.Location.AutoRevert.IsUnknown() {
+		body.Location.AutoRevert = data.Location.AutoRevert.ValueBoolPointer()
+	}
+}
+
+// readComputed reads the interface after create or update, to set the computed attributes
+func (data *IPInterfaceResourceModel) readComputed(errorHandler *utils.ErrorHandler, client restclient.RestClient) error {
+	restInfo, err := interfaces.GetIPInterface(errorHandler, client, data.UUID.ValueString())
+	if err != nil {
+		// error reporting done inside GetIPInterface
+		return err
+	}
+	data.setLocation(restInfo)
+	return nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data IPInterfaceResourceModel
@@ -183,11 +295,17 @@ func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	data.Name = types.StringValue(restInfo.Name)
 	data.UUID = types.StringValue(restInfo.UUID)
+	if restInfo.SVM.Name != "" {
+		data.SVMName = types.StringValue(restInfo.SVM.Name)
+	}
+	if !data.IPSpace.IsNull() || restInfo.Scope == "cluster" {
+		data.IPSpace = types.StringValue(restInfo.IPSpace.Name)
+	}
+	if !data.ServicePolicy.IsNull() || restInfo.Scope == "cluster" {
+		data.ServicePolicy = types.StringValue(restInfo.ServicePolicy.Name)
+	}
 
-	var location IPInterfaceResourceLocation
-	location.HomeNode = types.StringValue(restInfo.Location.HomeNode.Name)
-	location.HomePort = types.StringValue(restInfo.Location.HomePort.Name)
-	data.Location = &location
+	data.setLocation(restInfo)
 
 	var ip IPInterfaceResourceIP
 	ip.Address = types.StringValue(restInfo.IP.Address)
@@ -202,6 +320,7 @@ func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "network/ip/interfaces/"+data.UUID.ValueString())...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -234,6 +353,17 @@ func (r *IPInterfaceResource) Create(ctx context.Context, req resource.CreateReq
 	body.Location.HomeNode = interfaces.IPInterfaceResourceHomeNode{
 		Name: data.Location.HomeNode.ValueString(),
 	}
+	if !data.IPSpace.IsNull() {
+		if !data.SVMName.IsNull() {
+			errorHandler.MakeAndReportError("invalid ip_interface", "ipspace is only supported for cluster scoped interfaces, when svm_name is not set")
+			return
+		}
+		body.IPSpace = &interfaces.IPInterfaceResourceName{Name: data.IPSpace.ValueString()}
+	}
+	if !data.ServicePolicy.IsNull() {
+		body.ServicePolicy = &interfaces.IPInterfaceResourceName{Name: data.ServicePolicy.ValueString()}
+	}
+	data.locationBody(&body)
 
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -247,6 +377,12 @@ func (r *IPInterfaceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	data.UUID = types.StringValue(resource.UUID)
+	if err = data.migrate(errorHandler, *client, data.CurrentPort); err != nil {
+		return
+	}
+	if err = data.readComputed(errorHandler, *client); err != nil {
+		return
+	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
 
@@ -280,19 +416,32 @@ func (r *IPInterfaceResource) Update(ctx context.Context, req resource.UpdateReq
 	body.Location.HomeNode = interfaces.IPInterfaceResourceHomeNode{
 		Name: data.Location.HomeNode.ValueString(),
 	}
+	if !data.ServicePolicy.IsNull() {
+		body.ServicePolicy = &interfaces.IPInterfaceResourceName{Name: data.ServicePolicy.ValueString()}
+	}
+	data.locationBody(&body)
 
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	err = interfaces.UpdateIPInterface(errorHandler, *client, body, data.UUID.ValueString())
 
 	if err != nil {
 		return
 	}
+	// the home port is updated first, so that the interface can be migrated or reverted to the new home port
+	if err = data.migrate(errorHandler, *client, data.CurrentPort); err != nil {
+		return
+	}
+	if err = data.readComputed(errorHandler, *client); err != nil {
+		return
+	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "network/ip/interfaces/"+data.UUID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -331,10 +480,16 @@ func (r *IPInterfaceResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *IPInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, fmt.Sprintf("import req a network ip interface resource: %#v", req))
 	idParts := strings.Split(req.ID, ",")
+	if len(idParts) == 2 && idParts[0] != "" && idParts[1] != "" {
+		// cluster scoped interface
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+		return
+	}
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name or name,cx_profile_name for a cluster scoped interface. Got: %q", req.ID),
 		)
 		return
 	}
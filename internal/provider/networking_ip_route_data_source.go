@@ -58,7 +58,7 @@ func (d *IPRouteDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface svm name",
@@ -73,7 +73,8 @@ func (d *IPRouteDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 						Required:            true,
 					},
 					"netmask": schema.StringAttribute{
-						MarkdownDescription: "netmask length (16) or IPv4 mask (255.255.0.0). For IPv6, valid range is 1 to 127.",
+						MarkdownDescription: "netmask length (16) or IPv4 mask (255.255.0.0). For IPv6, valid range is 1 to 127. Required when several routes to the address use the same gateway",
+						Optional:            true,
 						Computed:            true,
 					},
 				},
@@ -135,7 +136,7 @@ func (d *IPRouteDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	restInfo, err := interfaces.GetIPRoute(errorHandler, *client, data.Destination.Address.ValueString(), data.SVMName.ValueString(), data.Gateway.ValueString(), cluster.Version)
+	restInfo, err := interfaces.GetIPRoute(errorHandler, *client, data.Destination.Address.ValueString(), data.Destination.Netmask.ValueString(), data.SVMName.ValueString(), data.Gateway.ValueString(), cluster.Version)
 	if err != nil {
 		// error reporting done inside GetNetRoute
 		return
@@ -3,11 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,12 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/modifiers"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &IPRouteResource{}
 var _ resource.ResourceWithImportState = &IPRouteResource{}
+var _ resource.ResourceWithModifyPlan = &IPRouteResource{}
 
 // NewIPRouteResource is a helper function to simplify the provider implementation.
 func NewIPRouteResource() resource.Resource {
@@ -63,7 +66,7 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"destination": schema.SingleNestedAttribute{
 				Optional:            true,
@@ -78,7 +81,6 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 						"address": types.StringValue("0.0.0.0"),
 						"netmask": types.StringValue("0"),
 					})),
-				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"address": schema.StringAttribute{
 						MarkdownDescription: "IPv4 or IPv6 address",
@@ -92,7 +94,8 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("0"),
-						PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+						// ONTAP reports the length, keep the state when the configuration uses the equivalent mask
+						PlanModifiers: []planmodifier.String{modifiers.NetmaskEquivalent(), stringplanmodifier.RequiresReplace()},
 					},
 				},
 			},
@@ -101,7 +104,7 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "The IP address of the gateway router leading to the destination.",
+				MarkdownDescription: "The IP address of the gateway router leading to the destination. Changing the gateway or svm_name creates the new route before deleting the old one.",
 				Required:            true,
 			},
 			"metric": schema.Int64Attribute{
@@ -122,6 +125,40 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// ModifyPlan reports the attributes that are not supported by the ONTAP version of the cluster, before any change is applied.
+// It also marks the id as unknown when Update replaces the route.
+func (r *IPRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to check on destroy
+		return
+	}
+	var config *IPRouteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+	attributes := map[string]string{}
+	if !config.Metric.IsNull() {
+		attributes["metric"] = "metric"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkVersionedAttributes(errorHandler, r.config, config.CxProfileName, "network/ip/routes", attributes)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state *IPRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Update replaces the route when the gateway or SVM changes, so the UUID changes too
+	if !plan.Gateway.Equal(state.Gateway) || !plan.SVMName.Equal(state.SVMName) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *IPRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -166,13 +203,24 @@ func (r *IPRouteResource) Read(ctx context.Context, req resource.ReadRequest, re
 		errorHandler.MakeAndReportError("No cluster found", fmt.Sprintf("No Cluster found"))
 		return
 	}
-	restInfo, err := interfaces.GetIPRoute(errorHandler, *client, data.Destination.Address.ValueString(), data.SVMName.ValueString(), data.Gateway.ValueString(), cluster.Version)
+	var restInfo *interfaces.IPRouteGetDataModelONTAP
+	if data.ID.IsNull() {
+		// on import, the route is identified by its destination, gateway, and SVM
+		restInfo, err = interfaces.GetIPRoute(errorHandler, *client, data.Destination.Address.ValueString(), data.Destination.Netmask.ValueString(), data.SVMName.ValueString(), data.Gateway.ValueString(), cluster.Version)
+	} else {
+		restInfo, err = interfaces.GetIPRouteByUUID(errorHandler, *client, data.ID.ValueString(), cluster.Version)
+	}
 	if err != nil {
-		// error reporting done inside GetIPInterface
+		// error reporting done inside GetIPRoute
+		return
+	}
+	if restInfo == nil && !data.ID.IsNull() {
+		tflog.Debug(ctx, fmt.Sprintf("route %s not found, removing it from state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No IP Route found", fmt.Sprintf("No IP Route %s found", data.Destination.Address.ValueString()))
+		errorHandler.MakeAndReportError("No IP Route found", fmt.Sprintf("No IP Route %s/%s through %s found", data.Destination.Address.ValueString(), data.Destination.Netmask.ValueString(), data.Gateway.ValueString()))
 		return
 	}
 
@@ -198,38 +246,19 @@ func (r *IPRouteResource) Create(ctx context.Context, req resource.CreateRequest
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	var body interfaces.IPRouteResourceBodyDataModelONTAP
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if data.Destination != nil {
-		if !data.Destination.Address.IsNull() {
-			body.Destination.Address = data.Destination.Address.ValueString()
-		}
-		if !data.Destination.Netmask.IsNull() {
-			body.Destination.Netmask = data.Destination.Netmask.ValueString()
-		}
-	}
-	if !data.SVMName.IsNull() {
-		body.SVM.Name = data.SVMName.ValueString()
-	}
-	if !data.Gateway.IsNull() {
-		body.Gateway = data.Gateway.ValueString()
-	}
-	if !data.Metric.IsNull() {
-		body.Metric = data.Metric.ValueInt64()
-	}
-
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
 	}
 
-	resource, err := interfaces.CreateIPRoute(errorHandler, *client, body)
+	resource, err := interfaces.CreateIPRoute(errorHandler, *client, ipRouteBody(data))
 	if err != nil {
 		return
 	}
@@ -253,10 +282,65 @@ func (r *IPRouteResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	tflog.Error(ctx, fmt.Sprintf("Update not supported by REST API for IP Routes"))
+	var state *IPRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// routes can not be modified with REST, destination and metric force a replacement, but the route is moved to a new gateway or SVM in place
+	if data.Gateway.Equal(state.Gateway) && data.SVMName.Equal(state.SVMName) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 
-	// Save updated data into Terraform state
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// create the new route before deleting the old one, so that the destination stays reachable
+	resource, err := interfaces.CreateIPRoute(errorHandler, *client, ipRouteBody(data))
+	if err != nil {
+		return
+	}
+	data.ID = types.StringValue(resource.UUID)
+	// save the new route now, so that it is still tracked if deleting the old one fails
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = interfaces.DeleteIPRoute(errorHandler, *client, state.ID.ValueString())
+	if err != nil {
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("replaced route %s with %s", state.ID.ValueString(), data.ID.ValueString()))
+}
+
+// ipRouteBody builds the POST body from the plan
+func ipRouteBody(data *IPRouteResourceModel) interfaces.IPRouteResourceBodyDataModelONTAP {
+	var body interfaces.IPRouteResourceBodyDataModelONTAP
+	if data.Destination != nil {
+		if !data.Destination.Address.IsNull() {
+			body.Destination.Address = data.Destination.Address.ValueString()
+		}
+		if !data.Destination.Netmask.IsNull() {
+			body.Destination.Netmask = data.Destination.Netmask.ValueString()
+		}
+	}
+	if !data.SVMName.IsNull() {
+		body.SVM.Name = data.SVMName.ValueString()
+	}
+	if !data.Gateway.IsNull() {
+		body.Gateway = data.Gateway.ValueString()
+	}
+	if !data.Metric.IsNull() {
+		body.Metric = data.Metric.ValueInt64()
+	}
+	return body
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -291,5 +375,30 @@ func (r *IPRouteResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *IPRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ",")
+
+	// svm_name is omitted for cluster scoped routes
+	if (len(idParts) != 3 && len(idParts) != 4) || idParts[0] == "" || idParts[1] == "" || idParts[len(idParts)-1] == "" || (len(idParts) == 4 && idParts[2] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: destination,gateway,svm_name,cx_profile_name or destination,gateway,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	destination := strings.Split(idParts[0], "/")
+	if len(destination) != 2 || destination[0] == "" || destination[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected destination with format: address/netmask. Got: %q", idParts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination").AtName("address"), destination[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination").AtName("netmask"), destination[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gateway"), idParts[1])...)
+	if len(idParts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[len(idParts)-1])...)
 }
@@ -53,21 +53,22 @@ func (d *IPRoutesDataSource) Metadata(ctx context.Context, req datasource.Metada
 func (d *IPRoutesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "IP Routes data source",
+		MarkdownDescription: "IP Routes data source, lists the routes of the cluster and SVMs",
 
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"gateway": schema.StringAttribute{
 				MarkdownDescription: "The IP address of the gateway router leading to the destination.",
-				Required:            true,
+				Optional:            true,
+				DeprecationMessage:  "Use filter.gateway instead, gateway is only used when filter.gateway is not set.",
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"svm_name": schema.StringAttribute{
-						MarkdownDescription: "IP Route svm name",
+						MarkdownDescription: "IP Route svm name, wildcards are supported",
 						Optional:            true,
 					},
 					"destination": schema.SingleNestedAttribute{
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+)
+
+// defaultMetricsInterval is used when the interval is not set, ONTAP keeps one sample every 15 seconds for the last hour.
+const defaultMetricsInterval = "1h"
+
+// PerformanceMetricDataSourceModel describes a performance sample.
+type PerformanceMetricDataSourceModel struct {
+	Timestamp  types.String                           `tfsdk:"timestamp"`
+	Duration   types.String                           `tfsdk:"duration"`
+	Status     types.String                           `tfsdk:"status"`
+	Latency    PerformanceMetricValuesDataSourceModel `tfsdk:"latency"`
+	IOPS       PerformanceMetricValuesDataSourceModel `tfsdk:"iops"`
+	Throughput PerformanceMetricValuesDataSourceModel `tfsdk:"throughput"`
+}
+
+// PerformanceMetricValuesDataSourceModel describes the read, write, other and total values of a performance counter.
+type PerformanceMetricValuesDataSourceModel struct {
+	Read  types.Int64 `tfsdk:"read"`
+	Write types.Int64 `tfsdk:"write"`
+	Other types.Int64 `tfsdk:"other"`
+	Total types.Int64 `tfsdk:"total"`
+}
+
+// metricsIntervalAttribute returns the schema of the interval attribute, shared by the metrics data sources.
+func metricsIntervalAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Time range of the samples, one of 1h, 1d, 1w, 1m, or 1y. Defaults to 1h",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("1h", "1d", "1w", "1m", "1y"),
+		},
+	}
+}
+
+// metricsAttribute returns the schema of the list of samples, shared by the metrics data sources.
+func metricsAttribute() schema.ListNestedAttribute {
+	values := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"read": schema.Int64Attribute{
+					MarkdownDescription: "Read operations",
+					Computed:            true,
+				},
+				"write": schema.Int64Attribute{
+					MarkdownDescription: "Write operations",
+					Computed:            true,
+				},
+				"other": schema.Int64Attribute{
+					MarkdownDescription: "Operations that are neither read nor write",
+					Computed:            true,
+				},
+				"total": schema.Int64Attribute{
+					MarkdownDescription: "All operations",
+					Computed:            true,
+				},
+			},
+		}
+	}
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Performance samples over the interval, the most recent first",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"timestamp": schema.StringAttribute{
+					MarkdownDescription: "Time of the sample, in ISO 8601 format",
+					Computed:            true,
+				},
+				"duration": schema.StringAttribute{
+					MarkdownDescription: "Time covered by the sample, in ISO 8601 format, eg PT15S",
+					Computed:            true,
+				},
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Status of the sample, values other than ok mean the counters are not reliable",
+					Computed:            true,
+				},
+				"latency":    values("Average latency, in microseconds"),
+				"iops":       values("Operations per second"),
+				"throughput": values("Throughput, in bytes per second"),
+			},
+		},
+	}
+}
+
+func flattenPerformanceMetricValues(values interfaces.PerformanceMetricValues) PerformanceMetricValuesDataSourceModel {
+	return PerformanceMetricValuesDataSourceModel{
+		Read:  types.Int64Value(values.Read),
+		Write: types.Int64Value(values.Write),
+		Other: types.Int64Value(values.Other),
+		Total: types.Int64Value(values.Total),
+	}
+}
+
+// flattenPerformanceMetrics converts the samples returned by ONTAP, an empty list is returned when there is no sample.
+func flattenPerformanceMetrics(records []interfaces.PerformanceMetricGetDataModelONTAP) []PerformanceMetricDataSourceModel {
+	metrics := make([]PerformanceMetricDataSourceModel, 0, len(records))
+	for _, record := range records {
+		metrics = append(metrics, PerformanceMetricDataSourceModel{
+			Timestamp:  types.StringValue(record.Timestamp),
+			Duration:   types.StringValue(record.Duration),
+			Status:     types.StringValue(record.Status),
+			Latency:    flattenPerformanceMetricValues(record.Latency),
+			IOPS:       flattenPerformanceMetricValues(record.IOPS),
+			Throughput: flattenPerformanceMetricValues(record.Throughput),
+		})
+	}
+	return metrics
+}
+
+// metricsInterval returns the configured interval, or the default one.
+func metricsInterval(interval types.String) string {
+	if interval.IsNull() || interval.ValueString() == "" {
+		return defaultMetricsInterval
+	}
+	return interval.ValueString()
+}
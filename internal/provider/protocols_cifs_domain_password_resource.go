@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CifsDomainPasswordResource{}
+var _ resource.ResourceWithImportState = &CifsDomainPasswordResource{}
+
+// NewCifsDomainPasswordResource is a helper function to simplify the provider implementation.
+func NewCifsDomainPasswordResource() resource.Resource {
+	return &CifsDomainPasswordResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_domain_password_resource",
+		},
+	}
+}
+
+// CifsDomainPasswordResource defines the resource implementation.
+type CifsDomainPasswordResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// CifsDomainPasswordResourceModel describes the resource data model.
+type CifsDomainPasswordResourceModel struct {
+	CxProfileName              types.String `tfsdk:"cx_profile_name"`
+	SVMName                    types.String `tfsdk:"svm_name"`
+	ScheduleEnabled            types.Bool   `tfsdk:"schedule_enabled"`
+	ScheduleWeeklyInterval     types.Int64  `tfsdk:"schedule_weekly_interval"`
+	ScheduleRandomizedInterval types.Int64  `tfsdk:"schedule_randomized_interval"`
+	ResetTrigger               types.String `tfsdk:"reset_trigger"`
+	ADUser                     types.String `tfsdk:"ad_user"`
+	ADPassword                 types.String `tfsdk:"ad_password"`
+	ID                         types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *CifsDomainPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *CifsDomainPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CIFS server machine account password schedule and reset resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the svm hosting the CIFS server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the machine account password is changed automatically",
+				Optional:            true,
+			},
+			"schedule_weekly_interval": schema.Int64Attribute{
+				MarkdownDescription: "Number of weeks between automatic password changes",
+				Optional:            true,
+			},
+			"schedule_randomized_interval": schema.Int64Attribute{
+				MarkdownDescription: "Maximum random delay, in minutes, added to the scheduled time of the automatic password change",
+				Optional:            true,
+			},
+			"reset_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value, eg a date. The machine account password is changed when the resource is created with a reset_trigger, and each time the value changes",
+				Optional:            true,
+			},
+			"ad_user": schema.StringAttribute{
+				MarkdownDescription: "Active Directory user, to reset the password when the machine account password is no longer valid. Without it, the password is changed using the machine account",
+				Optional:            true,
+			},
+			"ad_password": schema.StringAttribute{
+				MarkdownDescription: "Active Directory user password",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SVM UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CifsDomainPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CifsDomainPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CifsDomainPasswordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetCifsDomainPassword(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetCifsDomainPassword
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	schedule := restInfo.ADDomain.PasswordSchedule
+	if imported || !data.ScheduleEnabled.IsNull() {
+		data.ScheduleEnabled = types.BoolValue(schedule.ScheduleEnabled)
+	}
+	if imported || !data.ScheduleWeeklyInterval.IsNull() {
+		data.ScheduleWeeklyInterval = types.Int64Value(schedule.ScheduleWeeklyInterval)
+	}
+	if imported || !data.ScheduleRandomizedInterval.IsNull() {
+		data.ScheduleRandomizedInterval = types.Int64Value(schedule.ScheduleRandomizedInterval)
+	}
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the schedule, and changes the password if reset_trigger is set
+func (r *CifsDomainPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CifsDomainPasswordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetCifsDomainPassword(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+
+	if err = r.updateSchedule(errorHandler, *client, data, nil); err != nil {
+		return
+	}
+	if !data.ResetTrigger.IsNull() {
+		if err = interfaces.ResetCifsDomainPassword(errorHandler, *client, data.ADUser.ValueString(), data.ADPassword.ValueString(), data.ID.ValueString()); err != nil {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CifsDomainPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *CifsDomainPasswordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.updateSchedule(errorHandler, *client, data, state); err != nil {
+		return
+	}
+	if !data.ResetTrigger.IsNull() && !data.ResetTrigger.Equal(state.ResetTrigger) {
+		if err = interfaces.ResetCifsDomainPassword(errorHandler, *client, data.ADUser.ValueString(), data.ADPassword.ValueString(), data.ID.ValueString()); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateSchedule sends the schedule settings that are set in the plan and differ from the state, if any
+func (r *CifsDomainPasswordResource) updateSchedule(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *CifsDomainPasswordResourceModel, state *CifsDomainPasswordResourceModel) error {
+	var schedule interfaces.CifsDomainPasswordScheduleBody
+	changed := false
+	if !data.ScheduleEnabled.IsNull() && (state == nil || !data.ScheduleEnabled.Equal(state.ScheduleEnabled)) {
+		schedule.ScheduleEnabled = data.ScheduleEnabled.ValueBoolPointer()
+		changed = true
+	}
+	if !data.ScheduleWeeklyInterval.IsNull() && (state == nil || !data.ScheduleWeeklyInterval.Equal(state.ScheduleWeeklyInterval)) {
+		schedule.ScheduleWeeklyInterval = data.ScheduleWeeklyInterval.ValueInt64Pointer()
+		changed = true
+	}
+	if !data.ScheduleRandomizedInterval.IsNull() && (state == nil || !data.ScheduleRandomizedInterval.Equal(state.ScheduleRandomizedInterval)) {
+		schedule.ScheduleRandomizedInterval = data.ScheduleRandomizedInterval.ValueInt64Pointer()
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return interfaces.UpdateCifsDomainPasswordSchedule(errorHandler, client, schedule, data.ID.ValueString())
+}
+
+// Delete removes the resource from the Terraform state, the schedule is left unchanged.
+func (r *CifsDomainPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CifsDomainPasswordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("cifs domain password for svm %s removed from state, schedule is left unchanged", data.SVMName.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *CifsDomainPasswordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a cifs domain password resource: %#v", req.ID))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsCifsDomainPasswordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsCifsDomainPasswordResourceConfig(4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_domain_password_resource.example", "schedule_enabled", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_domain_password_resource.example", "schedule_weekly_interval", "4"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsCifsDomainPasswordResourceConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_domain_password_resource.example", "schedule_weekly_interval", "2"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_cifs_domain_password_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_domain_password_resource.example", "svm_name", "svm0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsCifsDomainPasswordResourceConfig(weeklyInterval int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_cifs_domain_password_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  schedule_enabled = true
+  schedule_weekly_interval = %d
+}`, host, admin, password, weeklyInterval)
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsCIFSHomeDirectorySearchPathResource{}
+var _ resource.ResourceWithImportState = &ProtocolsCIFSHomeDirectorySearchPathResource{}
+
+// NewProtocolsCIFSHomeDirectorySearchPathResource is a helper function to simplify the provider implementation.
+func NewProtocolsCIFSHomeDirectorySearchPathResource() resource.Resource {
+	return &ProtocolsCIFSHomeDirectorySearchPathResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_home_directory_search_path_resource",
+		},
+	}
+}
+
+// ProtocolsCIFSHomeDirectorySearchPathResource defines the resource implementation.
+type ProtocolsCIFSHomeDirectorySearchPathResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCIFSHomeDirectorySearchPathResourceModel describes the resource data model.
+type ProtocolsCIFSHomeDirectorySearchPathResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Path          types.String `tfsdk:"path"`
+	Index         types.Int64  `tfsdk:"index"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CIFS home directory search path resource, where ONTAP looks for the home directory of a user connecting to a dynamic home directory share",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Absolute path in the SVM namespace, eg /home1, searched for a directory matching the home directory share path",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Position of the path in the search order, starting at 1. The path is added at the end of the list when not set",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Home directory search path identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsCIFSHomeDirectorySearchPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, data.Path.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCIFSHomeDirectorySearchPath
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("home directory search path %s not found, removing it from state", data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// the index changes when a path before this one is removed
+	data.Index = types.Int64Value(restInfo.Index)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s", data.SVMName.ValueString(), data.Path.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create adds the path to the home directory search paths of the SVM
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsCIFSHomeDirectorySearchPathResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.ProtocolsCIFSHomeDirectorySearchPathResourceBodyDataModelONTAP{
+		Path: data.Path.ValueString(),
+		SVM:  interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+	}
+	if !data.Index.IsUnknown() {
+		body.Index = data.Index.ValueInt64()
+	}
+	if err = interfaces.CreateProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, body); err != nil {
+		return
+	}
+
+	// POST does not return the record, read it to know its index
+	restInfo, err := interfaces.GetProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, data.Path.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error creating home directory search path", fmt.Sprintf("home directory search path %s not found after create", data.Path.ValueString()))
+		return
+	}
+	data.Index = types.Int64Value(restInfo.Index)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s", data.SVMName.ValueString(), data.Path.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update moves the path in the search order, as only index can be modified.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsCIFSHomeDirectorySearchPathResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, data.Path.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error updating home directory search path", fmt.Sprintf("home directory search path %s not found", data.Path.ValueString()))
+		return
+	}
+	if data.Index.ValueInt64() != restInfo.Index {
+		if err = interfaces.UpdateProtocolsCIFSHomeDirectorySearchPathIndex(errorHandler, *client, restInfo.SVM.UUID, restInfo.Index, data.Index.ValueInt64()); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsCIFSHomeDirectorySearchPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// paths are deleted by index, which changes when other paths are removed
+	restInfo, err := interfaces.GetProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, data.Path.ValueString(), data.SVMName.ValueString())
+	if err != nil || restInfo == nil {
+		return
+	}
+	err = interfaces.DeleteProtocolsCIFSHomeDirectorySearchPath(errorHandler, *client, restInfo.SVM.UUID, restInfo.Index)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsCIFSHomeDirectorySearchPathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a cifs home directory search path resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: path,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsCIFSHomeDirectorySearchPathResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsCIFSHomeDirectorySearchPathResourceConfig("null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_home_directory_search_path_resource.example", "path", "/acc_test_home2"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_home_directory_search_path_resource.example", "index", "2"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsCIFSHomeDirectorySearchPathResourceConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_home_directory_search_path_resource.example", "index", "1"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_cifs_home_directory_search_path_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "/acc_test_home2", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_home_directory_search_path_resource.example", "path", "/acc_test_home2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsCIFSHomeDirectorySearchPathResourceConfig(index string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_cifs_home_directory_search_path_resource" "first" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  path = "/acc_test_home1"
+}
+
+resource "netapp-ontap_protocols_cifs_home_directory_search_path_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  path = "/acc_test_home2"
+  index = %s
+  depends_on = [netapp-ontap_protocols_cifs_home_directory_search_path_resource.first]
+}`, host, admin, password, index)
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCIFSSharesDataSource{}
+
+// NewProtocolsCIFSSharesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCIFSSharesDataSource() datasource.DataSource {
+	return &ProtocolsCIFSSharesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_shares_data_source",
+		},
+	}
+}
+
+// ProtocolsCIFSSharesDataSource defines the data source implementation.
+type ProtocolsCIFSSharesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCIFSSharesDataSourceModel describes the data source data model.
+type ProtocolsCIFSSharesDataSourceModel struct {
+	CxProfileName types.String                             `tfsdk:"cx_profile_name"`
+	Shares        []ProtocolsCIFSShareDataSourceModel      `tfsdk:"protocols_cifs_shares"`
+	Filter        *ProtocolsCIFSShareDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsCIFSShareDataSourceModel describes the data model for a share.
+type ProtocolsCIFSShareDataSourceModel struct {
+	Name                   types.String                     `tfsdk:"name"`
+	SVMName                types.String                     `tfsdk:"svm_name"`
+	Path                   types.String                     `tfsdk:"path"`
+	Comment                types.String                     `tfsdk:"comment"`
+	VolumeName             types.String                     `tfsdk:"volume_name"`
+	AccessBasedEnumeration types.Bool                       `tfsdk:"access_based_enumeration"`
+	ChangeNotify           types.Bool                       `tfsdk:"change_notify"`
+	ContinuouslyAvailable  types.Bool                       `tfsdk:"continuously_available"`
+	Encryption             types.Bool                       `tfsdk:"encryption"`
+	HomeDirectory          types.Bool                       `tfsdk:"home_directory"`
+	Oplocks                types.Bool                       `tfsdk:"oplocks"`
+	ShowSnapshot           types.Bool                       `tfsdk:"show_snapshot"`
+	OfflineFiles           types.String                     `tfsdk:"offline_files"`
+	UnixSymlink            types.String                     `tfsdk:"unix_symlink"`
+	ACLs                   []ProtocolsCIFSShareACLDataModel `tfsdk:"acls"`
+}
+
+// ProtocolsCIFSShareACLDataModel describes an access control entry of a share.
+type ProtocolsCIFSShareACLDataModel struct {
+	UserOrGroup types.String `tfsdk:"user_or_group"`
+	Permission  types.String `tfsdk:"permission"`
+	Type        types.String `tfsdk:"type"`
+}
+
+// ProtocolsCIFSShareDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsCIFSShareDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	SVMName types.String `tfsdk:"svm_name"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCIFSSharesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCIFSSharesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CIFS shares data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Share name, wildcards are supported",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Share svm name",
+						Optional:            true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Share path, wildcards are supported, eg /vol1/*",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_cifs_shares": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Share name",
+							Computed:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Share svm name",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the share in the svm namespace",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Share comment",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Volume containing the share path",
+							Computed:            true,
+						},
+						"access_based_enumeration": schema.BoolAttribute{
+							MarkdownDescription: "Whether access based enumeration is enabled",
+							Computed:            true,
+						},
+						"change_notify": schema.BoolAttribute{
+							MarkdownDescription: "Whether change notifications are enabled",
+							Computed:            true,
+						},
+						"continuously_available": schema.BoolAttribute{
+							MarkdownDescription: "Whether the share is continuously available",
+							Computed:            true,
+						},
+						"encryption": schema.BoolAttribute{
+							MarkdownDescription: "Whether SMB encryption is required to access the share",
+							Computed:            true,
+						},
+						"home_directory": schema.BoolAttribute{
+							MarkdownDescription: "Whether the share is a home directory share",
+							Computed:            true,
+						},
+						"oplocks": schema.BoolAttribute{
+							MarkdownDescription: "Whether opportunistic locks are enabled",
+							Computed:            true,
+						},
+						"show_snapshot": schema.BoolAttribute{
+							MarkdownDescription: "Whether the snapshot directory is visible",
+							Computed:            true,
+						},
+						"offline_files": schema.StringAttribute{
+							MarkdownDescription: "Offline files caching, eg none, manual, documents, programs",
+							Computed:            true,
+						},
+						"unix_symlink": schema.StringAttribute{
+							MarkdownDescription: "UNIX symbolic links handling, eg local, widelink, disable",
+							Computed:            true,
+						},
+						"acls": schema.ListNestedAttribute{
+							MarkdownDescription: "Share access control entries",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user_or_group": schema.StringAttribute{
+										MarkdownDescription: "User or group name",
+										Computed:            true,
+									},
+									"permission": schema.StringAttribute{
+										MarkdownDescription: "Access permission, eg read, change, full_control, no_access",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "User or group type, eg windows, unix_user, unix_group",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCIFSSharesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCIFSSharesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCIFSSharesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsCIFSShareDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsCIFSShareDataSourceFilterModel{
+			Name:    data.Filter.Name.ValueString(),
+			SVMName: data.Filter.SVMName.ValueString(),
+			Path:    data.Filter.Path.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListProtocolsCIFSShares(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListProtocolsCIFSShares
+		return
+	}
+
+	data.Shares = make([]ProtocolsCIFSShareDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		acls := make([]ProtocolsCIFSShareACLDataModel, len(record.ACLs))
+		for i, acl := range record.ACLs {
+			acls[i] = ProtocolsCIFSShareACLDataModel{
+				UserOrGroup: types.StringValue(acl.UserOrGroup),
+				Permission:  types.StringValue(acl.Permission),
+				Type:        types.StringValue(acl.Type),
+			}
+		}
+		data.Shares[index] = ProtocolsCIFSShareDataSourceModel{
+			Name:                   types.StringValue(record.Name),
+			SVMName:                types.StringValue(record.SVM.Name),
+			Path:                   types.StringValue(record.Path),
+			Comment:                types.StringValue(record.Comment),
+			VolumeName:             types.StringValue(record.Volume.Name),
+			AccessBasedEnumeration: types.BoolValue(record.AccessBasedEnumeration),
+			ChangeNotify:           types.BoolValue(record.ChangeNotify),
+			ContinuouslyAvailable:  types.BoolValue(record.ContinuouslyAvailable),
+			Encryption:             types.BoolValue(record.Encryption),
+			HomeDirectory:          types.BoolValue(record.HomeDirectory),
+			Oplocks:                types.BoolValue(record.Oplocks),
+			ShowSnapshot:           types.BoolValue(record.ShowSnapshot),
+			OfflineFiles:           types.StringValue(record.OfflineFiles),
+			UnixSymlink:            types.StringValue(record.UnixSymlink),
+			ACLs:                   acls,
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
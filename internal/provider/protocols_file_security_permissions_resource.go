@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsFileSecurityPermissionsResource{}
+var _ resource.ResourceWithImportState = &ProtocolsFileSecurityPermissionsResource{}
+
+// NewProtocolsFileSecurityPermissionsResource is a helper function to simplify the provider implementation.
+func NewProtocolsFileSecurityPermissionsResource() resource.Resource {
+	return &ProtocolsFileSecurityPermissionsResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_file_security_permissions_resource",
+		},
+	}
+}
+
+// ProtocolsFileSecurityPermissionsResource defines the resource implementation.
+type ProtocolsFileSecurityPermissionsResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsFileSecurityPermissionsResourceModel describes the resource data model.
+type ProtocolsFileSecurityPermissionsResourceModel struct {
+	CxProfileName   types.String                          `tfsdk:"cx_profile_name"`
+	SVMName         types.String                          `tfsdk:"svm_name"`
+	Path            types.String                          `tfsdk:"path"`
+	Owner           types.String                          `tfsdk:"owner"`
+	Group           types.String                          `tfsdk:"group"`
+	PropagationMode types.String                          `tfsdk:"propagation_mode"`
+	ACLs            []ProtocolsFileSecurityPermissionsACL `tfsdk:"acls"`
+	ID              types.String                          `tfsdk:"id"`
+}
+
+// ProtocolsFileSecurityPermissionsACL describes an access control entry.
+type ProtocolsFileSecurityPermissionsACL struct {
+	User    types.String                             `tfsdk:"user"`
+	Access  types.String                             `tfsdk:"access"`
+	Rights  types.String                             `tfsdk:"rights"`
+	ApplyTo *ProtocolsFileSecurityPermissionsApplyTo `tfsdk:"apply_to"`
+}
+
+// ProtocolsFileSecurityPermissionsApplyTo describes where an access control entry is propagated.
+type ProtocolsFileSecurityPermissionsApplyTo struct {
+	Files      types.Bool `tfsdk:"files"`
+	SubFolders types.Bool `tfsdk:"sub_folders"`
+	ThisFolder types.Bool `tfsdk:"this_folder"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsFileSecurityPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsFileSecurityPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "File security permissions resource, to set the owner, group, and access control entries of a file or directory",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file or directory in the SVM namespace, eg /vol1/share1",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Owner of the file or directory, as a user name or SID, eg DOMAIN\\user1. Left unchanged when not set",
+				Optional:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Primary group of the file or directory, as a group name or SID. Left unchanged when not set",
+				Optional:            true,
+			},
+			"propagation_mode": schema.StringAttribute{
+				MarkdownDescription: "How the access control entries are applied to the child files and directories, propagate to add them to the existing entries, replace to replace the existing entries",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("propagate", "replace"),
+				},
+			},
+			"acls": schema.ListNestedAttribute{
+				MarkdownDescription: "Access control entries managed by this resource. Inherited entries and entries for other users are left unchanged",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user": schema.StringAttribute{
+							MarkdownDescription: "User or group the entry applies to, as a name or SID, eg DOMAIN\\group1 or Everyone",
+							Required:            true,
+						},
+						"access": schema.StringAttribute{
+							MarkdownDescription: "Whether the entry allows or denies access",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("access_allow", "access_deny"),
+							},
+						},
+						"rights": schema.StringAttribute{
+							MarkdownDescription: "Access rights of the entry",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("no_access", "full_control", "modify", "read_and_execute", "read", "write"),
+							},
+						},
+						"apply_to": schema.SingleNestedAttribute{
+							MarkdownDescription: "Where the entry applies, to this folder, sub folders, and files when not set",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"files": schema.BoolAttribute{
+									MarkdownDescription: "Apply to the files",
+									Required:            true,
+								},
+								"sub_folders": schema.BoolAttribute{
+									MarkdownDescription: "Apply to the sub folders",
+									Required:            true,
+								},
+								"this_folder": schema.BoolAttribute{
+									MarkdownDescription: "Apply to this folder",
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "File security permissions identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsFileSecurityPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// fileSecurityACLKey identifies an access control entry, a user can have one allow and one deny entry.
+func fileSecurityACLKey(user string, access string) string {
+	return strings.ToLower(user) + "," + access
+}
+
+// fileSecurityACLToONTAP converts an access control entry to the REST body model.
+func fileSecurityACLToONTAP(acl ProtocolsFileSecurityPermissionsACL) interfaces.FileSecurityPermissionsACL {
+	aclONTAP := interfaces.FileSecurityPermissionsACL{
+		User:   acl.User.ValueString(),
+		Access: acl.Access.ValueString(),
+		Rights: acl.Rights.ValueString(),
+	}
+	if acl.ApplyTo != nil {
+		aclONTAP.ApplyTo = &interfaces.FileSecurityPermissionsApplyTo{
+			Files:      acl.ApplyTo.Files.ValueBool(),
+			SubFolders: acl.ApplyTo.SubFolders.ValueBool(),
+			ThisFolder: acl.ApplyTo.ThisFolder.ValueBool(),
+		}
+	}
+	return aclONTAP
+}
+
+// fileSecurityACLFromONTAP converts an access control entry from ONTAP, keeping the configured user name and omitting apply_to when it matches the default.
+func fileSecurityACLFromONTAP(aclONTAP interfaces.FileSecurityPermissionsACL, configured *ProtocolsFileSecurityPermissionsACL) ProtocolsFileSecurityPermissionsACL {
+	acl := ProtocolsFileSecurityPermissionsACL{
+		User:   types.StringValue(aclONTAP.User),
+		Access: types.StringValue(aclONTAP.Access),
+		Rights: types.StringValue(aclONTAP.Rights),
+	}
+	if configured != nil {
+		acl.User = configured.User
+	}
+	applyTo := aclONTAP.ApplyTo
+	if applyTo != nil && (configured == nil || configured.ApplyTo != nil || !applyTo.Files || !applyTo.SubFolders || !applyTo.ThisFolder) {
+		acl.ApplyTo = &ProtocolsFileSecurityPermissionsApplyTo{
+			Files:      types.BoolValue(applyTo.Files),
+			SubFolders: types.BoolValue(applyTo.SubFolders),
+			ThisFolder: types.BoolValue(applyTo.ThisFolder),
+		}
+	}
+	return acl
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsFileSecurityPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsFileSecurityPermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetFileSecurityPermissions(errorHandler, *client, svm.UUID, data.Path.ValueString())
+	if err != nil {
+		// error reporting done inside GetFileSecurityPermissions
+		return
+	}
+
+	// only the configured settings are reported, the owner and group may be set by other tools.
+	// On import, ID is not set yet, and all settings are reported.
+	imported := data.ID.IsNull()
+	if imported || (!data.Owner.IsNull() && !strings.EqualFold(data.Owner.ValueString(), restInfo.Owner)) {
+		data.Owner = types.StringValue(restInfo.Owner)
+	}
+	if imported || (!data.Group.IsNull() && !strings.EqualFold(data.Group.ValueString(), restInfo.Group)) {
+		data.Group = types.StringValue(restInfo.Group)
+	}
+
+	explicitACLs := map[string]interfaces.FileSecurityPermissionsACL{}
+	for _, aclONTAP := range restInfo.ACLs {
+		if !aclONTAP.Inherited {
+			explicitACLs[fileSecurityACLKey(aclONTAP.User, aclONTAP.Access)] = aclONTAP
+		}
+	}
+	if imported {
+		for _, aclONTAP := range restInfo.ACLs {
+			if !aclONTAP.Inherited {
+				data.ACLs = append(data.ACLs, fileSecurityACLFromONTAP(aclONTAP, nil))
+			}
+		}
+	} else if data.ACLs != nil {
+		// keep the configured order, entries removed outside of Terraform are dropped and added back on apply
+		acls := []ProtocolsFileSecurityPermissionsACL{}
+		for i := range data.ACLs {
+			if aclONTAP, ok := explicitACLs[fileSecurityACLKey(data.ACLs[i].User.ValueString(), data.ACLs[i].Access.ValueString())]; ok {
+				acls = append(acls, fileSecurityACLFromONTAP(aclONTAP, &data.ACLs[i]))
+			}
+		}
+		data.ACLs = acls
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s", data.SVMName.ValueString(), data.Path.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create sets the owner, group, and access control entries of the path
+func (r *ProtocolsFileSecurityPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsFileSecurityPermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	body := interfaces.FileSecurityPermissionsResourceBodyDataModelONTAP{
+		Owner:           data.Owner.ValueString(),
+		Group:           data.Group.ValueString(),
+		PropagationMode: data.PropagationMode.ValueString(),
+	}
+	for _, acl := range data.ACLs {
+		body.ACLs = append(body.ACLs, fileSecurityACLToONTAP(acl))
+	}
+	timeout := r.config.providerConfig.JobCompletionTimeOut
+	interval := r.config.providerConfig.JobPollInterval
+	if err = interfaces.CreateFileSecurityPermissions(errorHandler, *client, svm.UUID, data.Path.ValueString(), body, timeout, interval); err != nil {
+		return
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s", data.SVMName.ValueString(), data.Path.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the owner and group, and adds, modifies, or removes the access control entries that differ from the state.
+func (r *ProtocolsFileSecurityPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsFileSecurityPermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	timeout := r.config.providerConfig.JobCompletionTimeOut
+	interval := r.config.providerConfig.JobPollInterval
+	propagationMode := data.PropagationMode.ValueString()
+
+	var body interfaces.FileSecurityPermissionsResourceBodyDataModelONTAP
+	if !data.Owner.IsNull() && !data.Owner.Equal(state.Owner) {
+		body.Owner = data.Owner.ValueString()
+	}
+	if !data.Group.IsNull() && !data.Group.Equal(state.Group) {
+		body.Group = data.Group.ValueString()
+	}
+	if body.Owner != "" || body.Group != "" {
+		body.PropagationMode = propagationMode
+		if err = interfaces.UpdateFileSecurityPermissions(errorHandler, *client, svm.UUID, data.Path.ValueString(), body, timeout, interval); err != nil {
+			return
+		}
+	}
+
+	planned := map[string]ProtocolsFileSecurityPermissionsACL{}
+	for _, acl := range data.ACLs {
+		planned[fileSecurityACLKey(acl.User.ValueString(), acl.Access.ValueString())] = acl
+	}
+	current := map[string]ProtocolsFileSecurityPermissionsACL{}
+	for _, acl := range state.ACLs {
+		key := fileSecurityACLKey(acl.User.ValueString(), acl.Access.ValueString())
+		current[key] = acl
+		if _, ok := planned[key]; !ok {
+			if err = interfaces.DeleteFileSecurityPermissionsACL(errorHandler, *client, svm.UUID, data.Path.ValueString(), fileSecurityACLToONTAP(acl), timeout, interval); err != nil {
+				return
+			}
+		}
+	}
+	for _, acl := range data.ACLs {
+		stateACL, ok := current[fileSecurityACLKey(acl.User.ValueString(), acl.Access.ValueString())]
+		if !ok {
+			err = interfaces.AddFileSecurityPermissionsACL(errorHandler, *client, svm.UUID, data.Path.ValueString(), fileSecurityACLToONTAP(acl), propagationMode, timeout, interval)
+		} else if !acl.Rights.Equal(stateACL.Rights) || !fileSecurityApplyToEqual(acl.ApplyTo, stateACL.ApplyTo) {
+			err = interfaces.UpdateFileSecurityPermissionsACL(errorHandler, *client, svm.UUID, data.Path.ValueString(), fileSecurityACLToONTAP(acl), propagationMode, timeout, interval)
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fileSecurityApplyToEqual compares two apply_to settings, not set is the same as applying everywhere.
+func fileSecurityApplyToEqual(a *ProtocolsFileSecurityPermissionsApplyTo, b *ProtocolsFileSecurityPermissionsApplyTo) bool {
+	all := &ProtocolsFileSecurityPermissionsApplyTo{Files: types.BoolValue(true), SubFolders: types.BoolValue(true), ThisFolder: types.BoolValue(true)}
+	if a == nil {
+		a = all
+	}
+	if b == nil {
+		b = all
+	}
+	return a.Files.Equal(b.Files) && a.SubFolders.Equal(b.SubFolders) && a.ThisFolder.Equal(b.ThisFolder)
+}
+
+// Delete removes the access control entries managed by this resource, the owner and group are left unchanged.
+func (r *ProtocolsFileSecurityPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsFileSecurityPermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	timeout := r.config.providerConfig.JobCompletionTimeOut
+	interval := r.config.providerConfig.JobPollInterval
+	for _, acl := range data.ACLs {
+		if err = interfaces.DeleteFileSecurityPermissionsACL(errorHandler, *client, svm.UUID, data.Path.ValueString(), fileSecurityACLToONTAP(acl), timeout, interval); err != nil {
+			return
+		}
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsFileSecurityPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a file security permissions resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: path,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
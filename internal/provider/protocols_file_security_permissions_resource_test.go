@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsFileSecurityPermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsFileSecurityPermissionsResourceConfig("read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_file_security_permissions_resource.example", "path", "/acc_test_file_security"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_file_security_permissions_resource.example", "acls.0.rights", "read"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsFileSecurityPermissionsResourceConfig("modify"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_file_security_permissions_resource.example", "acls.0.rights", "modify"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_file_security_permissions_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "/acc_test_file_security", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_file_security_permissions_resource.example", "path", "/acc_test_file_security"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsFileSecurityPermissionsResourceConfig(rights string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_file_security_permissions_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  path = "/acc_test_file_security"
+  owner = "BUILTIN\\Administrators"
+  propagation_mode = "propagate"
+  acls = [
+    {
+      user = "Everyone"
+      access = "access_allow"
+      rights = "%s"
+    },
+  ]
+}`, host, admin, password, rights)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsLockBreakResource{}
+
+// NewProtocolsLockBreakResource is a helper function to simplify the provider implementation.
+func NewProtocolsLockBreakResource() resource.Resource {
+	return &ProtocolsLockBreakResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_lock_break_resource",
+		},
+	}
+}
+
+// ProtocolsLockBreakResource defines the resource implementation.
+type ProtocolsLockBreakResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsLockBreakResourceModel describes the resource data model.
+type ProtocolsLockBreakResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	Path          types.String `tfsdk:"path"`
+	ClientAddress types.String `tfsdk:"client_address"`
+	Protocol      types.String `tfsdk:"protocol"`
+	MaxLocks      types.Int64  `tfsdk:"max_locks"`
+	Trigger       types.String `tfsdk:"trigger"`
+	BrokenLocks   types.Int64  `tfsdk:"broken_locks"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsLockBreakResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsLockBreakResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Break the CIFS or NFS locks held on a file. The locks are broken on create, any change recreates the resource and breaks the matching locks again",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Lock svm name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Lock volume name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the locked file. Wildcards are not allowed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_address": schema.StringAttribute{
+				MarkdownDescription: "Only break the locks held by this client IP address",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only break the locks for this protocol, eg cifs, nlm, nfsv4, nfsv4.1",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_locks": schema.Int64Attribute{
+				MarkdownDescription: "Safety guard: no lock is broken if more locks than this match. Defaults to 1",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value. Changing it breaks the matching locks again",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"broken_locks": schema.Int64Attribute{
+				MarkdownDescription: "Number of locks broken on create",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Lock break identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsLockBreakResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read keeps the Terraform state, as the locks are no longer expected to exist once broken.
+func (r *ProtocolsLockBreakResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsLockBreakResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create breaks the matching locks, unless more than max_locks match
+func (r *ProtocolsLockBreakResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsLockBreakResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if strings.ContainsAny(data.Path.ValueString(), "*?") {
+		errorHandler.MakeAndReportError("invalid path", fmt.Sprintf("wildcards are not allowed in path %s", data.Path.ValueString()))
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	filter := interfaces.ProtocolsLockDataSourceFilterModel{
+		SVMName:       data.SVMName.ValueString(),
+		VolumeName:    data.VolumeName.ValueString(),
+		Path:          data.Path.ValueString(),
+		ClientAddress: data.ClientAddress.ValueString(),
+		Protocol:      data.Protocol.ValueString(),
+	}
+	locks, err := interfaces.GetListProtocolsLocks(errorHandler, *client, &filter)
+	if err != nil {
+		return
+	}
+	if int64(len(locks)) > data.MaxLocks.ValueInt64() {
+		errorHandler.MakeAndReportError("too many locks",
+			fmt.Sprintf("%d locks match path %s, max_locks is %d, no lock was broken", len(locks), data.Path.ValueString(), data.MaxLocks.ValueInt64()))
+		return
+	}
+	for _, lock := range locks {
+		tflog.Debug(ctx, fmt.Sprintf("breaking lock %s on %s held by %s", lock.UUID, lock.Path, lock.ClientAddress))
+		if err = interfaces.BreakProtocolsLock(errorHandler, *client, lock.UUID); err != nil {
+			return
+		}
+	}
+
+	data.BrokenLocks = types.Int64Value(int64(len(locks)))
+	data.ID = types.StringValue(fmt.Sprintf("%s,%s,%s", data.SVMName.ValueString(), data.VolumeName.ValueString(), data.Path.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not expected, as all the attributes require a replacement.
+func (r *ProtocolsLockBreakResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsLockBreakResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *ProtocolsLockBreakResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsLockBreakResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("lock break %s removed from state", data.ID.ValueString()))
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsLockBreakResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// wildcards are rejected
+			{
+				Config:      testAccProtocolsLockBreakResourceConfig("/terraform_vol/*"),
+				ExpectError: regexp.MustCompile("wildcards are not allowed"),
+			},
+			// no lock on the file, nothing to break
+			{
+				Config: testAccProtocolsLockBreakResourceConfig("/terraform_vol/not_locked"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_lock_break_resource.example", "broken_locks", "0"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_lock_break_resource.example", "max_locks", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsLockBreakResourceConfig(path string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_lock_break_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "terraform_vol"
+  path = "%s"
+}`, host, admin, password, path)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsLocksDataSource{}
+
+// NewProtocolsLocksDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsLocksDataSource() datasource.DataSource {
+	return &ProtocolsLocksDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_locks_data_source",
+		},
+	}
+}
+
+// ProtocolsLocksDataSource defines the data source implementation.
+type ProtocolsLocksDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsLocksDataSourceModel describes the data source data model.
+type ProtocolsLocksDataSourceModel struct {
+	CxProfileName types.String                        `tfsdk:"cx_profile_name"`
+	Locks         []ProtocolsLockDataSourceModel      `tfsdk:"locks"`
+	Filter        *ProtocolsLockDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsLockDataSourceModel describes the data model for a lock.
+type ProtocolsLockDataSourceModel struct {
+	SVMName       types.String `tfsdk:"svm_name"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	Path          types.String `tfsdk:"path"`
+	ClientAddress types.String `tfsdk:"client_address"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Type          types.String `tfsdk:"type"`
+	State         types.String `tfsdk:"state"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// ProtocolsLockDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsLockDataSourceFilterModel struct {
+	SVMName       types.String `tfsdk:"svm_name"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	Path          types.String `tfsdk:"path"`
+	ClientAddress types.String `tfsdk:"client_address"`
+	Protocol      types.String `tfsdk:"protocol"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsLocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsLocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Current CIFS and NFS locks data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Lock svm name",
+						Optional:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						MarkdownDescription: "Lock volume name",
+						Optional:            true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path of the locked file, wildcards are supported, eg /vol1/dir/*",
+						Optional:            true,
+					},
+					"client_address": schema.StringAttribute{
+						MarkdownDescription: "IP address of the client holding the lock",
+						Optional:            true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "Lock protocol, eg cifs, nlm, nfsv4, nfsv4.1",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"locks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Lock svm name",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Lock volume name",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the locked file",
+							Computed:            true,
+						},
+						"client_address": schema.StringAttribute{
+							MarkdownDescription: "IP address of the client holding the lock",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Lock protocol",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Lock type, eg byte_range, share_level, op_lock, delegation",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Lock state, eg granted",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Lock UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsLocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsLocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsLocksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsLockDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsLockDataSourceFilterModel{
+			SVMName:       data.Filter.SVMName.ValueString(),
+			VolumeName:    data.Filter.VolumeName.ValueString(),
+			Path:          data.Filter.Path.ValueString(),
+			ClientAddress: data.Filter.ClientAddress.ValueString(),
+			Protocol:      data.Filter.Protocol.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListProtocolsLocks(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListProtocolsLocks
+		return
+	}
+
+	data.Locks = make([]ProtocolsLockDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.Locks[index] = ProtocolsLockDataSourceModel{
+			SVMName:       types.StringValue(record.SVM.Name),
+			VolumeName:    types.StringValue(record.Volume.Name),
+			Path:          types.StringValue(record.Path),
+			ClientAddress: types.StringValue(record.ClientAddress),
+			Protocol:      types.StringValue(record.Protocol),
+			Type:          types.StringValue(record.Type),
+			State:         types.StringValue(record.State),
+			ID:            types.StringValue(record.UUID),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
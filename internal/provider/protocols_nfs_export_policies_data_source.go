@@ -58,7 +58,7 @@ func (d *ExportPoliciesDataSource) Schema(ctx context.Context, req datasource.Sc
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
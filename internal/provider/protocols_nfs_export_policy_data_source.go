@@ -33,10 +33,22 @@ type ExportPolicyDataSource struct {
 
 // ExportPolicyDataSourceModel describes the source data model.
 type ExportPolicyDataSourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	SVMName       types.String `tfsdk:"svm_name"`
-	Name          types.String `tfsdk:"name"`
-	ID            types.String `tfsdk:"id"`
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	SVMName       types.String                       `tfsdk:"svm_name"`
+	Name          types.String                       `tfsdk:"name"`
+	ID            types.String                       `tfsdk:"id"`
+	Rules         []ExportPolicyRuleSummaryDataModel `tfsdk:"rules"`
+}
+
+// ExportPolicyRuleSummaryDataModel describes the rules of an export policy.
+type ExportPolicyRuleSummaryDataModel struct {
+	Index         types.Int64    `tfsdk:"index"`
+	ClientsMatch  []types.String `tfsdk:"clients_match"`
+	RoRule        []types.String `tfsdk:"ro_rule"`
+	RwRule        []types.String `tfsdk:"rw_rule"`
+	Protocols     []types.String `tfsdk:"protocols"`
+	Superuser     []types.String `tfsdk:"superuser"`
+	AnonymousUser types.String   `tfsdk:"anonymous_user"`
 }
 
 // ExportPolicyDataSourceFilterModel describes the data source data model for queries.
@@ -54,12 +66,12 @@ func (d *ExportPolicyDataSource) Metadata(ctx context.Context, req datasource.Me
 func (d *ExportPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Export policy rule resource",
+		MarkdownDescription: "Export policy data source, with the rules of the policy",
 
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "Name of the svm to use",
@@ -73,6 +85,47 @@ func (d *ExportPolicyDataSource) Schema(ctx context.Context, req datasource.Sche
 				Computed:            true,
 				MarkdownDescription: "Export policy identifier",
 			},
+			"rules": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Export policy rules, ordered by index",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "rule index",
+						},
+						"clients_match": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "List of Client Match Hostnames, IP Addresses, Netgroups, or Domains",
+						},
+						"ro_rule": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "RO Access Rule",
+						},
+						"rw_rule": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "RW Access Rule",
+						},
+						"protocols": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Access Protocol",
+						},
+						"superuser": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Superuser Security Types",
+						},
+						"anonymous_user": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "User ID To Which Anonymous Users Are Mapped",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -126,6 +179,37 @@ func (d *ExportPolicyDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 	data.ID = types.StringValue(strconv.Itoa(exportPolicy.ID))
 
+	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return
+	}
+	if cluster == nil {
+		errorHandler.MakeAndReportError("No cluster found", "cluster not found")
+		return
+	}
+	rules, err := interfaces.GetListExportPolicyRules(errorHandler, *client, data.ID.ValueString(), nil, cluster.Version)
+	if err != nil {
+		// error reporting done inside GetListExportPolicyRules
+		return
+	}
+	data.Rules = make([]ExportPolicyRuleSummaryDataModel, len(rules))
+	for index, rule := range rules {
+		clientsMatch := make([]string, len(rule.ClientsMatch))
+		for i, clientMatch := range rule.ClientsMatch {
+			clientsMatch[i] = clientMatch.Match
+		}
+		data.Rules[index] = ExportPolicyRuleSummaryDataModel{
+			Index:         types.Int64Value(rule.Index),
+			ClientsMatch:  flattenTypesStringList(clientsMatch),
+			RoRule:        flattenTypesStringList(rule.RoRule),
+			RwRule:        flattenTypesStringList(rule.RwRule),
+			Protocols:     flattenTypesStringList(rule.Protocols),
+			Superuser:     flattenTypesStringList(rule.Superuser),
+			AnonymousUser: types.StringValue(rule.AnonymousUser),
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -38,10 +38,11 @@ type ExportPolicyResource struct {
 
 // ExportPolicyResourceModel describes the resource data model.
 type ExportPolicyResourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	Name          types.String `tfsdk:"name"`
-	SVMName       types.String `tfsdk:"svm_name"`
-	ID            types.String `tfsdk:"id"`
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	Name            types.String `tfsdk:"name"`
+	SVMName         types.String `tfsdk:"svm_name"`
+	RetainOnDestroy types.Bool   `tfsdk:"retain_on_destroy"`
+	ID              types.String `tfsdk:"id"`
 }
 
 // Metadata returns the resource type name.
@@ -58,7 +59,7 @@ func (r *ExportPolicyResource) Schema(ctx context.Context, req resource.SchemaRe
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the export policy to manage",
@@ -68,6 +69,10 @@ func (r *ExportPolicyResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "Name of the svm to use",
 				Required:            true,
 			},
+			"retain_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When true, destroying the resource only removes it from the Terraform state, and the export policy is kept on the cluster. Defaults to false",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Export policy identifier",
@@ -179,6 +184,7 @@ func (r *ExportPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		}
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "protocols/nfs/export-policies/"+data.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -199,6 +205,7 @@ func (r *ExportPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	if err != nil {
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	var request interfaces.ExportpolicyResourceModel
 	request.Name = data.Name.ValueString()
@@ -208,6 +215,7 @@ func (r *ExportPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "protocols/nfs/export-policies/"+data.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -226,6 +234,11 @@ func (r *ExportPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if data.RetainOnDestroy.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("retain_on_destroy is set, export policy %s (%s) removed from state only", data.Name.ValueString(), data.ID.ValueString()))
+		return
+	}
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
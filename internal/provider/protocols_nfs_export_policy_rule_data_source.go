@@ -64,7 +64,7 @@ func (d *ExportPolicyRuleDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "Name of the svm to use",
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &ExportPolicyRuleFunction{}
+
+// exportPolicyRuleTemplates describes the access granted by each function
+var exportPolicyRuleTemplates = map[string]struct {
+	summary   string
+	roRule    []string
+	rwRule    []string
+	superuser []string
+}{
+	"nfs_rw": {summary: "Read-write NFS export policy rule settings for a list of CIDRs", roRule: []string{"sys"}, rwRule: []string{"sys"}, superuser: []string{"none"}},
+	"nfs_ro": {summary: "Read-only NFS export policy rule settings for a list of CIDRs", roRule: []string{"sys"}, rwRule: []string{"never"}, superuser: []string{"none"}},
+}
+
+// ExportPolicyRuleFunctionModel describes the object returned by the nfs_rw and nfs_ro functions.
+type ExportPolicyRuleFunctionModel struct {
+	ClientsMatch []string `tfsdk:"clients_match"`
+	Protocols    []string `tfsdk:"protocols"`
+	RoRule       []string `tfsdk:"ro_rule"`
+	RwRule       []string `tfsdk:"rw_rule"`
+	Superuser    []string `tfsdk:"superuser"`
+}
+
+// ExportPolicyRuleFunction defines the nfs_rw and nfs_ro functions.
+type ExportPolicyRuleFunction struct {
+	name string
+}
+
+// NewNFSRWFunction is a helper function to simplify the provider implementation.
+func NewNFSRWFunction() function.Function {
+	return &ExportPolicyRuleFunction{name: "nfs_rw"}
+}
+
+// NewNFSROFunction is a helper function to simplify the provider implementation.
+func NewNFSROFunction() function.Function {
+	return &ExportPolicyRuleFunction{name: "nfs_ro"}
+}
+
+// Metadata returns the function name.
+func (f *ExportPolicyRuleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ExportPolicyRuleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	stringList := types.ListType{ElemType: types.StringType}
+	resp.Definition = function.Definition{
+		Summary: exportPolicyRuleTemplates[f.name].summary,
+		MarkdownDescription: exportPolicyRuleTemplates[f.name].summary + ", computed locally without connecting to ONTAP. " +
+			"The attributes of the returned object match the ones of netapp-ontap_protocols_nfs_export_policy_rule_resource",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				MarkdownDescription: "Client networks in CIDR notation, eg 10.0.0.0/16. IP addresses are accepted as host networks",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"clients_match": stringList,
+				"protocols":     stringList,
+				"ro_rule":       stringList,
+				"rw_rule":       stringList,
+				"superuser":     stringList,
+			},
+		},
+	}
+}
+
+// Run computes the rule settings from the CIDRs.
+func (f *ExportPolicyRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+	clients, err := expandExportPolicyRuleClients(cidrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	template := exportPolicyRuleTemplates[f.name]
+	result := ExportPolicyRuleFunctionModel{
+		ClientsMatch: clients,
+		Protocols:    []string{"nfs3", "nfs4"},
+		RoRule:       template.roRule,
+		RwRule:       template.rwRule,
+		Superuser:    template.superuser,
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// expandExportPolicyRuleClients normalizes the CIDRs to their network address, and drops duplicates and networks contained in another one.
+// The order of the remaining networks is preserved.
+func expandExportPolicyRuleClients(cidrs []string) ([]string, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("%s is not a CIDR or an IP address", cidr)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+			network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		}
+		networks = append(networks, network)
+	}
+	clients := []string{}
+	for index, network := range networks {
+		contained := false
+		for other, otherNetwork := range networks {
+			if other == index {
+				continue
+			}
+			ones, _ := network.Mask.Size()
+			otherOnes, _ := otherNetwork.Mask.Size()
+			// contained in a larger network, or a duplicate of an earlier one
+			if otherNetwork.Contains(network.IP) && (otherOnes < ones || (otherOnes == ones && other < index)) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			clients = append(clients, network.String())
+		}
+	}
+	return clients, nil
+}
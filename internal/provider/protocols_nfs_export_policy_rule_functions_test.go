@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandExportPolicyRuleClients(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "normalized", cidrs: []string{"10.1.2.3/16"}, want: []string{"10.1.0.0/16"}},
+		{name: "ip_address", cidrs: []string{"10.1.2.3", "fd00::1"}, want: []string{"10.1.2.3/32", "fd00::1/128"}},
+		{name: "duplicates", cidrs: []string{"10.1.0.0/16", "10.2.0.0/16", "10.1.0.0/16"}, want: []string{"10.1.0.0/16", "10.2.0.0/16"}},
+		{name: "contained", cidrs: []string{"10.1.2.0/24", "10.0.0.0/8", "192.168.1.0/24"}, want: []string{"10.0.0.0/8", "192.168.1.0/24"}},
+		{name: "empty", cidrs: []string{}, want: []string{}},
+		{name: "invalid", cidrs: []string{"10.1.0.0/33"}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandExportPolicyRuleClients(tt.cidrs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expandExportPolicyRuleClients() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandExportPolicyRuleClients() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportPolicyRuleFunctionRun(t *testing.T) {
+	stringList := func(values ...string) attr.Value {
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	attributeTypes := map[string]attr.Type{
+		"clients_match": types.ListType{ElemType: types.StringType},
+		"protocols":     types.ListType{ElemType: types.StringType},
+		"ro_rule":       types.ListType{ElemType: types.StringType},
+		"rw_rule":       types.ListType{ElemType: types.StringType},
+		"superuser":     types.ListType{ElemType: types.StringType},
+	}
+	tests := []struct {
+		name     string
+		function function.Function
+		cidrs    []string
+		want     attr.Value
+		wantErr  bool
+	}{
+		{name: "nfs_rw", function: NewNFSRWFunction(), cidrs: []string{"10.1.2.0/24", "10.0.0.0/8"}, want: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"clients_match": stringList("10.0.0.0/8"),
+			"protocols":     stringList("nfs3", "nfs4"),
+			"ro_rule":       stringList("sys"),
+			"rw_rule":       stringList("sys"),
+			"superuser":     stringList("none"),
+		})},
+		{name: "nfs_ro", function: NewNFSROFunction(), cidrs: []string{"192.168.1.1"}, want: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"clients_match": stringList("192.168.1.1/32"),
+			"protocols":     stringList("nfs3", "nfs4"),
+			"ro_rule":       stringList("sys"),
+			"rw_rule":       stringList("never"),
+			"superuser":     stringList("none"),
+		})},
+		{name: "invalid", function: NewNFSRWFunction(), cidrs: []string{"10.1.0.0/33"}, want: types.ObjectUnknown(attributeTypes), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition := function.DefinitionResponse{}
+			tt.function.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+			cidrs := []attr.Value{}
+			for _, cidr := range tt.cidrs {
+				cidrs = append(cidrs, types.StringValue(cidr))
+			}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.ListValueMust(types.StringType, cidrs)}),
+			}
+			result, _ := definition.Definition.Return.NewResultData(context.Background())
+			resp := function.RunResponse{
+				Result: result,
+			}
+			tt.function.Run(context.Background(), req, &resp)
+			if (resp.Error != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+				return
+			}
+			if !resp.Result.Value().Equal(tt.want) {
+				t.Errorf("Run() = %v, want %v", resp.Result.Value(), tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
@@ -27,6 +31,11 @@ var _ resource.Resource = &ExportPolicyRuleResource{}
 
 var _ resource.ResourceWithImportState = &ExportPolicyRuleResource{}
 
+var _ resource.ResourceWithModifyPlan = &ExportPolicyRuleResource{}
+
+// exportPolicyRuleSecurityFlavors are the security types accepted by ro_rule, rw_rule, and superuser
+var exportPolicyRuleSecurityFlavors = []string{"any", "none", "never", "krb5", "krb5i", "krb5p", "ntlm", "sys"}
+
 // NewExportPolicyRuleResource is a helper function to simplify the provider implementation.
 func NewExportPolicyRuleResource() resource.Resource {
 	return &ExportPolicyRuleResource{
@@ -75,7 +84,7 @@ func (r *ExportPolicyRuleResource) Schema(ctx context.Context, req resource.Sche
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "Name of the svm to use",
@@ -94,13 +103,19 @@ func (r *ExportPolicyRuleResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"ro_rule": schema.SetAttribute{
 				Required:            true,
-				MarkdownDescription: "RO Access Rule",
+				MarkdownDescription: "RO Access Rule, the security types allowed for read-only access: any, none, never, krb5, krb5i, krb5p, ntlm, or sys",
 				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exportPolicyRuleSecurityFlavors...)),
+				},
 			},
 			"rw_rule": schema.SetAttribute{
 				Required:            true,
-				MarkdownDescription: "RW Access Rule",
+				MarkdownDescription: "RW Access Rule, the security types allowed for read-write access: any, none, never, krb5, krb5i, krb5p, ntlm, or sys",
 				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exportPolicyRuleSecurityFlavors...)),
+				},
 			},
 			"clients_match": schema.SetAttribute{
 				Required:            true,
@@ -126,12 +141,15 @@ func (r *ExportPolicyRuleResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"superuser": schema.SetAttribute{
-				MarkdownDescription: "Superuser Security Types",
+				MarkdownDescription: "Superuser Security Types, the security types for which root access is granted: any, none, never, krb5, krb5i, krb5p, ntlm, or sys",
 				Optional:            true,
 				Computed:            true,
 				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
 				ElementType:         types.StringType,
-				PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exportPolicyRuleSecurityFlavors...)),
+				},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
 			},
 			"allow_device_creation": schema.BoolAttribute{
 				MarkdownDescription: "Allow Creation of Devices",
@@ -143,19 +161,25 @@ func (r *ExportPolicyRuleResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"ntfs_unix_security": schema.StringAttribute{
-				MarkdownDescription: "NTFS export UNIX security options",
+				MarkdownDescription: "NTFS export UNIX security options, fail to reject UNIX permission changes on NTFS volumes, or ignore to silently accept them. Requires ONTAP 9.11 or later to be read back",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("fail"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("fail", "ignore"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"chown_mode": schema.StringAttribute{
-				MarkdownDescription: "Specifies who is authorized to change the ownership mode of a file",
+				MarkdownDescription: "Specifies who is authorized to change the ownership mode of a file, restricted to the superuser, or unrestricted",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("restricted"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("restricted", "unrestricted"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -238,6 +262,25 @@ func (r *ExportPolicyRuleResource) Configure(ctx context.Context, req resource.C
 	r.config.providerConfig = config
 }
 
+// ModifyPlan reports the attributes that are not supported by the ONTAP version of the cluster, before any change is applied.
+func (r *ExportPolicyRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to check on destroy
+		return
+	}
+	var config *ExportPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+	attributes := map[string]string{}
+	if !config.NtfsUnixSecurity.IsNull() {
+		attributes["ntfs_unix_security"] = "ntfs_unix_security"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkVersionedAttributes(errorHandler, r.config, config.CxProfileName, "protocols/nfs/export-policies/rules", attributes)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *ExportPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ExportPolicyRuleResourceModel
@@ -354,6 +397,7 @@ func (r *ExportPolicyRuleResource) Read(ctx context.Context, req resource.ReadRe
 			return
 		}
 		exportPolicyID = strconv.Itoa(exportPolicy.ID)
+		data.ExportPolicyID = types.StringValue(exportPolicyID)
 	} else {
 		exportPolicyID = data.ExportPolicyID.ValueString()
 	}
@@ -407,7 +451,11 @@ func (r *ExportPolicyRuleResource) Read(ctx context.Context, req resource.ReadRe
 	if !data.AnonymousUser.IsNull() {
 		data.AnonymousUser = types.StringValue(restInfo.AnonymousUser)
 	}
+	if data.ID.IsNull() {
+		data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s_%d", data.CxProfileName.ValueString(), data.SVMName.ValueString(), data.ExportPolicyName.ValueString(), data.Index.ValueInt64()))
+	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, fmt.Sprintf("protocols/nfs/export-policies/%s/rules/%d", exportPolicyID, data.Index.ValueInt64()))...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -428,6 +476,7 @@ func (r *ExportPolicyRuleResource) Update(ctx context.Context, req resource.Upda
 	if err != nil {
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	var exportPolicyID string
 	if data.ExportPolicyID.IsNull() {
@@ -489,6 +538,7 @@ func (r *ExportPolicyRuleResource) Update(ctx context.Context, req resource.Upda
 	}
 	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s_%d", data.CxProfileName.ValueString(), data.SVMName.ValueString(), data.ExportPolicyName.ValueString(), data.Index.ValueInt64()))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, fmt.Sprintf("protocols/nfs/export-policies/%s/rules/%d", exportPolicyID, data.Index.ValueInt64()))...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -537,5 +587,26 @@ func (r *ExportPolicyRuleResource) Delete(ctx context.Context, req resource.Dele
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *ExportPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: export_policy_name,index,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	index, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected index to be an integer. Got: %q", idParts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("export_policy_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
 }
@@ -58,7 +58,7 @@ func (d *ProtocolsNFSExportPolicyRulesDataSource) Schema(ctx context.Context, re
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				Required:            true,
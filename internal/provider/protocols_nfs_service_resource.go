@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -35,6 +34,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ProtocolsNfsServiceResource{}
 var _ resource.ResourceWithImportState = &ProtocolsNfsServiceResource{}
+var _ resource.ResourceWithModifyPlan = &ProtocolsNfsServiceResource{}
 
 // NewProtocolsNfsServiceResource is a helper function to simplify the provider implementation.
 func NewProtocolsNfsServiceResource() resource.Resource {
@@ -133,7 +133,7 @@ func (r *ProtocolsNfsServiceResource) Schema(ctx context.Context, req resource.S
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface svm name",
@@ -444,6 +444,50 @@ func (r *ProtocolsNfsServiceResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+// versionedAttributes returns the attributes set in the configuration that require a recent ONTAP version, mapped to their API field
+func (data *ProtocolsNfsServiceResourceModel) versionedAttributes() map[string]string {
+	attributes := map[string]string{}
+	add := func(attribute string, value attr.Value) {
+		if !value.IsNull() {
+			attributes[attribute] = attribute
+		}
+	}
+	if data.Root != nil {
+		add("root.ignore_nt_acl", data.Root.IgnoreNtACL)
+		add("root.skip_write_permission_check", data.Root.SkipWritePermissionCheck)
+	}
+	if data.Security != nil {
+		add("security.chown_mode", data.Security.ChownMode)
+		add("security.nt_acl_display_permission", data.Security.NtACLDisplayPermission)
+		add("security.ntfs_unix_security", data.Security.NtfsUnixSecurity)
+		add("security.rpcsec_context_idle", data.Security.RpcsecContextIdel)
+	}
+	if data.Transport != nil {
+		add("transport.tcp_max_transfer_size", data.Transport.TCPMaxXferSize)
+	}
+	if data.Windows != nil {
+		add("windows.default_user", data.Windows.DefaultUser)
+		add("windows.map_unknown_uid_to_default_user", data.Windows.MapUnknownUIDToDefaultUser)
+		add("windows.v3_ms_dos_client_enabled", data.Windows.V3MsDosClientEnabled)
+	}
+	return attributes
+}
+
+// ModifyPlan reports the attributes that are not supported by the ONTAP version of the cluster, before any change is applied.
+func (r *ProtocolsNfsServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to check on destroy
+		return
+	}
+	var config *ProtocolsNfsServiceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkVersionedAttributes(errorHandler, r.config, config.CxProfileName, "protocols/nfs/services", config.versionedAttributes())
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *ProtocolsNfsServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -577,7 +621,8 @@ func (r *ProtocolsNfsServiceResource) Create(ctx context.Context, req resource.C
 		// error reporting done inside GetCluster
 		return
 	}
-	clusterVersion := strconv.Itoa(cluster.Version.Generation) + "." + strconv.Itoa(cluster.Version.Major)
+	// the root, security, windows, and transport.tcp_max_transfer_size options share the same minimum version
+	versionedFieldsSupported := interfaces.SupportsField("protocols/nfs/services", cluster.Version, "root.ignore_nt_acl")
 	var errors []string
 
 	if !data.Enabled.IsNull() {
@@ -623,36 +668,36 @@ func (r *ProtocolsNfsServiceResource) Create(ctx context.Context, req resource.C
 		}
 	}
 	if data.Root != nil {
-		if !data.Root.IgnoreNtACL.IsNull() && clusterVersion > "9.10" {
+		if !data.Root.IgnoreNtACL.IsNull() && versionedFieldsSupported {
 			body.Root.IgnoreNtACL = data.Root.IgnoreNtACL.ValueBool()
-		} else if !data.Root.IgnoreNtACL.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Root.IgnoreNtACL.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "root.ignore_nt_acl")
 		}
-		if !data.Root.SkipWritePermissionCheck.IsNull() && clusterVersion > "9.10" {
+		if !data.Root.SkipWritePermissionCheck.IsNull() && versionedFieldsSupported {
 			body.Root.SkipWritePermissionCheck = data.Root.SkipWritePermissionCheck.ValueBool()
-		} else if !data.Root.SkipWritePermissionCheck.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Root.SkipWritePermissionCheck.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "root.skip_write_permission_check")
 		}
 	}
 	if data.Security != nil {
-		if !data.Security.ChownMode.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.ChownMode.IsNull() && versionedFieldsSupported {
 			body.Security.ChownMode = data.Security.ChownMode.ValueString()
-		} else if !data.Security.ChownMode.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.ChownMode.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.chown_mode")
 		}
-		if !data.Security.NtACLDisplayPermission.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.NtACLDisplayPermission.IsNull() && versionedFieldsSupported {
 			body.Security.NtACLDisplayPermission = data.Security.NtACLDisplayPermission.ValueBool()
-		} else if !data.Security.NtACLDisplayPermission.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.NtACLDisplayPermission.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.nt_acl_display_permission")
 		}
-		if !data.Security.NtfsUnixSecurity.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.NtfsUnixSecurity.IsNull() && versionedFieldsSupported {
 			body.Security.NtfsUnixSecurity = data.Security.NtfsUnixSecurity.ValueString()
-		} else if !data.Security.NtfsUnixSecurity.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.NtfsUnixSecurity.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.ntfs_unix_security")
 		}
-		if !data.Security.RpcsecContextIdel.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.RpcsecContextIdel.IsNull() && versionedFieldsSupported {
 			body.Security.RpcsecContextIdel = data.Security.RpcsecContextIdel.ValueInt64()
-		} else if !data.Security.RpcsecContextIdel.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.RpcsecContextIdel.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.rpcsec_context_idle")
 		}
 	}
@@ -663,9 +708,9 @@ func (r *ProtocolsNfsServiceResource) Create(ctx context.Context, req resource.C
 		if !data.Transport.TCPEnabled.IsNull() {
 			body.Transport.TCP = data.Transport.TCPEnabled.ValueBool()
 		}
-		if !data.Transport.TCPMaxXferSize.IsNull() && clusterVersion > "9.10" {
+		if !data.Transport.TCPMaxXferSize.IsNull() && versionedFieldsSupported {
 			body.Transport.TCPMaxXferSize = data.Transport.TCPMaxXferSize.ValueInt64()
-		} else if !data.Transport.TCPMaxXferSize.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Transport.TCPMaxXferSize.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "transport.tcp_max_transfer_size")
 		}
 		if !data.Transport.UDPEnabled.IsNull() {
@@ -676,27 +721,27 @@ func (r *ProtocolsNfsServiceResource) Create(ctx context.Context, req resource.C
 		body.VstorageEnabled = data.VstorageEnabled.ValueBool()
 	}
 	if data.Windows != nil {
-		if !data.Windows.DefaultUser.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.DefaultUser.IsNull() && versionedFieldsSupported {
 			body.Windows.DefaultUser = data.Windows.DefaultUser.ValueString()
-		} else if !data.Windows.DefaultUser.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.DefaultUser.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.default_user")
 		}
-		if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && versionedFieldsSupported {
 			body.Windows.MapUnknownUIDToDefaultUser = data.Windows.MapUnknownUIDToDefaultUser.ValueBool()
-		} else if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.map_unknown_uid_to_default_user")
 		}
-		if !data.Windows.V3MsDosClientEnabled.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.V3MsDosClientEnabled.IsNull() && versionedFieldsSupported {
 			body.Windows.V3MsDosClientEnabled = data.Windows.V3MsDosClientEnabled.ValueBool()
-		} else if !data.Windows.V3MsDosClientEnabled.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.V3MsDosClientEnabled.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.v3_ms_dos_client_enabled")
 		}
 	}
 	body.SVM.Name = data.SVMName.ValueString()
 	data.ID = data.SVMName
 	if len(errors) > 0 {
-		errorsString := strings.Join(errors, ", ")
-		tflog.Error(ctx, fmt.Sprintf("The following Variables are only support with ONTAP 9.11 or higher: %#v", errorsString))
+		// error reporting done inside CheckFieldsSupported
+		interfaces.CheckFieldsSupported(errorHandler, "protocols/nfs/services", cluster.Version, data.versionedAttributes())
 		return
 	}
 
@@ -756,7 +801,8 @@ func (r *ProtocolsNfsServiceResource) Update(ctx context.Context, req resource.U
 		errorHandler.MakeAndReportError("No cluster found", fmt.Sprintf("Cluster not found."))
 		return
 	}
-	clusterVersion := strconv.Itoa(cluster.Version.Generation) + "." + strconv.Itoa(cluster.Version.Major)
+	// the root, security, windows, and transport.tcp_max_transfer_size options share the same minimum version
+	versionedFieldsSupported := interfaces.SupportsField("protocols/nfs/services", cluster.Version, "root.ignore_nt_acl")
 	var request interfaces.ProtocolsNfsServiceGetDataModelONTAP
 	var errors []string
 	if !data.Enabled.IsNull() {
@@ -802,36 +848,36 @@ func (r *ProtocolsNfsServiceResource) Update(ctx context.Context, req resource.U
 		}
 	}
 	if data.Root != nil {
-		if !data.Root.IgnoreNtACL.IsNull() && clusterVersion > "9.10" {
+		if !data.Root.IgnoreNtACL.IsNull() && versionedFieldsSupported {
 			request.Root.IgnoreNtACL = data.Root.IgnoreNtACL.ValueBool()
-		} else if !data.Root.IgnoreNtACL.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Root.IgnoreNtACL.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "root.ignore_nt_acl")
 		}
-		if !data.Root.SkipWritePermissionCheck.IsNull() && clusterVersion > "9.10" {
+		if !data.Root.SkipWritePermissionCheck.IsNull() && versionedFieldsSupported {
 			request.Root.SkipWritePermissionCheck = data.Root.SkipWritePermissionCheck.ValueBool()
-		} else if !data.Root.SkipWritePermissionCheck.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Root.SkipWritePermissionCheck.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "root.skip_write_permission_check")
 		}
 	}
 	if data.Security != nil {
-		if !data.Security.ChownMode.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.ChownMode.IsNull() && versionedFieldsSupported {
 			request.Security.ChownMode = data.Security.ChownMode.ValueString()
-		} else if !data.Security.ChownMode.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.ChownMode.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.chown_mode")
 		}
-		if !data.Security.NtACLDisplayPermission.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.NtACLDisplayPermission.IsNull() && versionedFieldsSupported {
 			request.Security.NtACLDisplayPermission = data.Security.NtACLDisplayPermission.ValueBool()
-		} else if !data.Security.NtACLDisplayPermission.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.NtACLDisplayPermission.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.nt_acl_display_permission")
 		}
-		if !data.Security.NtfsUnixSecurity.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.NtfsUnixSecurity.IsNull() && versionedFieldsSupported {
 			request.Security.NtfsUnixSecurity = data.Security.NtfsUnixSecurity.ValueString()
-		} else if !data.Security.NtfsUnixSecurity.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.NtfsUnixSecurity.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.ntfs_unix_security")
 		}
-		if !data.Security.RpcsecContextIdel.IsNull() && clusterVersion > "9.10" {
+		if !data.Security.RpcsecContextIdel.IsNull() && versionedFieldsSupported {
 			request.Security.RpcsecContextIdel = data.Security.RpcsecContextIdel.ValueInt64()
-		} else if !data.Security.RpcsecContextIdel.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Security.RpcsecContextIdel.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "security.rpcsec_context_idle")
 		}
 	}
@@ -842,9 +888,9 @@ func (r *ProtocolsNfsServiceResource) Update(ctx context.Context, req resource.U
 		if !data.Transport.TCPEnabled.IsNull() {
 			request.Transport.TCP = data.Transport.TCPEnabled.ValueBool()
 		}
-		if !data.Transport.TCPMaxXferSize.IsNull() && clusterVersion > "9.10" {
+		if !data.Transport.TCPMaxXferSize.IsNull() && versionedFieldsSupported {
 			request.Transport.TCPMaxXferSize = data.Transport.TCPMaxXferSize.ValueInt64()
-		} else if !data.Transport.TCPMaxXferSize.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Transport.TCPMaxXferSize.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "transport.tcp_max_transfer_size")
 		}
 		if !data.Transport.UDPEnabled.IsNull() {
@@ -855,27 +901,27 @@ func (r *ProtocolsNfsServiceResource) Update(ctx context.Context, req resource.U
 		request.VstorageEnabled = data.VstorageEnabled.ValueBool()
 	}
 	if data.Windows != nil {
-		if !data.Windows.DefaultUser.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.DefaultUser.IsNull() && versionedFieldsSupported {
 			request.Windows.DefaultUser = data.Windows.DefaultUser.ValueString()
-		} else if !data.Windows.DefaultUser.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.DefaultUser.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.default_user")
 		}
-		if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && versionedFieldsSupported {
 			request.Windows.MapUnknownUIDToDefaultUser = data.Windows.MapUnknownUIDToDefaultUser.ValueBool()
-		} else if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.MapUnknownUIDToDefaultUser.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.map_unknown_uid_to_default_user")
 		}
-		if !data.Windows.V3MsDosClientEnabled.IsNull() && clusterVersion > "9.10" {
+		if !data.Windows.V3MsDosClientEnabled.IsNull() && versionedFieldsSupported {
 			request.Windows.V3MsDosClientEnabled = data.Windows.V3MsDosClientEnabled.ValueBool()
-		} else if !data.Windows.V3MsDosClientEnabled.IsNull() && clusterVersion <= "9.10" {
+		} else if !data.Windows.V3MsDosClientEnabled.IsNull() && !versionedFieldsSupported {
 			errors = append(errors, "windows.v3_ms_dos_client_enabled")
 		}
 	}
 	request.SVM.Name = data.SVMName.ValueString()
 	data.ID = data.SVMName
 	if len(errors) > 0 {
-		errorsString := strings.Join(errors, ", ")
-		tflog.Error(ctx, fmt.Sprintf("The following Variables are only support with ONTAP 9.11 or higher: %#v", errorsString))
+		// error reporting done inside CheckFieldsSupported
+		interfaces.CheckFieldsSupported(errorHandler, "protocols/nfs/services", cluster.Version, data.versionedAttributes())
 		return
 	}
 
@@ -927,7 +973,7 @@ func (r *ProtocolsNfsServiceResource) ImportState(ctx context.Context, req resou
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
 		)
 		return
 	}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsNvmeSubsystemHostResource{}
+var _ resource.ResourceWithImportState = &ProtocolsNvmeSubsystemHostResource{}
+
+// NewProtocolsNvmeSubsystemHostResource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemHostResource() resource.Resource {
+	return &ProtocolsNvmeSubsystemHostResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_nvme_subsystem_host_resource",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemHostResource defines the resource implementation.
+type ProtocolsNvmeSubsystemHostResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemHostResourceModel describes the resource data model.
+type ProtocolsNvmeSubsystemHostResourceModel struct {
+	CxProfileName types.String                               `tfsdk:"cx_profile_name"`
+	SVMName       types.String                               `tfsdk:"svm_name"`
+	SubsystemName types.String                               `tfsdk:"subsystem_name"`
+	NQN           types.String                               `tfsdk:"nqn"`
+	DhHmacChap    *ProtocolsNvmeSubsystemHostDhHmacChapModel `tfsdk:"dh_hmac_chap"`
+	ID            types.String                               `tfsdk:"id"`
+}
+
+// ProtocolsNvmeSubsystemHostDhHmacChapModel describes the DH-HMAC-CHAP authentication settings of a host.
+type ProtocolsNvmeSubsystemHostDhHmacChapModel struct {
+	HostSecretKey       types.String `tfsdk:"host_secret_key"`
+	ControllerSecretKey types.String `tfsdk:"controller_secret_key"`
+	HashFunction        types.String `tfsdk:"hash_function"`
+	GroupSize           types.String `tfsdk:"group_size"`
+	Mode                types.String `tfsdk:"mode"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsNvmeSubsystemHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsNvmeSubsystemHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NVMe subsystem host resource, to allow a host NQN to access the namespaces mapped to a NVMe subsystem",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subsystem_name": schema.StringAttribute{
+				MarkdownDescription: "NVMe subsystem name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nqn": schema.StringAttribute{
+				MarkdownDescription: "NVMe qualified name of the host, eg nqn.2014-08.org.nvmexpress:uuid:<uuid>",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dh_hmac_chap": schema.SingleNestedAttribute{
+				MarkdownDescription: "In-band DH-HMAC-CHAP authentication of the host, requires ONTAP 9.12 or later. The secret keys are not returned by ONTAP, changes made outside of Terraform are not detected",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"host_secret_key": schema.StringAttribute{
+						MarkdownDescription: "Secret key used by the host to authenticate, in DHHC-1 format, eg as generated by nvme gen-dhchap-key",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"controller_secret_key": schema.StringAttribute{
+						MarkdownDescription: "Secret key used by the controller to authenticate to the host, for bidirectional authentication",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"hash_function": schema.StringAttribute{
+						MarkdownDescription: "Hash function, sha_256 or sha_512",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("sha_256", "sha_512"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"group_size": schema.StringAttribute{
+						MarkdownDescription: "Diffie-Hellman group size, none to disable the key exchange",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "2048_bit", "3072_bit", "4096_bit", "6144_bit", "8192_bit"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "Authentication mode, unidirectional or bidirectional",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "NVMe subsystem host identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsNvmeSubsystemHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// setHost sets the computed attributes, the secret keys are kept from the plan or state
+func (data *ProtocolsNvmeSubsystemHostResourceModel) setHost(restInfo *interfaces.NvmeSubsystemHostGetDataModelONTAP) {
+	if data.DhHmacChap != nil {
+		data.DhHmacChap.HashFunction = types.StringValue(restInfo.DhHmacChap.HashFunction)
+		data.DhHmacChap.GroupSize = types.StringValue(restInfo.DhHmacChap.GroupSize)
+		data.DhHmacChap.Mode = types.StringValue(restInfo.DhHmacChap.Mode)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s", data.SVMName.ValueString(), data.SubsystemName.ValueString(), data.NQN.ValueString()))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsNvmeSubsystemHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemHostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	subsystem, err := interfaces.GetNvmeSubsystemByName(errorHandler, *client, data.SubsystemName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetNvmeSubsystemHost(errorHandler, *client, subsystem.UUID, data.NQN.ValueString())
+	if err != nil {
+		// error reporting done inside GetNvmeSubsystemHost
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("host %s not found in subsystem %s, removing it from state", data.NQN.ValueString(), data.SubsystemName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.setHost(restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create adds the host to the subsystem.
+func (r *ProtocolsNvmeSubsystemHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsNvmeSubsystemHostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	subsystem, err := interfaces.GetNvmeSubsystemByName(errorHandler, *client, data.SubsystemName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	body := interfaces.NvmeSubsystemHostResourceBodyDataModelONTAP{NQN: data.NQN.ValueString()}
+	if data.DhHmacChap != nil {
+		body.DhHmacChap = &interfaces.NvmeSubsystemDhHmacChap{
+			HostSecretKey:       data.DhHmacChap.HostSecretKey.ValueString(),
+			ControllerSecretKey: data.DhHmacChap.ControllerSecretKey.ValueString(),
+		}
+		if !data.DhHmacChap.HashFunction.IsUnknown() {
+			body.DhHmacChap.HashFunction = data.DhHmacChap.HashFunction.ValueString()
+		}
+		if !data.DhHmacChap.GroupSize.IsUnknown() {
+			body.DhHmacChap.GroupSize = data.DhHmacChap.GroupSize.ValueString()
+		}
+	}
+	if err = interfaces.AddNvmeSubsystemHost(errorHandler, *client, subsystem.UUID, body); err != nil {
+		return
+	}
+
+	// read the host to know the defaults applied by ONTAP
+	restInfo, err := interfaces.GetNvmeSubsystemHost(errorHandler, *client, subsystem.UUID, data.NQN.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error adding NVMe subsystem host", fmt.Sprintf("host %s not found in subsystem %s after create", data.NQN.ValueString(), data.SubsystemName.ValueString()))
+		return
+	}
+	data.setHost(restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not supported, all the attributes require a replace.
+func (r *ProtocolsNvmeSubsystemHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsNvmeSubsystemHostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only cx_profile_name can change
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the host from the subsystem.
+func (r *ProtocolsNvmeSubsystemHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsNvmeSubsystemHostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	subsystem, err := interfaces.GetNvmeSubsystemByName(errorHandler, *client, data.SubsystemName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if err = interfaces.RemoveNvmeSubsystemHost(errorHandler, *client, subsystem.UUID, data.NQN.ValueString()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsNvmeSubsystemHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a NVMe subsystem host resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: nqn,subsystem_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nqn"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subsystem_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsNvmeSubsystemHostResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsNvmeSubsystemHostResourceConfig("nqn.2014-08.org.nvmexpress:uuid:acc-test-host"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_nvme_subsystem_host_resource.example", "nqn", "nqn.2014-08.org.nvmexpress:uuid:acc-test-host"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_nvme_subsystem_host_resource.example", "dh_hmac_chap.mode", "unidirectional"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_nvme_subsystem_host_resource.example", "dh_hmac_chap.hash_function", "sha_256"),
+				),
+			},
+			// Test importing a resource, the secret keys are not returned by ONTAP
+			{
+				ResourceName:  "netapp-ontap_protocols_nvme_subsystem_host_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s", "nqn.2014-08.org.nvmexpress:uuid:acc-test-host", "acc_test_subsystem", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_nvme_subsystem_host_resource.example", "subsystem_name", "acc_test_subsystem"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsNvmeSubsystemHostResourceConfig(nqn string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_nvme_subsystem_host_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  subsystem_name = "acc_test_subsystem"
+  nqn = "%s"
+  dh_hmac_chap = {
+    host_secret_key = "DHHC-1:00:ia6zGodOr4SEG0Zzaw398rpY0wqipUWj4jWjUh4HWUz6aQ2n:"
+    hash_function = "sha_256"
+  }
+}`, host, admin, password, nqn)
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsS3BucketPolicyResource{}
+var _ resource.ResourceWithImportState = &ProtocolsS3BucketPolicyResource{}
+
+// NewProtocolsS3BucketPolicyResource is a helper function to simplify the provider implementation.
+func NewProtocolsS3BucketPolicyResource() resource.Resource {
+	return &ProtocolsS3BucketPolicyResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_s3_bucket_policy_resource",
+		},
+	}
+}
+
+// ProtocolsS3BucketPolicyResource defines the resource implementation.
+type ProtocolsS3BucketPolicyResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsS3BucketPolicyResourceModel describes the resource data model.
+type ProtocolsS3BucketPolicyResourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	SVMName       types.String                       `tfsdk:"svm_name"`
+	BucketName    types.String                       `tfsdk:"bucket_name"`
+	Statements    []ProtocolsS3BucketPolicyStatement `tfsdk:"statements"`
+	ID            types.String                       `tfsdk:"id"`
+}
+
+// ProtocolsS3BucketPolicyStatement describes a statement of a bucket policy.
+type ProtocolsS3BucketPolicyStatement struct {
+	Sid        types.String                       `tfsdk:"sid"`
+	Effect     types.String                       `tfsdk:"effect"`
+	Actions    []types.String                     `tfsdk:"actions"`
+	Principals []types.String                     `tfsdk:"principals"`
+	Resources  []types.String                     `tfsdk:"resources"`
+	Conditions []ProtocolsS3BucketPolicyCondition `tfsdk:"conditions"`
+}
+
+// ProtocolsS3BucketPolicyCondition describes a condition of a bucket policy statement.
+type ProtocolsS3BucketPolicyCondition struct {
+	Operator   types.String   `tfsdk:"operator"`
+	SourceIPs  []types.String `tfsdk:"source_ips"`
+	Usernames  []types.String `tfsdk:"usernames"`
+	Prefixes   []types.String `tfsdk:"prefixes"`
+	Delimiters []types.String `tfsdk:"delimiters"`
+	MaxKeys    []types.Int64  `tfsdk:"max_keys"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsS3BucketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsS3BucketPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "S3 bucket policy resource, to manage the access policy of an existing S3 bucket",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the S3 bucket",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statements": schema.ListNestedAttribute{
+				MarkdownDescription: "Statements of the policy. They replace any policy already set on the bucket",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Statement identifier",
+							Optional:            true,
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "Whether the statement allows or denies access",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"actions": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 actions the statement applies to, eg GetObject, PutObject, ListBucket, or * for all actions",
+							Required:            true,
+						},
+						"principals": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Users or groups the statement applies to, eg user1 or nasgroup/group1, or * for everyone. All the users of the object store server when not set",
+							Optional:            true,
+						},
+						"resources": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Bucket and objects the statement applies to, eg bucket1, bucket1/* or bucket1/logs/*",
+							Required:            true,
+						},
+						"conditions": schema.ListNestedAttribute{
+							MarkdownDescription: "Conditions for the statement to apply",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										MarkdownDescription: "Condition operator, eg ip_address, not_ip_address, string_equals, string_not_equals, numeric_equals, numeric_less_than_equals",
+										Required:            true,
+									},
+									"source_ips": schema.ListAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "Client IP addresses or subnets, eg 10.0.0.0/24, for the ip_address and not_ip_address operators",
+										Optional:            true,
+									},
+									"usernames": schema.ListAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "User names to compare with",
+										Optional:            true,
+									},
+									"prefixes": schema.ListAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "Object name prefixes to compare with",
+										Optional:            true,
+									},
+									"delimiters": schema.ListAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "Delimiters to compare with",
+										Optional:            true,
+									},
+									"max_keys": schema.ListAttribute{
+										ElementType:         types.Int64Type,
+										MarkdownDescription: "Maximum number of keys to compare with",
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "S3 bucket UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsS3BucketPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// s3BucketPolicyStatementsToONTAP converts the policy statements to the REST body model.
+func s3BucketPolicyStatementsToONTAP(statements []ProtocolsS3BucketPolicyStatement) []interfaces.S3BucketPolicyStatement {
+	statementsONTAP := make([]interfaces.S3BucketPolicyStatement, 0, len(statements))
+	for _, statement := range statements {
+		statementONTAP := interfaces.S3BucketPolicyStatement{
+			Sid:        statement.Sid.ValueString(),
+			Effect:     statement.Effect.ValueString(),
+			Actions:    expandTypesStringList(statement.Actions),
+			Principals: expandTypesStringList(statement.Principals),
+			Resources:  expandTypesStringList(statement.Resources),
+		}
+		for _, condition := range statement.Conditions {
+			conditionONTAP := interfaces.S3BucketPolicyCondition{
+				Operator:   condition.Operator.ValueString(),
+				SourceIPs:  expandTypesStringList(condition.SourceIPs),
+				Usernames:  expandTypesStringList(condition.Usernames),
+				Prefixes:   expandTypesStringList(condition.Prefixes),
+				Delimiters: expandTypesStringList(condition.Delimiters),
+			}
+			for _, maxKeys := range condition.MaxKeys {
+				conditionONTAP.MaxKeys = append(conditionONTAP.MaxKeys, maxKeys.ValueInt64())
+			}
+			statementONTAP.Conditions = append(statementONTAP.Conditions, conditionONTAP)
+		}
+		statementsONTAP = append(statementsONTAP, statementONTAP)
+	}
+	return statementsONTAP
+}
+
+// s3BucketPolicyStatementsFromONTAP converts the policy statements from ONTAP, keeping the configured order of the lists when ONTAP reports the same values.
+func s3BucketPolicyStatementsFromONTAP(statementsONTAP []interfaces.S3BucketPolicyStatement, configured []ProtocolsS3BucketPolicyStatement) []ProtocolsS3BucketPolicyStatement {
+	statements := make([]ProtocolsS3BucketPolicyStatement, 0, len(statementsONTAP))
+	for i, statementONTAP := range statementsONTAP {
+		var current ProtocolsS3BucketPolicyStatement
+		if i < len(configured) {
+			current = configured[i]
+		}
+		statement := ProtocolsS3BucketPolicyStatement{
+			Sid:        types.StringNull(),
+			Effect:     types.StringValue(statementONTAP.Effect),
+			Actions:    flattenUnorderedStringList(current.Actions, statementONTAP.Actions),
+			Principals: flattenUnorderedStringList(current.Principals, statementONTAP.Principals),
+			Resources:  flattenUnorderedStringList(current.Resources, statementONTAP.Resources),
+		}
+		if statementONTAP.Sid != "" {
+			statement.Sid = types.StringValue(statementONTAP.Sid)
+		}
+		for j, conditionONTAP := range statementONTAP.Conditions {
+			var currentCondition ProtocolsS3BucketPolicyCondition
+			if j < len(current.Conditions) {
+				currentCondition = current.Conditions[j]
+			}
+			condition := ProtocolsS3BucketPolicyCondition{
+				Operator:   types.StringValue(conditionONTAP.Operator),
+				SourceIPs:  flattenUnorderedStringList(currentCondition.SourceIPs, conditionONTAP.SourceIPs),
+				Usernames:  flattenUnorderedStringList(currentCondition.Usernames, conditionONTAP.Usernames),
+				Prefixes:   flattenUnorderedStringList(currentCondition.Prefixes, conditionONTAP.Prefixes),
+				Delimiters: flattenUnorderedStringList(currentCondition.Delimiters, conditionONTAP.Delimiters),
+			}
+			for _, maxKeys := range conditionONTAP.MaxKeys {
+				condition.MaxKeys = append(condition.MaxKeys, types.Int64Value(maxKeys))
+			}
+			statement.Conditions = append(statement.Conditions, condition)
+		}
+		statements = append(statements, statement)
+	}
+	return statements
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsS3BucketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsS3BucketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetS3BucketPolicy(errorHandler, *client, data.BucketName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetS3BucketPolicy
+		return
+	}
+	if restInfo == nil || len(restInfo.Policy.Statements) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("no policy on bucket %s, removing it from state", data.BucketName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Statements = s3BucketPolicyStatementsFromONTAP(restInfo.Policy.Statements, data.Statements)
+	data.ID = types.StringValue(restInfo.UUID)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setPolicy replaces the statements of the policy of the bucket
+func (r *ProtocolsS3BucketPolicyResource) setPolicy(errorHandler *utils.ErrorHandler, data *ProtocolsS3BucketPolicyResourceModel, statements []interfaces.S3BucketPolicyStatement) error {
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	bucket, err := interfaces.GetS3BucketPolicy(errorHandler, *client, data.BucketName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return err
+	}
+	if bucket == nil {
+		return errorHandler.MakeAndReportError("error setting S3 bucket policy", fmt.Sprintf("no bucket %s found in svm %s", data.BucketName.ValueString(), data.SVMName.ValueString()))
+	}
+	if err = interfaces.UpdateS3BucketPolicy(errorHandler, *client, bucket.SVM.UUID, bucket.UUID, statements); err != nil {
+		return err
+	}
+	data.ID = types.StringValue(bucket.UUID)
+	return nil
+}
+
+// Create sets the policy of the bucket
+func (r *ProtocolsS3BucketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsS3BucketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.setPolicy(errorHandler, data, s3BucketPolicyStatementsToONTAP(data.Statements)); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update replaces the statements of the policy of the bucket.
+func (r *ProtocolsS3BucketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsS3BucketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.setPolicy(errorHandler, data, s3BucketPolicyStatementsToONTAP(data.Statements)); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the policy of the bucket, the bucket is left unchanged.
+func (r *ProtocolsS3BucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsS3BucketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.setPolicy(errorHandler, data, nil); err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsS3BucketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a S3 bucket policy resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
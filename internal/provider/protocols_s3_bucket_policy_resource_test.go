@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsS3BucketPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsS3BucketPolicyResourceConfig(`["GetObject", "ListBucket"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "bucket_name", "acc_test_bucket"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "statements.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "statements.0.actions.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "statements.0.conditions.0.source_ips.0", "10.0.0.0/24"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsS3BucketPolicyResourceConfig(`["GetObject", "ListBucket", "PutObject"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "statements.0.actions.#", "3"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_s3_bucket_policy_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_bucket", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_bucket_policy_resource.example", "bucket_name", "acc_test_bucket"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsS3BucketPolicyResourceConfig(actions string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_s3_bucket_policy_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  bucket_name = "acc_test_bucket"
+  statements = [
+    {
+      sid = "AccTest"
+      effect = "allow"
+      actions = %s
+      principals = ["acc_test_user"]
+      resources = ["acc_test_bucket", "acc_test_bucket/*"]
+      conditions = [
+        {
+          operator = "ip_address"
+          source_ips = ["10.0.0.0/24"]
+        },
+      ]
+    },
+  ]
+}`, host, admin, password, actions)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsS3GroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsS3GroupResourceConfig("readers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_group_resource.example", "name", "acc_test_group"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_group_resource.example", "comment", "readers"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_group_resource.example", "policies.0", "AccTestPolicy"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_policy_resource.example", "statements.#", "1"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsS3GroupResourceConfig("bucket readers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_group_resource.example", "comment", "bucket readers"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_s3_group_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_group", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_group_resource.example", "name", "acc_test_group"),
+				),
+			},
+			{
+				ResourceName:  "netapp-ontap_protocols_s3_policy_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "AccTestPolicy", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_policy_resource.example", "name", "AccTestPolicy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsS3GroupResourceConfig(comment string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_s3_policy_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "AccTestPolicy"
+  statements = [
+    {
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      resources = ["acc_test_bucket", "acc_test_bucket/*"]
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_s3_group_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "acc_test_group"
+  comment = "%s"
+  users = ["acc_test_user"]
+  policies = [netapp-ontap_protocols_s3_policy_resource.example.name]
+}`, host, admin, password, comment)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsS3PolicyResource{}
+var _ resource.ResourceWithImportState = &ProtocolsS3PolicyResource{}
+
+// NewProtocolsS3PolicyResource is a helper function to simplify the provider implementation.
+func NewProtocolsS3PolicyResource() resource.Resource {
+	return &ProtocolsS3PolicyResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_s3_policy_resource",
+		},
+	}
+}
+
+// ProtocolsS3PolicyResource defines the resource implementation.
+type ProtocolsS3PolicyResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsS3PolicyResourceModel describes the resource data model.
+type ProtocolsS3PolicyResourceModel struct {
+	CxProfileName types.String                 `tfsdk:"cx_profile_name"`
+	SVMName       types.String                 `tfsdk:"svm_name"`
+	Name          types.String                 `tfsdk:"name"`
+	Comment       types.String                 `tfsdk:"comment"`
+	Statements    []ProtocolsS3PolicyStatement `tfsdk:"statements"`
+	ID            types.String                 `tfsdk:"id"`
+}
+
+// ProtocolsS3PolicyStatement describes a statement of a S3 access policy.
+type ProtocolsS3PolicyStatement struct {
+	Sid       types.String   `tfsdk:"sid"`
+	Effect    types.String   `tfsdk:"effect"`
+	Actions   []types.String `tfsdk:"actions"`
+	Resources []types.String `tfsdk:"resources"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsS3PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsS3PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "S3 policy resource, to define an access policy of the object store server. The policy applies to the users of the S3 groups it is attached to",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Policy name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Policy comment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"statements": schema.ListNestedAttribute{
+				MarkdownDescription: "Statements of the policy",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Statement identifier",
+							Optional:            true,
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "Whether the statement allows or denies access",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"actions": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 actions the statement applies to, eg GetObject, PutObject, ListBucket, or * for all actions",
+							Required:            true,
+						},
+						"resources": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Buckets and objects the statement applies to, eg bucket1, bucket1/*, or * for all the buckets",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "S3 policy identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsS3PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// body converts the resource data model to the REST body model.
+func (data *ProtocolsS3PolicyResourceModel) body() interfaces.S3PolicyResourceBodyDataModelONTAP {
+	body := interfaces.S3PolicyResourceBodyDataModelONTAP{
+		Comment: data.Comment.ValueString(),
+	}
+	for _, statement := range data.Statements {
+		body.Statements = append(body.Statements, interfaces.S3PolicyStatement{
+			Sid:       statement.Sid.ValueString(),
+			Effect:    statement.Effect.ValueString(),
+			Actions:   expandTypesStringList(statement.Actions),
+			Resources: expandTypesStringList(statement.Resources),
+		})
+	}
+	return body
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsS3PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsS3PolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetS3Policy(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetS3Policy
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("S3 policy %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Comment = types.StringValue(restInfo.Comment)
+	statements := make([]ProtocolsS3PolicyStatement, 0, len(restInfo.Statements))
+	for i, statementONTAP := range restInfo.Statements {
+		var current ProtocolsS3PolicyStatement
+		if i < len(data.Statements) {
+			current = data.Statements[i]
+		}
+		statement := ProtocolsS3PolicyStatement{
+			Sid:       types.StringNull(),
+			Effect:    types.StringValue(statementONTAP.Effect),
+			Actions:   flattenUnorderedStringList(current.Actions, statementONTAP.Actions),
+			Resources: flattenUnorderedStringList(current.Resources, statementONTAP.Resources),
+		}
+		if statementONTAP.Sid != "" {
+			statement.Sid = types.StringValue(statementONTAP.Sid)
+		}
+		statements = append(statements, statement)
+	}
+	data.Statements = statements
+	data.ID = types.StringValue(restInfo.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ProtocolsS3PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsS3PolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	body := data.body()
+	body.Name = data.Name.ValueString()
+	if err = interfaces.CreateS3Policy(errorHandler, *client, svm.UUID, body); err != nil {
+		return
+	}
+	data.Comment = types.StringValue(body.Comment)
+	data.ID = data.Name
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update replaces the comment and statements of the policy.
+func (r *ProtocolsS3PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsS3PolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if err = interfaces.UpdateS3Policy(errorHandler, *client, svm.UUID, data.Name.ValueString(), data.body()); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsS3PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsS3PolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if err = interfaces.DeleteS3Policy(errorHandler, *client, svm.UUID, data.Name.ValueString()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsS3PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a S3 policy resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsS3ServiceCertificateResource{}
+var _ resource.ResourceWithImportState = &ProtocolsS3ServiceCertificateResource{}
+
+// NewProtocolsS3ServiceCertificateResource is a helper function to simplify the provider implementation.
+func NewProtocolsS3ServiceCertificateResource() resource.Resource {
+	return &ProtocolsS3ServiceCertificateResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_s3_service_certificate_resource",
+		},
+	}
+}
+
+// ProtocolsS3ServiceCertificateResource defines the resource implementation.
+type ProtocolsS3ServiceCertificateResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsS3ServiceCertificateResourceModel describes the resource data model.
+type ProtocolsS3ServiceCertificateResourceModel struct {
+	CxProfileName         types.String `tfsdk:"cx_profile_name"`
+	SVMName               types.String `tfsdk:"svm_name"`
+	CertificateName       types.String `tfsdk:"certificate_name"`
+	CertificateUUID       types.String `tfsdk:"certificate_uuid"`
+	CertificateExpiryTime types.String `tfsdk:"certificate_expiry_time"`
+	ID                    types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsS3ServiceCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsS3ServiceCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "S3 service certificate resource, to bind the server certificate used by the object store server of an SVM. The certificate is left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the server certificate used by the S3 service, installed for the SVM. Changing it rotates the certificate in place",
+				Required:            true,
+			},
+			"certificate_uuid": schema.StringAttribute{
+				MarkdownDescription: "UUID of the server certificate",
+				Computed:            true,
+			},
+			"certificate_expiry_time": schema.StringAttribute{
+				MarkdownDescription: "Expiry time of the server certificate",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SVM UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsS3ServiceCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// getServerCertificate returns the server certificate of the SVM with the given name, or nil if it is not installed
+func getServerCertificate(errorHandler *utils.ErrorHandler, client *restclient.RestClient, svmName string, name string) (*interfaces.SecurityCertificateGetDataModelONTAP, error) {
+	certificates, err := interfaces.GetListSecurityCertificates(errorHandler, *client, &interfaces.SecurityCertificateDataSourceFilterModel{Name: name, SVMName: svmName, Type: "server"})
+	if err != nil || len(certificates) == 0 {
+		return nil, err
+	}
+	return &certificates[0], nil
+}
+
+// bindCertificate checks that the certificate is installed and valid before switching the S3 service to it,
+// so that a wrong name or an expired certificate does not break the access to the buckets.
+func (r *ProtocolsS3ServiceCertificateResource) bindCertificate(errorHandler *utils.ErrorHandler, data *ProtocolsS3ServiceCertificateResourceModel) error {
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return err
+	}
+	service, err := interfaces.GetS3Service(errorHandler, *client, svm.UUID)
+	if err != nil {
+		return err
+	}
+	if service == nil {
+		return errorHandler.MakeAndReportError("error binding S3 service certificate", fmt.Sprintf("no S3 service found in svm %s", data.SVMName.ValueString()))
+	}
+	certificate, err := getServerCertificate(errorHandler, client, data.SVMName.ValueString(), data.CertificateName.ValueString())
+	if err != nil {
+		return err
+	}
+	if certificate == nil {
+		return errorHandler.MakeAndReportError("error binding S3 service certificate", fmt.Sprintf("no server certificate %s installed for svm %s", data.CertificateName.ValueString(), data.SVMName.ValueString()))
+	}
+	if expiry, err := time.Parse(time.RFC3339, certificate.ExpiryTime); err == nil && expiry.Before(time.Now()) {
+		return errorHandler.MakeAndReportError("error binding S3 service certificate", fmt.Sprintf("server certificate %s expired on %s", certificate.Name, certificate.ExpiryTime))
+	}
+	if service.Certificate.UUID != certificate.UUID {
+		body := interfaces.S3ServiceResourceBodyDataModelONTAP{Certificate: &interfaces.S3ServiceCertificate{Name: certificate.Name}}
+		if err = interfaces.UpdateS3Service(errorHandler, *client, svm.UUID, body); err != nil {
+			return err
+		}
+	}
+	data.CertificateUUID = types.StringValue(certificate.UUID)
+	data.CertificateExpiryTime = types.StringValue(certificate.ExpiryTime)
+	data.ID = types.StringValue(svm.UUID)
+	return nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsS3ServiceCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsS3ServiceCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	service, err := interfaces.GetS3Service(errorHandler, *client, svm.UUID)
+	if err != nil {
+		// error reporting done inside GetS3Service
+		return
+	}
+	if service == nil {
+		tflog.Debug(ctx, fmt.Sprintf("no S3 service in svm %s, removing it from state", data.SVMName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CertificateName = types.StringValue(service.Certificate.Name)
+	data.CertificateUUID = types.StringValue(service.Certificate.UUID)
+	data.CertificateExpiryTime = types.StringValue("")
+	certificate, err := getServerCertificate(errorHandler, client, data.SVMName.ValueString(), service.Certificate.Name)
+	if err != nil {
+		return
+	}
+	if certificate != nil {
+		data.CertificateExpiryTime = types.StringValue(certificate.ExpiryTime)
+	}
+	data.ID = types.StringValue(svm.UUID)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create binds the certificate to the S3 service
+func (r *ProtocolsS3ServiceCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsS3ServiceCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.bindCertificate(errorHandler, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update rotates the certificate of the S3 service in place.
+func (r *ProtocolsS3ServiceCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsS3ServiceCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.bindCertificate(errorHandler, data); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state, the S3 service always has a certificate so it is left unchanged.
+func (r *ProtocolsS3ServiceCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsS3ServiceCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 service certificate of svm %s left unchanged", data.SVMName.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsS3ServiceCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a S3 service certificate resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsS3ServiceCertificateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown certificate
+			{
+				Config:      testAccProtocolsS3ServiceCertificateResourceConfig("acc_test_no_such_cert"),
+				ExpectError: regexp.MustCompile("no server certificate acc_test_no_such_cert installed"),
+			},
+			// Create and read testing
+			{
+				Config: testAccProtocolsS3ServiceCertificateResourceConfig("acc_test_s3_cert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_service_certificate_resource.example", "certificate_name", "acc_test_s3_cert"),
+				),
+			},
+			// Rotate and read testing
+			{
+				Config: testAccProtocolsS3ServiceCertificateResourceConfig("acc_test_s3_cert_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_service_certificate_resource.example", "certificate_name", "acc_test_s3_cert_2"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_s3_service_certificate_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_s3_service_certificate_resource.example", "certificate_name", "acc_test_s3_cert_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsS3ServiceCertificateResourceConfig(certificateName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_s3_service_certificate_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  certificate_name = "%s"
+}`, host, admin, password, certificateName)
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanIgroupsDataSource{}
+
+// NewProtocolsSanIgroupsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanIgroupsDataSource() datasource.DataSource {
+	return &ProtocolsSanIgroupsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_igroups_data_source",
+		},
+	}
+}
+
+// ProtocolsSanIgroupsDataSource defines the data source implementation.
+type ProtocolsSanIgroupsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanIgroupsDataSourceModel describes the data source data model.
+type ProtocolsSanIgroupsDataSourceModel struct {
+	CxProfileName types.String                             `tfsdk:"cx_profile_name"`
+	Igroups       []ProtocolsSanIgroupDataSourceModel      `tfsdk:"protocols_san_igroups"`
+	Filter        *ProtocolsSanIgroupDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsSanIgroupDataSourceModel describes the data model for an igroup.
+type ProtocolsSanIgroupDataSourceModel struct {
+	Name           types.String   `tfsdk:"name"`
+	ID             types.String   `tfsdk:"id"`
+	SVMName        types.String   `tfsdk:"svm_name"`
+	Protocol       types.String   `tfsdk:"protocol"`
+	OsType         types.String   `tfsdk:"os_type"`
+	PortsetName    types.String   `tfsdk:"portset_name"`
+	Initiators     []types.String `tfsdk:"initiators"`
+	MappedLunCount types.Int64    `tfsdk:"mapped_lun_count"`
+}
+
+// ProtocolsSanIgroupDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanIgroupDataSourceFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	SVMName  types.String `tfsdk:"svm_name"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanIgroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanIgroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SAN igroups data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Igroup name, wildcards are supported",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Igroup svm name",
+						Optional:            true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "Igroup protocol, one of fcp, iscsi, mixed",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("fcp", "iscsi", "mixed"),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"protocols_san_igroups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Igroup name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Igroup UUID",
+							Computed:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Igroup svm name",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Igroup protocol",
+							Computed:            true,
+						},
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "Operating system of the initiators, eg linux, windows, vmware",
+							Computed:            true,
+						},
+						"portset_name": schema.StringAttribute{
+							MarkdownDescription: "Portset bound to the igroup, empty when there is none",
+							Computed:            true,
+						},
+						"initiators": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Initiators of the igroup, iSCSI IQNs or FC WWPNs",
+							Computed:            true,
+						},
+						"mapped_lun_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of LUNs mapped to the igroup",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanIgroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanIgroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanIgroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.IgroupDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.IgroupDataSourceFilterModel{
+			Name:     data.Filter.Name.ValueString(),
+			SVMName:  data.Filter.SVMName.ValueString(),
+			Protocol: data.Filter.Protocol.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListProtocolsSanIgroups(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListProtocolsSanIgroups
+		return
+	}
+
+	data.Igroups = make([]ProtocolsSanIgroupDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		initiators := make([]string, len(record.Initiators))
+		for i, initiator := range record.Initiators {
+			initiators[i] = initiator.Name
+		}
+		data.Igroups[index] = ProtocolsSanIgroupDataSourceModel{
+			Name:           types.StringValue(record.Name),
+			ID:             types.StringValue(record.UUID),
+			SVMName:        types.StringValue(record.SVM.Name),
+			Protocol:       types.StringValue(record.Protocol),
+			OsType:         types.StringValue(record.OsType),
+			PortsetName:    types.StringValue(record.Portset.Name),
+			Initiators:     flattenTypesStringList(initiators),
+			MappedLunCount: types.Int64Value(int64(len(record.LunMaps))),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
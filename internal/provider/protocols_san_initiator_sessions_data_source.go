@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanInitiatorSessionsDataSource{}
+
+// NewProtocolsSanInitiatorSessionsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanInitiatorSessionsDataSource() datasource.DataSource {
+	return &ProtocolsSanInitiatorSessionsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_initiator_sessions_data_source",
+		},
+	}
+}
+
+// ProtocolsSanInitiatorSessionsDataSource defines the data source implementation.
+type ProtocolsSanInitiatorSessionsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanInitiatorSessionsDataSourceModel describes the data source data model.
+type ProtocolsSanInitiatorSessionsDataSourceModel struct {
+	CxProfileName types.String                              `tfsdk:"cx_profile_name"`
+	SVMName       types.String                              `tfsdk:"svm_name"`
+	Protocol      types.String                              `tfsdk:"protocol"`
+	Initiators    []types.String                            `tfsdk:"initiators"`
+	IscsiSessions []ProtocolsSanIscsiSessionDataSourceModel `tfsdk:"iscsi_sessions"`
+	FcLogins      []ProtocolsSanFcLoginDataSourceModel      `tfsdk:"fc_logins"`
+}
+
+// ProtocolsSanIscsiSessionDataSourceModel describes an iSCSI session.
+type ProtocolsSanIscsiSessionDataSourceModel struct {
+	Initiator         types.String                                 `tfsdk:"initiator"`
+	Alias             types.String                                 `tfsdk:"alias"`
+	ISID              types.String                                 `tfsdk:"isid"`
+	TSIH              types.Int64                                  `tfsdk:"tsih"`
+	TargetPortalGroup types.String                                 `tfsdk:"target_portal_group"`
+	Igroups           []types.String                               `tfsdk:"igroups"`
+	Connections       []ProtocolsSanIscsiConnectionDataSourceModel `tfsdk:"connections"`
+}
+
+// ProtocolsSanIscsiConnectionDataSourceModel describes a connection of an iSCSI session.
+type ProtocolsSanIscsiConnectionDataSourceModel struct {
+	Interface          types.String `tfsdk:"interface"`
+	InterfaceAddress   types.String `tfsdk:"interface_address"`
+	InitiatorAddress   types.String `tfsdk:"initiator_address"`
+	InitiatorPort      types.Int64  `tfsdk:"initiator_port"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+}
+
+// ProtocolsSanFcLoginDataSourceModel describes a FC login.
+type ProtocolsSanFcLoginDataSourceModel struct {
+	InitiatorWWPN types.String   `tfsdk:"initiator_wwpn"`
+	InitiatorWWNN types.String   `tfsdk:"initiator_wwnn"`
+	Interface     types.String   `tfsdk:"interface"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Igroups       []types.String `tfsdk:"igroups"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanInitiatorSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanInitiatorSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SAN initiator sessions data source, to list the iSCSI and FC initiators currently logged in to a SVM",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only list the iscsi sessions or the fc logins, both are listed when not set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("iscsi", "fc"),
+				},
+			},
+			"initiators": schema.ListAttribute{
+				MarkdownDescription: "Sorted list of the logged in initiators, iSCSI IQNs and FC WWPNs",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"iscsi_sessions": schema.ListNestedAttribute{
+				MarkdownDescription: "iSCSI sessions",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"initiator": schema.StringAttribute{
+							MarkdownDescription: "Initiator IQN",
+							Computed:            true,
+						},
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "Initiator alias",
+							Computed:            true,
+						},
+						"isid": schema.StringAttribute{
+							MarkdownDescription: "Initiator session identifier",
+							Computed:            true,
+						},
+						"tsih": schema.Int64Attribute{
+							MarkdownDescription: "Target session identifying handle",
+							Computed:            true,
+						},
+						"target_portal_group": schema.StringAttribute{
+							MarkdownDescription: "Target portal group of the session",
+							Computed:            true,
+						},
+						"igroups": schema.ListAttribute{
+							MarkdownDescription: "Igroups containing the initiator",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"connections": schema.ListNestedAttribute{
+							MarkdownDescription: "Connections of the session",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"interface": schema.StringAttribute{
+										MarkdownDescription: "Name of the network interface of the SVM",
+										Computed:            true,
+									},
+									"interface_address": schema.StringAttribute{
+										MarkdownDescription: "IP address of the network interface of the SVM",
+										Computed:            true,
+									},
+									"initiator_address": schema.StringAttribute{
+										MarkdownDescription: "IP address of the initiator",
+										Computed:            true,
+									},
+									"initiator_port": schema.Int64Attribute{
+										MarkdownDescription: "TCP port of the initiator",
+										Computed:            true,
+									},
+									"authentication_type": schema.StringAttribute{
+										MarkdownDescription: "Authentication type, none or chap",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"fc_logins": schema.ListNestedAttribute{
+				MarkdownDescription: "FC logins, for FCP and FC-NVMe",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"initiator_wwpn": schema.StringAttribute{
+							MarkdownDescription: "Initiator world wide port name",
+							Computed:            true,
+						},
+						"initiator_wwnn": schema.StringAttribute{
+							MarkdownDescription: "Initiator world wide node name",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Name of the FC interface of the SVM",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol of the login, fcp or fc_nvme",
+							Computed:            true,
+						},
+						"igroups": schema.ListAttribute{
+							MarkdownDescription: "Igroups containing the initiator",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanInitiatorSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// igroupNames returns the names of the igroups
+func igroupNames(igroups []interfaces.NameDataModel) []types.String {
+	names := make([]types.String, len(igroups))
+	for index, igroup := range igroups {
+		names[index] = types.StringValue(igroup.Name)
+	}
+	return names
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanInitiatorSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanInitiatorSessionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	protocol := data.Protocol.ValueString()
+	initiators := map[string]bool{}
+	data.IscsiSessions = []ProtocolsSanIscsiSessionDataSourceModel{}
+	data.FcLogins = []ProtocolsSanFcLoginDataSourceModel{}
+	if protocol == "" || protocol == "iscsi" {
+		sessions, err := interfaces.GetIscsiSessions(errorHandler, *client, data.SVMName.ValueString())
+		if err != nil {
+			// error reporting done inside GetIscsiSessions
+			return
+		}
+		for _, session := range sessions {
+			connections := make([]ProtocolsSanIscsiConnectionDataSourceModel, len(session.Connections))
+			for index, connection := range session.Connections {
+				connections[index] = ProtocolsSanIscsiConnectionDataSourceModel{
+					Interface:          types.StringValue(connection.Interface.Name),
+					InterfaceAddress:   types.StringValue(connection.Interface.IP.Address),
+					InitiatorAddress:   types.StringValue(connection.InitiatorAddress.Address),
+					InitiatorPort:      types.Int64Value(connection.InitiatorAddress.Port),
+					AuthenticationType: types.StringValue(connection.AuthenticationType),
+				}
+			}
+			data.IscsiSessions = append(data.IscsiSessions, ProtocolsSanIscsiSessionDataSourceModel{
+				Initiator:         types.StringValue(session.Initiator.Name),
+				Alias:             types.StringValue(session.Initiator.Alias),
+				ISID:              types.StringValue(session.ISID),
+				TSIH:              types.Int64Value(session.TSIH),
+				TargetPortalGroup: types.StringValue(session.Tpgroup.Name),
+				Igroups:           igroupNames(session.Igroups),
+				Connections:       connections,
+			})
+			initiators[session.Initiator.Name] = true
+		}
+	}
+	if protocol == "" || protocol == "fc" {
+		logins, err := interfaces.GetFcLogins(errorHandler, *client, data.SVMName.ValueString())
+		if err != nil {
+			// error reporting done inside GetFcLogins
+			return
+		}
+		for _, login := range logins {
+			data.FcLogins = append(data.FcLogins, ProtocolsSanFcLoginDataSourceModel{
+				InitiatorWWPN: types.StringValue(login.Initiator.WWPN),
+				InitiatorWWNN: types.StringValue(login.Initiator.WWNN),
+				Interface:     types.StringValue(login.Interface.Name),
+				Protocol:      types.StringValue(login.Protocol),
+				Igroups:       igroupNames(login.Igroups),
+			})
+			initiators[login.Initiator.WWPN] = true
+		}
+	}
+
+	// an initiator logs in once per path, it is only listed once
+	names := make([]string, 0, len(initiators))
+	for name := range initiators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	data.Initiators = make([]types.String, len(names))
+	for index, name := range names {
+		data.Initiators[index] = types.StringValue(name)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
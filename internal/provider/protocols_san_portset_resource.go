@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsSanPortsetResource{}
+var _ resource.ResourceWithImportState = &ProtocolsSanPortsetResource{}
+
+// NewProtocolsSanPortsetResource is a helper function to simplify the provider implementation.
+func NewProtocolsSanPortsetResource() resource.Resource {
+	return &ProtocolsSanPortsetResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_portset_resource",
+		},
+	}
+}
+
+// ProtocolsSanPortsetResource defines the resource implementation.
+type ProtocolsSanPortsetResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanPortsetResourceModel describes the resource data model.
+type ProtocolsSanPortsetResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Interfaces    []types.String `tfsdk:"interfaces"`
+	Igroups       []types.String `tfsdk:"igroups"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsSanPortsetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsSanPortsetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SAN portset resource, to restrict the network interfaces used by the initiators of the bound igroups",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Portset name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol of the network interfaces, fcp, iscsi or mixed. ONTAP defaults to mixed",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("fcp", "iscsi", "mixed"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interfaces": schema.ListAttribute{
+				MarkdownDescription: "Names of the iSCSI IP interfaces or FC interfaces in the portset",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"igroups": schema.ListAttribute{
+				MarkdownDescription: "Names of the igroups bound to the portset. An igroup can only be bound to one portset",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Portset UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsSanPortsetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsSanPortsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsSanPortsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortset(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanPortset
+		return
+	}
+
+	// only report the interfaces and igroups that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	data.Protocol = types.StringValue(restInfo.Protocol)
+	if imported || data.Interfaces != nil {
+		names := make([]string, len(restInfo.Interfaces))
+		for index, record := range restInfo.Interfaces {
+			names[index] = record.InterfaceName()
+		}
+		data.Interfaces = flattenUnorderedStringList(data.Interfaces, names)
+	}
+	if imported || data.Igroups != nil {
+		names := make([]string, len(restInfo.Igroups))
+		for index, record := range restInfo.Igroups {
+			names[index] = record.Name
+		}
+		data.Igroups = flattenUnorderedStringList(data.Igroups, names)
+	}
+	data.ID = types.StringValue(restInfo.UUID)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a portset, add the interfaces and bind the igroups
+func (r *ProtocolsSanPortsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.PortsetResourceBodyDataModelONTAP{
+		Name: data.Name.ValueString(),
+		SVM:  interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+	}
+	if !data.Protocol.IsUnknown() {
+		body.Protocol = data.Protocol.ValueString()
+	}
+	restInfo, err := interfaces.CreateProtocolsSanPortset(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+	data.ID = types.StringValue(restInfo.UUID)
+
+	// the portset is created, save the state so that it is cleaned up if adding an interface or binding an igroup fails
+	if data.Protocol.IsUnknown() {
+		protocol := restInfo.Protocol
+		if protocol == "" {
+			// ONTAP default
+			protocol = "mixed"
+		}
+		data.Protocol = types.StringValue(protocol)
+	}
+	interfaceNames := data.Interfaces
+	igroupNames := data.Igroups
+	data.Interfaces = nil
+	data.Igroups = nil
+	if interfaceNames != nil {
+		data.Interfaces = []types.String{}
+	}
+	if igroupNames != nil {
+		data.Igroups = []types.String{}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	for _, name := range interfaceNames {
+		if err = r.addInterface(errorHandler, *client, data, name.ValueString()); err != nil {
+			return
+		}
+		data.Interfaces = append(data.Interfaces, name)
+	}
+	for _, name := range igroupNames {
+		if err = r.bindIgroup(errorHandler, *client, data, name.ValueString()); err != nil {
+			return
+		}
+		data.Igroups = append(data.Igroups, name)
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update adds and removes interfaces, and binds and unbinds igroups.
+func (r *ProtocolsSanPortsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortset(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	// unbind igroups first, as ONTAP does not allow to remove the last interface of a portset bound to an igroup
+	for _, igroup := range restInfo.Igroups {
+		if StringInSlice(igroup.Name, state.Igroups) && !StringInSlice(igroup.Name, data.Igroups) {
+			if err = interfaces.UpdateProtocolsSanIgroupPortset(errorHandler, *client, igroup.UUID, ""); err != nil {
+				return
+			}
+		}
+	}
+	for _, record := range restInfo.Interfaces {
+		name := record.InterfaceName()
+		if StringInSlice(name, state.Interfaces) && !StringInSlice(name, data.Interfaces) {
+			if err = interfaces.RemoveProtocolsSanPortsetInterface(errorHandler, *client, restInfo.UUID, record.UUID); err != nil {
+				return
+			}
+		}
+	}
+	for _, name := range data.Interfaces {
+		if !StringInSlice(name.ValueString(), state.Interfaces) {
+			if err = r.addInterface(errorHandler, *client, data, name.ValueString()); err != nil {
+				return
+			}
+		}
+	}
+	for _, name := range data.Igroups {
+		if !StringInSlice(name.ValueString(), state.Igroups) {
+			if err = r.bindIgroup(errorHandler, *client, data, name.ValueString()); err != nil {
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete unbinds the igroups managed by terraform, and deletes the portset.
+func (r *ProtocolsSanPortsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortset(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	// a portset can not be deleted while bound to an igroup, igroups bound outside of terraform are left as is
+	for _, igroup := range restInfo.Igroups {
+		if StringInSlice(igroup.Name, data.Igroups) {
+			if err = interfaces.UpdateProtocolsSanIgroupPortset(errorHandler, *client, igroup.UUID, ""); err != nil {
+				return
+			}
+		}
+	}
+
+	err = interfaces.DeleteProtocolsSanPortset(errorHandler, *client, restInfo.UUID)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsSanPortsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a san portset resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// addInterface adds an IP interface for iscsi, or a FC interface for fcp.
+// For mixed portsets, the interface is looked up in the IP interfaces of the SVM, and assumed to be a FC interface otherwise.
+func (r *ProtocolsSanPortsetResource) addInterface(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *ProtocolsSanPortsetResourceModel, name string) error {
+	isIP := data.Protocol.ValueString() == "iscsi"
+	if data.Protocol.ValueString() == "mixed" {
+		records, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Name: name, SVMName: data.SVMName.ValueString()})
+		if err != nil {
+			return err
+		}
+		isIP = len(records) > 0
+	}
+	body := interfaces.PortsetInterface{}
+	if isIP {
+		body.IP = &interfaces.PortsetInterfaceName{Name: name}
+	} else {
+		body.FC = &interfaces.PortsetInterfaceName{Name: name}
+	}
+	return interfaces.AddProtocolsSanPortsetInterface(errorHandler, client, data.ID.ValueString(), body)
+}
+
+// bindIgroup binds an igroup to the portset
+func (r *ProtocolsSanPortsetResource) bindIgroup(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *ProtocolsSanPortsetResourceModel, name string) error {
+	igroup, err := interfaces.GetProtocolsSanIgroupByName(errorHandler, client, name, data.SVMName.ValueString())
+	if err != nil {
+		return err
+	}
+	return interfaces.UpdateProtocolsSanIgroupPortset(errorHandler, client, igroup.UUID, data.Name.ValueString())
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsSanPortsetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccProtocolsSanPortsetResourceConfig(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "name", "acc_test_portset"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "protocol", "iscsi"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "interfaces.#", "0"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccProtocolsSanPortsetResourceConfig(`["lif1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "interfaces.0", "lif1"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_san_portset_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_portset", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_portset_resource.example", "name", "acc_test_portset"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsSanPortsetResourceConfig(interfaces string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_san_portset_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "acc_test_portset"
+  protocol = "iscsi"
+  interfaces = %s
+}`, host, admin, password, interfaces)
+}
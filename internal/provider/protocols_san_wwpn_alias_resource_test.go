@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsSanWwpnAliasResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test invalid WWPN
+			{
+				Config:      testAccProtocolsSanWwpnAliasResourceConfig("acc_test_hba0", "20:00:00:25:B5:00:A0:01"),
+				ExpectError: regexp.MustCompile("must be 8 lowercase hexadecimal bytes"),
+			},
+			// Create and read testing
+			{
+				Config: testAccProtocolsSanWwpnAliasResourceConfig("acc_test_hba0", "20:00:00:25:b5:00:a0:01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_wwpn_alias_resource.example", "alias", "acc_test_hba0"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_wwpn_alias_resource.example", "wwpn", "20:00:00:25:b5:00:a0:01"),
+				),
+			},
+			// Update testing, the alias is replaced
+			{
+				Config: testAccProtocolsSanWwpnAliasResourceConfig("acc_test_hba0", "20:00:00:25:b5:00:a0:02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_wwpn_alias_resource.example", "wwpn", "20:00:00:25:b5:00:a0:02"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_protocols_san_wwpn_alias_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_hba0", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_wwpn_alias_resource.example", "wwpn", "20:00:00:25:b5:00:a0:02"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsSanWwpnAliasResourceConfig(alias string, wwpn string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_san_wwpn_alias_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  alias = "%s"
+  wwpn = "%s"
+}`, host, admin, password, alias, wwpn)
+}
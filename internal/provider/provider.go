@@ -2,18 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 )
 
 // Ensure ONTAPProvider satisfies various provider interfaces.
 var _ provider.Provider = &ONTAPProvider{}
+var _ provider.ProviderWithFunctions = &ONTAPProvider{}
 
 //var _ provider.ProviderWithMetadata = &ONTAPProvider{}
 
@@ -33,12 +40,31 @@ type ConnectionProfileModel struct {
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
 	ValidateCerts types.Bool   `tfsdk:"validate_certs"`
+	// mutual TLS authentication, as an alternative to username and password
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	ClientPrivateKey  types.String `tfsdk:"client_private_key"`
+	// OAuth 2.0 bearer token, as an alternative to username and password
+	AccessToken       types.String `tfsdk:"access_token"`
+	FSx               types.Bool   `tfsdk:"fsx"`
+	ConnectTimeout    types.Int64  `tfsdk:"connect_timeout"`
+	ReadTimeout       types.Int64  `tfsdk:"read_timeout"`
+	OperationDeadline types.Int64  `tfsdk:"operation_deadline"`
+	CACertFile        types.String `tfsdk:"ca_cert_file"`
+	CACertPEM         types.String `tfsdk:"ca_cert_pem"`
+	ValidateHostname  types.Bool   `tfsdk:"validate_hostname"`
+	ZAPIFallback      types.Bool   `tfsdk:"zapi_fallback"`
+	// used by resources and data sources when cx_profile_name is not set
+	Default types.Bool `tfsdk:"default"`
+	// limits the load on the ONTAP management plane, eg with large for_each applies
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
 }
 
 // ONTAPProviderModel describes the provider data model.
 type ONTAPProviderModel struct {
 	Endpoint             types.String             `tfsdk:"endpoint"`
 	JobCompletionTimeOut types.Int64              `tfsdk:"job_completion_timeout"`
+	JobPollInterval      types.Int64              `tfsdk:"job_poll_interval"`
+	ValidateOnly         types.Bool               `tfsdk:"validate_only"`
 	ConnectionProfiles   []ConnectionProfileModel `tfsdk:"connection_profiles"`
 }
 
@@ -61,6 +87,17 @@ func (p *ONTAPProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "Time in seconds to wait for completion. Default to 600 seconds",
 				Optional:            true,
 			},
+			"job_poll_interval": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds between checks on the state of a long running job, such as volume create, volume move, or SnapMirror initialize. Default to 10 seconds",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"validate_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send create requests to ONTAP with validate_only during plan, for resources supporting it, to report errors before any change is made. Objects referenced by the request, such as the SVM, must already exist. Default to false",
+				Optional:            true,
+			},
 			"connection_profiles": schema.ListNestedAttribute{
 				MarkdownDescription: "Define connection and credentials",
 				Required:            true,
@@ -75,18 +112,84 @@ func (p *ONTAPProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 							Required:            true,
 						},
 						"username": schema.StringAttribute{
-							MarkdownDescription: "ONTAP management user name (cluster or svm)",
-							Required:            true,
+							MarkdownDescription: "ONTAP management user name (cluster or svm), required unless client_certificate or access_token is set",
+							Optional:            true,
 						},
 						"password": schema.StringAttribute{
-							MarkdownDescription: "ONTAP management password for username",
-							Required:            true,
+							MarkdownDescription: "ONTAP management password for username, required unless client_certificate or access_token is set",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"client_certificate": schema.StringAttribute{
+							MarkdownDescription: "PEM encoded client certificate, to authenticate with mutual TLS rather than username and password. The certificate must be installed on ONTAP, and the user enabled for the cert authentication method",
+							Optional:            true,
+						},
+						"client_private_key": schema.StringAttribute{
+							MarkdownDescription: "PEM encoded private key for client_certificate",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"access_token": schema.StringAttribute{
+							MarkdownDescription: "OAuth 2.0 access token issued by the authorization server configured on ONTAP, sent as a bearer token rather than username and password. Requires ONTAP 9.14 or later",
+							Optional:            true,
 							Sensitive:           true,
 						},
 						"validate_certs": schema.BoolAttribute{
 							MarkdownDescription: "Whether to enforce SSL certificate validation, defaults to true",
 							Optional:            true,
 						},
+						"ca_cert_file": schema.StringAttribute{
+							MarkdownDescription: "Path to a file with PEM encoded CA certificates to trust, in addition to the system roots, eg to trust a self-signed cluster certificate. Conflicts with ca_cert_pem",
+							Optional:            true,
+						},
+						"ca_cert_pem": schema.StringAttribute{
+							MarkdownDescription: "PEM encoded CA certificates to trust, in addition to the system roots. Conflicts with ca_cert_file",
+							Optional:            true,
+						},
+						"validate_hostname": schema.BoolAttribute{
+							MarkdownDescription: "Whether to verify that the cluster certificate is issued for hostname, defaults to true. When false, the certificate chain is still verified if validate_certs is true",
+							Optional:            true,
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use this profile for resources and data sources that do not set cx_profile_name, defaults to false. At most one profile can be the default. When a single profile is defined, it is always used by default",
+							Optional:            true,
+						},
+						"zapi_fallback": schema.BoolAttribute{
+							MarkdownDescription: "Whether to use ONTAPI (ZAPI) when the cluster does not support the REST API, as with ONTAP 9.5 or earlier, defaults to false. Only netapp-ontap_cluster_data_source supports ZAPI, all other resources and data sources ignore this setting and require the REST API",
+							Optional:            true,
+						},
+						"fsx": schema.BoolAttribute{
+							MarkdownDescription: "Whether hostname is the management endpoint of an Amazon FSx for NetApp ONTAP file system or SVM, defaults to false. Username defaults to fsxadmin, and APIs managed by AWS are rejected",
+							Optional:            true,
+						},
+						"connect_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds to establish a connection, including the TLS handshake. Defaults to 30 seconds",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"read_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds to wait for each request to complete, including reading the response. Defaults to 120 seconds",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of REST requests sent at the same time using this connection profile, shared by all resources and data sources. Defaults to 6",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"operation_deadline": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds allowed for each create, read, update, or delete operation, including retries and waiting for jobs. No deadline by default",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
 					},
 				},
 			},
@@ -110,7 +213,29 @@ func (p *ONTAPProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 	connectionProfiles := make(map[string]ConnectionProfile, len(data.ConnectionProfiles))
+	defaultProfileName := ""
 	for _, profile := range data.ConnectionProfiles {
+		if profile.Default.ValueBool() {
+			if defaultProfileName != "" {
+				resp.Diagnostics.AddError("more than one default connection profile", fmt.Sprintf("Connection profiles %s and %s are both marked as default.", defaultProfileName, profile.Name.ValueString()))
+				return
+			}
+			defaultProfileName = profile.Name.ValueString()
+		}
+		fsx := profile.FSx.ValueBool()
+		if fsx && profile.Username.IsNull() && profile.ClientCertificate.IsNull() && profile.AccessToken.IsNull() {
+			// fsxadmin is the only cluster scoped user on FSx
+			profile.Username = types.StringValue("fsxadmin")
+		}
+		if err := validateConnectionProfileCredentials(profile); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("invalid connection profile %s", profile.Name.ValueString()), err.Error())
+			return
+		}
+		caCertificates, err := readCACertificates(profile)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("invalid connection profile %s", profile.Name.ValueString()), err.Error())
+			return
+		}
 		var validateCerts bool
 		if profile.ValidateCerts.IsNull() {
 			validateCerts = true
@@ -118,58 +243,181 @@ func (p *ONTAPProvider) Configure(ctx context.Context, req provider.ConfigureReq
 			validateCerts = profile.ValidateCerts.ValueBool()
 		}
 		connectionProfiles[profile.Name.ValueString()] = ConnectionProfile{
-			Hostname:              profile.Hostname.ValueString(),
-			Username:              profile.Username.ValueString(),
-			Password:              profile.Password.ValueString(),
-			ValidateCerts:         validateCerts,
-			MaxConcurrentRequests: 0,
+			Hostname:                 profile.Hostname.ValueString(),
+			Username:                 profile.Username.ValueString(),
+			Password:                 profile.Password.ValueString(),
+			ValidateCerts:            validateCerts,
+			MaxConcurrentRequests:    int(profile.MaxConcurrentRequests.ValueInt64()),
+			ClientCertificate:        profile.ClientCertificate.ValueString(),
+			ClientPrivateKey:         profile.ClientPrivateKey.ValueString(),
+			AccessToken:              profile.AccessToken.ValueString(),
+			CACertificates:           caCertificates,
+			SkipHostnameVerification: !profile.ValidateHostname.IsNull() && !profile.ValidateHostname.ValueBool(),
+			FSx:                      fsx,
+			ZAPIFallback:             profile.ZAPIFallback.ValueBool(),
+			ConnectTimeout:           int(profile.ConnectTimeout.ValueInt64()),
+			ReadTimeout:              int(profile.ReadTimeout.ValueInt64()),
+			OperationDeadline:        int(profile.OperationDeadline.ValueInt64()),
 		}
 	}
 	jobCompletionTimeOut := data.JobCompletionTimeOut.ValueInt64()
 	if data.JobCompletionTimeOut.IsNull() {
 		jobCompletionTimeOut = 600
 	}
+	jobPollInterval := data.JobPollInterval.ValueInt64()
+	if data.JobPollInterval.IsNull() {
+		jobPollInterval = 10
+	}
 	config := Config{
 		ConnectionProfiles:   connectionProfiles,
+		DefaultProfileName:   defaultProfileName,
 		JobCompletionTimeOut: int(jobCompletionTimeOut),
+		JobPollInterval:      int(jobPollInterval),
+		ValidateOnly:         data.ValidateOnly.ValueBool(),
 		Version:              p.version,
+		clusterCaches:        make(map[string]*restclient.ClusterCache, len(connectionProfiles)),
+		requestSlots:         make(map[string]restclient.RequestSlots, len(connectionProfiles)),
+	}
+	for name, profile := range connectionProfiles {
+		config.clusterCaches[name] = &restclient.ClusterCache{}
+		config.requestSlots[name] = restclient.NewRequestSlots(profile.MaxConcurrentRequests)
 	}
 	resp.DataSourceData = config
 	resp.ResourceData = config
 
 }
 
+// validateConnectionProfileCredentials checks that a profile uses either username and password, a client certificate and key, or an access token
+func validateConnectionProfileCredentials(profile ConnectionProfileModel) error {
+	hasCertificate := profile.ClientCertificate.ValueString() != "" || profile.ClientPrivateKey.ValueString() != ""
+	hasPassword := profile.Username.ValueString() != "" || profile.Password.ValueString() != ""
+	if profile.AccessToken.ValueString() != "" {
+		if hasCertificate || hasPassword {
+			return errors.New("access_token cannot be used with username and password, or client_certificate")
+		}
+		return nil
+	}
+	if hasCertificate {
+		if profile.ClientCertificate.ValueString() == "" || profile.ClientPrivateKey.ValueString() == "" {
+			return errors.New("client_certificate and client_private_key must be set together")
+		}
+		if hasPassword {
+			return errors.New("username and password cannot be used with client_certificate")
+		}
+		return nil
+	}
+	if profile.Username.ValueString() == "" || profile.Password.ValueString() == "" {
+		return errors.New("username and password are required, unless client_certificate and client_private_key, or access_token are set")
+	}
+	return nil
+}
+
+// readCACertificates returns the PEM encoded CA certificates of a profile, from ca_cert_pem or the content of ca_cert_file
+func readCACertificates(profile ConnectionProfileModel) (string, error) {
+	if profile.CACertFile.ValueString() != "" && profile.CACertPEM.ValueString() != "" {
+		return "", errors.New("only one of ca_cert_file or ca_cert_pem can be set")
+	}
+	if profile.CACertFile.ValueString() == "" {
+		return profile.CACertPEM.ValueString(), nil
+	}
+	content, err := os.ReadFile(profile.CACertFile.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("failed to read ca_cert_file: %s", err)
+	}
+	return string(content), nil
+}
+
 // Resources defines the provider's resources.
 func (p *ONTAPProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAggregateResource,
+		NewCifsDomainPasswordResource,
 		NewClusterLicensingLicenseResource,
+		NewClusterResource,
 		NewClusterScheduleResource,
+		NewClusterServiceProcessorResource,
+		NewClusterStorageFailoverResource,
+		NewClusterWebResource,
+		NewConsistencyGroupResource,
+		NewEmsDestinationResource,
+		NewEmsFilterResource,
+		NewEmsFilterRuleResource,
+		NewEmsRoleConfigResource,
 		NewExampleResource,
 		NewExportPolicyResource,
 		NewExportPolicyRuleResource,
 		NewIPInterfaceResource,
 		NewIPRouteResource,
 		NewNameServicesDNSResource,
+		NewPerformanceArchiveResource,
+		NewProtocolsCIFSHomeDirectorySearchPathResource,
+		NewProtocolsFileSecurityPermissionsResource,
+		NewProtocolsLockBreakResource,
 		NewProtocolsNfsServiceResource,
+		NewProtocolsNvmeSubsystemHostResource,
+		NewProtocolsS3BucketPolicyResource,
+		NewProtocolsS3GroupResource,
+		NewProtocolsS3PolicyResource,
+		NewProtocolsS3ServiceCertificateResource,
+		NewProtocolsSanPortsetResource,
+		NewProtocolsSanWwpnAliasResource,
+		NewRestResource,
+		NewSecurityAuditResource,
+		NewSecurityAuditDestinationResource,
+		NewSecurityAuthenticationClusterOAuth2Resource,
+		NewSecurityAuthenticationClusterOAuth2ClientResource,
+		NewSecurityConfigResource,
+		NewSecurityLoginTotpResource,
 		NewSnapmirrorResource,
 		NewSnapmirrorPolicyResource,
+		NewSnapmirrorReleaseResource,
 		NewSnapshotPolicyResource,
+		NewSnmpTraphostResource,
+		NewSnmpUserResource,
+		NewStorageDiskAssignmentResource,
+		NewStorageLunResource,
+		NewStorageObjectStoreProfilerResource,
+		NewStorageSnaplockComplianceClockResource,
+		NewStorageSnaplockFileRetentionResource,
+		NewStorageSnaplockLitigationResource,
+		NewStoragePoolResource,
+		NewStorageVolumeDirectoryResource,
 		NewStorageVolumeResource,
 		NewStorageVolumeSnapshotResource,
 		NewSvmResource,
 	}
 }
 
+// Functions defines the provider's functions.
+func (p *ONTAPProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCIDRToNetmaskFunction,
+		NewNetmaskToPrefixFunction,
+		NewNFSROFunction,
+		NewNFSRWFunction,
+		NewSizeFunction,
+	}
+}
+
 // DataSources defines the provider's data sources.
 func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewBroadcastDomainDataSource,
+		NewBroadcastDomainsDataSource,
 		NewClusterDataSource,
+		NewClusterHealthDataSource,
 		NewClusterLicensingLicenseDataSource,
 		NewClusterLicensingLicensesDataSource,
+		NewClusterMetroclusterDataSource,
+		NewClusterMetroclusterDrGroupsDataSource,
+		NewClusterMetroclusterOperationsDataSource,
+		NewClusterNodeDataSource,
+		NewClusterNodesDataSource,
 		NewClusterScheduleDataSource,
 		NewClusterSchedulesDataSource,
+		NewClusterTimeDataSource,
 		NewExampleDataSource,
+		NewEthernetPortsDataSource,
 		NewExportPolicyDataSource,
 		NewExportPoliciesDataSource,
 		NewExportPolicyRuleDataSource,
@@ -180,7 +428,13 @@ func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewIPRoutesDataSource,
 		NewNameServicesDNSDataSource,
 		NewNameServicesDNSsDataSource,
+		NewProtocolsCIFSSharesDataSource,
+		NewProtocolsLocksDataSource,
 		NewProtocolsNfsServiceDataSource,
+		NewProtocolsSanIgroupsDataSource,
+		NewProtocolsSanInitiatorSessionsDataSource,
+		NewRestQueryDataSource,
+		NewSecurityCertificatesDataSource,
 		NewSnapmirrorDataSource,
 		NewSnapmirrorsDataSource,
 		NewSnapshotPoliciesDataSource,
@@ -189,13 +443,24 @@ func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewSnapmirrorPolicyDataSource,
 		NewSnapmirrorPoliciesDataSource,
 		NewStorageAggregateDataSource,
+		NewStorageAggregateSparesDataSource,
 		NewStorageAggregatesDataSource,
+		NewStorageDisksDataSource,
+		NewStorageLunDataSource,
+		NewStorageLunMetricsDataSource,
+		NewStorageLunsDataSource,
+		NewStorageVolumeComplianceGapsDataSource,
 		NewStorageVolumeSnapshotDataSource,
 		NewStorageVolumeSnapshotsDataSource,
 		NewStorageVolumeDataSource,
+		NewStorageVolumeMetricsDataSource,
+		NewStorageVolumeSpaceDataSource,
+		NewStorageVolumeRecoveryQueueDataSource,
 		NewStorageVolumesDataSource,
 		NewSvmDataSource,
+		NewSvmMetricsDataSource,
 		NewSvmsDataSource,
+		NewSvmPeersDataSource,
 	}
 }
 
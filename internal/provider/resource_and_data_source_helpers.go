@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/zapiclient"
 )
 
 type resourceOrDataSourceConfig struct {
@@ -25,6 +32,74 @@ func getRestClient(errorHandler *utils.ErrorHandler, config resourceOrDataSource
 	return config.client, nil
 }
 
+// checkVersionedAttributes reports the attributes that are not supported by the ONTAP version of the cluster.
+// It is called at plan time, and only connects to the cluster when a versioned attribute is set.
+func checkVersionedAttributes(errorHandler *utils.ErrorHandler, config resourceOrDataSourceConfig, cxProfileName types.String, api string, attributes map[string]string) {
+	if len(attributes) == 0 || cxProfileName.IsUnknown() || len(config.providerConfig.ConnectionProfiles) == 0 {
+		// the provider is not configured yet, the check is done again on apply
+		return
+	}
+	client, err := getRestClient(errorHandler, config, cxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return
+	}
+	// error reporting done inside CheckFieldsSupported
+	interfaces.CheckFieldsSupported(errorHandler, api, cluster.Version, attributes)
+}
+
+// privateState is implemented by the Private field of the resource requests and responses
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// etagPrivateKey is the private state key for the ETag of the managed object recorded on Read
+const etagPrivateKey = "etag"
+
+// saveETag records the ETag of the managed object in the resource private state, path is the API path used to GET and PATCH it.
+// Read and Update use different clients, so this is how Update sends If-Match for the object as it was last read.
+// Only resources reading their object by path record an ETag, ONTAP does not return one for a GET on a collection.
+func saveETag(ctx context.Context, client *restclient.RestClient, private privateState, path string) diag.Diagnostics {
+	tags := map[string]string{}
+	if etag := client.ETag(path); etag != "" {
+		tags[path] = etag
+	}
+	value, err := json.Marshal(tags)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to encode ETag", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, etagPrivateKey, value)
+}
+
+// restoreETag sets the ETag saved by saveETag on client, to be called before any PATCH
+func restoreETag(ctx context.Context, client *restclient.RestClient, private privateState) diag.Diagnostics {
+	value, diags := private.GetKey(ctx, etagPrivateKey)
+	if diags.HasError() || value == nil {
+		return diags
+	}
+	var tags map[string]string
+	if err := json.Unmarshal(value, &tags); err != nil {
+		diags.AddError("failed to decode ETag", fmt.Sprintf("error: %s, value: %s", err, value))
+		return diags
+	}
+	client.SetETags(tags)
+	return diags
+}
+
+// getZAPIClient creates a ZAPI client if zapi_fallback is enabled for the connection profile, otherwise it returns nil
+// It also returns nil for resources and data sources without a ZAPI implementation, see zapiFallbackResources
+func getZAPIClient(errorHandler *utils.ErrorHandler, config resourceOrDataSourceConfig, cxProfileName types.String) (*zapiclient.ZapiClient, error) {
+	return config.providerConfig.NewZAPIClient(errorHandler, cxProfileName.ValueString(), config.name)
+}
+
 // func flattenTypesInt64List(clist []int64) interface{} {
 func flattenTypesInt64List(clist []int64) []types.Int64 {
 	if len(clist) == 0 {
@@ -50,3 +125,58 @@ func flattenTypesStringList(terraformStringsList []string) []types.String {
 
 	return stringsList
 }
+
+// expandTypesStringList converts a list of terraform strings to a list of go strings
+func expandTypesStringList(terraformStringsList []types.String) []string {
+	stringsList := make([]string, len(terraformStringsList))
+	for index, record := range terraformStringsList {
+		stringsList[index] = record.ValueString()
+	}
+
+	return stringsList
+}
+
+// flattenUnorderedStringList keeps the configured order when ONTAP reports the same names, possibly in a different order
+func flattenUnorderedStringList(configured []types.String, names []string) []types.String {
+	if len(configured) == len(names) {
+		same := true
+		for _, name := range names {
+			if !StringInSlice(name, configured) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return configured
+		}
+	}
+	return flattenTypesStringList(names)
+}
+
+// expandTypesStringMap converts a map of terraform strings to a map of go strings, ignoring null values
+func expandTypesStringMap(terraformStringsMap map[string]types.String) map[string]string {
+	if len(terraformStringsMap) == 0 {
+		return nil
+	}
+	stringsMap := make(map[string]string, len(terraformStringsMap))
+	for key, value := range terraformStringsMap {
+		if !value.IsNull() {
+			stringsMap[key] = value.ValueString()
+		}
+	}
+
+	return stringsMap
+}
+
+// flattenTypesStringMap converts a map of go strings to a map of terraform strings
+func flattenTypesStringMap(stringsMap map[string]string) map[string]types.String {
+	if len(stringsMap) == 0 {
+		return nil
+	}
+	terraformStringsMap := make(map[string]types.String, len(stringsMap))
+	for key, value := range stringsMap {
+		terraformStringsMap[key] = types.StringValue(value)
+	}
+
+	return terraformStringsMap
+}
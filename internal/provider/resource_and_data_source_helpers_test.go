@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+)
+
+// testPrivateState is an in-memory privateState
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestSaveAndRestoreETag(t *testing.T) {
+	object := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"uuid": "1234"}}, ETag: "\"1\""}
+	preconditionFailed := errors.New("statusCode indicates error, without details: 412")
+	reader, err := restclient.NewMockedRestClient([]restclient.MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"name": "cluster1"}}, ETag: "\"2\""}},
+		{ExpectedMethod: "GET", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: object},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, _, err := reader.GetNilOrOneRecord("cluster", nil, nil); err != nil {
+		t.Fatalf("GetNilOrOneRecord() unexpected error = %v", err)
+	}
+	if _, _, err := reader.GetNilOrOneRecord("storage/volumes/1234", nil, nil); err != nil {
+		t.Fatalf("GetNilOrOneRecord() unexpected error = %v", err)
+	}
+	private := testPrivateState{}
+	if diags := saveETag(context.Background(), reader, private, "storage/volumes/1234"); diags.HasError() {
+		t.Fatalf("saveETag() unexpected error = %v", diags)
+	}
+	if got := string(private[etagPrivateKey]); got != `{"storage/volumes/1234":"\"1\""}` {
+		t.Errorf("saveETag() saved %s, want only the ETag of the managed object", got)
+	}
+
+	writer, err := restclient.NewMockedRestClient([]restclient.MockResponse{
+		{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 412, Response: restclient.RestResponse{}, Err: preconditionFailed},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if diags := restoreETag(context.Background(), writer, private); diags.HasError() {
+		t.Fatalf("restoreETag() unexpected error = %v", diags)
+	}
+	if _, _, err := writer.CallUpdateMethod("storage/volumes/1234", nil, map[string]any{"comment": "new"}); !errors.Is(err, restclient.ErrConcurrentModification) {
+		t.Errorf("CallUpdateMethod() error = %v, want ErrConcurrentModification", err)
+	}
+}
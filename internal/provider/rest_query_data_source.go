@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &RestQueryDataSource{}
+
+// NewRestQueryDataSource is a helper function to simplify the provider implementation.
+func NewRestQueryDataSource() datasource.DataSource {
+	return &RestQueryDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "rest_query_data_source",
+		},
+	}
+}
+
+// RestQueryDataSource defines the data source implementation.
+type RestQueryDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// RestQueryDataSourceModel describes the data source data model.
+type RestQueryDataSourceModel struct {
+	CxProfileName types.String            `tfsdk:"cx_profile_name"`
+	API           types.String            `tfsdk:"api"`
+	Query         map[string]types.String `tfsdk:"query"`
+	Fields        []types.String          `tfsdk:"fields"`
+	Records       types.String            `tfsdk:"records"`
+	NumRecords    types.Int64             `tfsdk:"num_records"`
+}
+
+// Metadata returns the data source type name.
+func (d *RestQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *RestQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads any ONTAP REST API not yet modeled by the provider, and returns the raw records",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"api": schema.StringAttribute{
+				MarkdownDescription: "REST API path, relative to /api, eg storage/qtrees",
+				Required:            true,
+			},
+			"query": schema.MapAttribute{
+				MarkdownDescription: "Query parameters, eg { \"svm.name\" = \"svm1\" }",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"fields": schema.ListAttribute{
+				MarkdownDescription: "Fields to return, ONTAP returns a default set of fields when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"records": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded list of records, use jsondecode() to read them",
+				Computed:            true,
+			},
+			"num_records": schema.Int64Attribute{
+				MarkdownDescription: "Number of records",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RestQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RestQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RestQueryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	records, err := interfaces.GetRestQueryRecords(errorHandler, *client, data.API.ValueString(), expandTypesStringMap(data.Query), expandTypesStringList(data.Fields))
+	if err != nil {
+		// error reporting done inside GetRestQueryRecords
+		return
+	}
+	if records == nil {
+		records = []map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(records)
+	if err != nil {
+		errorHandler.MakeAndReportError("error encoding records", fmt.Sprintf("error on encoding records from %s: %s", data.API.ValueString(), err))
+		return
+	}
+	data.Records = types.StringValue(string(encoded))
+	data.NumRecords = types.Int64Value(int64(len(records)))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RestResource{}
+
+// NewRestResource is a helper function to simplify the provider implementation.
+func NewRestResource() resource.Resource {
+	return &RestResource{
+		config: resourceOrDataSourceConfig{
+			name: "rest_resource",
+		},
+	}
+}
+
+// RestResource defines the resource implementation.
+type RestResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// RestResourceModel describes the resource data model.
+type RestResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	API           types.String `tfsdk:"api"`
+	ReadAPI       types.String `tfsdk:"read_api"`
+	IDAttribute   types.String `tfsdk:"id_attribute"`
+	Body          types.String `tfsdk:"body"`
+	UpdateBody    types.String `tfsdk:"update_body"`
+	Response      types.String `tfsdk:"response"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *RestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *RestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages any ONTAP REST object not yet modeled by the provider",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"api": schema.StringAttribute{
+				MarkdownDescription: "REST API path used to create the object, relative to /api, eg storage/qtrees",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"read_api": schema.StringAttribute{
+				MarkdownDescription: "REST API path used to read, update, and delete the object, {id} is replaced with the object id, eg storage/qtrees/<volume_uuid>/{id}. Defaults to api/{id}",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id_attribute": schema.StringAttribute{
+				MarkdownDescription: "Attribute of the created record identifying the object, defaults to uuid",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("uuid"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded body to create the object, and to update it unless update_body is set",
+				Required:            true,
+			},
+			"update_body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded body to update the object, when some attributes in body can only be set on create",
+				Optional:            true,
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded object, as read from read_api",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Object id, the value of id_attribute in the created record",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// readAPI returns the path to read, update, and delete the object
+func (r *RestResource) readAPI(data *RestResourceModel) string {
+	if data.ReadAPI.IsNull() {
+		return strings.TrimRight(data.API.ValueString(), "/") + "/" + data.ID.ValueString()
+	}
+	return strings.ReplaceAll(data.ReadAPI.ValueString(), "{id}", data.ID.ValueString())
+}
+
+// decodeBody converts a JSON encoded body to the REST request body
+func (r *RestResource) decodeBody(errorHandler *utils.ErrorHandler, name string, body types.String) (map[string]interface{}, error) {
+	var bodyMap map[string]interface{}
+	if err := json.Unmarshal([]byte(body.ValueString()), &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("invalid %s", name), fmt.Sprintf("%s is not a JSON object: %s", name, err))
+	}
+	return bodyMap, nil
+}
+
+// read sets response from the object, and returns false if it does not exist
+func (r *RestResource) read(errorHandler *utils.ErrorHandler, data *RestResourceModel, client restclient.RestClient) (bool, error) {
+	restInfo, err := interfaces.GetRestResource(errorHandler, client, r.readAPI(data))
+	if err != nil {
+		// error reporting done inside GetRestResource
+		return false, err
+	}
+	if restInfo == nil {
+		return false, nil
+	}
+	response, err := json.Marshal(restInfo)
+	if err != nil {
+		return false, errorHandler.MakeAndReportError("error encoding response", fmt.Sprintf("error on encoding %s response: %s", r.readAPI(data), err))
+	}
+	data.Response = types.StringValue(string(response))
+	return true, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *RestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RestResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	found, err := r.read(errorHandler, &data, *client)
+	if err != nil {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("%s not found, removing it from state", r.readAPI(&data)))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, r.readAPI(&data))...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource
+func (r *RestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RestResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body, err := r.decodeBody(errorHandler, "body", data.Body)
+	if err != nil {
+		return
+	}
+	record, err := interfaces.CreateRestResource(errorHandler, *client, data.API.ValueString(), body)
+	if err != nil {
+		return
+	}
+	id, ok := record[data.IDAttribute.ValueString()]
+	if !ok {
+		errorHandler.MakeAndReportError("error creating rest resource",
+			fmt.Sprintf("%s not found in the record returned by POST %s: %#v. Set id_attribute to an attribute of the record", data.IDAttribute.ValueString(), data.API.ValueString(), record))
+		return
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%v", id))
+
+	if _, err = r.read(errorHandler, data, *client); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *RestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RestResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	var body map[string]interface{}
+	if data.UpdateBody.IsNull() {
+		body, err = r.decodeBody(errorHandler, "body", data.Body)
+	} else {
+		body, err = r.decodeBody(errorHandler, "update_body", data.UpdateBody)
+	}
+	if err != nil {
+		return
+	}
+	if err = interfaces.UpdateRestResource(errorHandler, *client, r.readAPI(data), body); err != nil {
+		return
+	}
+
+	if _, err = r.read(errorHandler, data, *client); err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, r.readAPI(data))...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RestResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteRestResource(errorHandler, *client, r.readAPI(data))
+	if err != nil {
+		return
+	}
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityAuditDestinationResource{}
+var _ resource.ResourceWithImportState = &SecurityAuditDestinationResource{}
+
+// NewSecurityAuditDestinationResource is a helper function to simplify the provider implementation.
+func NewSecurityAuditDestinationResource() resource.Resource {
+	return &SecurityAuditDestinationResource{
+		config: resourceOrDataSourceConfig{
+			name: "security_audit_destination_resource",
+		},
+	}
+}
+
+// SecurityAuditDestinationResource defines the resource implementation.
+type SecurityAuditDestinationResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityAuditDestinationResourceModel describes the resource data model.
+type SecurityAuditDestinationResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	Address       types.String `tfsdk:"address"`
+	Port          types.Int64  `tfsdk:"port"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Facility      types.String `tfsdk:"facility"`
+	VerifyServer  types.Bool   `tfsdk:"verify_server"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityAuditDestinationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityAuditDestinationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Security audit destination resource, to forward the audit log to a remote syslog or Splunk server",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IP address or host name of the remote server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the remote server, defaults to 514. 6514 is commonly used with tcp_encrypted",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(514),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol used to send the messages, udp_unencrypted, tcp_unencrypted, or tcp_encrypted for TLS. Defaults to udp_unencrypted",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("udp_unencrypted"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("udp_unencrypted", "tcp_unencrypted", "tcp_encrypted"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"facility": schema.StringAttribute{
+				MarkdownDescription: "Syslog facility of the messages, eg user, local0 to local7. Defaults to user",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("user"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("kern", "user", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7"),
+				},
+			},
+			"verify_server": schema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate of the remote server is verified, only with tcp_encrypted. Defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Security audit destination identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityAuditDestinationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityAuditDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityAuditDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityAuditDestination(errorHandler, *client, data.Address.ValueString(), data.Port.ValueInt64())
+	if err != nil {
+		// error reporting done inside GetSecurityAuditDestination
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("audit destination %s:%d not found, removing it from state", data.Address.ValueString(), data.Port.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Protocol = types.StringValue(restInfo.Protocol)
+	data.Facility = types.StringValue(restInfo.Facility)
+	data.VerifyServer = types.BoolValue(restInfo.VerifyServer)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%d", data.Address.ValueString(), data.Port.ValueInt64()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create adds the audit log destination
+func (r *SecurityAuditDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityAuditDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SecurityAuditDestinationResourceBodyDataModelONTAP{
+		Address:      data.Address.ValueString(),
+		Port:         data.Port.ValueInt64(),
+		Protocol:     data.Protocol.ValueString(),
+		Facility:     data.Facility.ValueString(),
+		VerifyServer: data.VerifyServer.ValueBool(),
+	}
+	if err = interfaces.CreateSecurityAuditDestination(errorHandler, *client, body); err != nil {
+		return
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s_%d", data.Address.ValueString(), data.Port.ValueInt64()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the facility and server verification, the other settings require a new destination.
+func (r *SecurityAuditDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecurityAuditDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SecurityAuditDestinationResourceBodyDataModelONTAP{
+		Facility:     data.Facility.ValueString(),
+		VerifyServer: data.VerifyServer.ValueBool(),
+	}
+	if err = interfaces.UpdateSecurityAuditDestination(errorHandler, *client, data.Address.ValueString(), data.Port.ValueInt64(), body); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SecurityAuditDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityAuditDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteSecurityAuditDestination(errorHandler, *client, data.Address.ValueString(), data.Port.ValueInt64())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SecurityAuditDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a security audit destination resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: address,port,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	port, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected port to be a number, got: %q", idParts[1]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), port)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityAuditDestinationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSecurityAuditDestinationResourceConfig("user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_audit_destination_resource.example", "address", "10.193.78.10"),
+					resource.TestCheckResourceAttr("netapp-ontap_security_audit_destination_resource.example", "protocol", "udp_unencrypted"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSecurityAuditDestinationResourceConfig("local0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_audit_destination_resource.example", "facility", "local0"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_security_audit_destination_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "10.193.78.10", "514", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_audit_destination_resource.example", "facility", "local0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityAuditDestinationResourceConfig(facility string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_security_audit_destination_resource" "example" {
+  cx_profile_name = "cluster4"
+  address = "10.193.78.10"
+  facility = "%s"
+}`, host, admin, password, facility)
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityAuditResource{}
+var _ resource.ResourceWithImportState = &SecurityAuditResource{}
+
+// NewSecurityAuditResource is a helper function to simplify the provider implementation.
+func NewSecurityAuditResource() resource.Resource {
+	return &SecurityAuditResource{
+		config: resourceOrDataSourceConfig{
+			name: "security_audit_resource",
+		},
+	}
+}
+
+// SecurityAuditResource defines the resource implementation.
+type SecurityAuditResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityAuditResourceModel describes the resource data model.
+type SecurityAuditResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	Cli           types.Bool   `tfsdk:"cli"`
+	HTTP          types.Bool   `tfsdk:"http"`
+	Ontapi        types.Bool   `tfsdk:"ontapi"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityAuditResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityAuditResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster audit settings resource, to log GET requests in addition to the set requests that are always logged. The settings are cluster wide and are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"cli": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables the auditing of CLI get operations",
+				Optional:            true,
+			},
+			"http": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables the auditing of HTTP GET requests, including REST API requests",
+				Optional:            true,
+			},
+			"ontapi": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables the auditing of ONTAPI (ZAPI) get requests",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Security audit identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityAuditResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityAuditResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityAuditResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityAudit(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetSecurityAudit
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	if imported || !data.Cli.IsNull() {
+		data.Cli = types.BoolValue(restInfo.Cli)
+	}
+	if imported || !data.HTTP.IsNull() {
+		data.HTTP = types.BoolValue(restInfo.HTTP)
+	}
+	if imported || !data.Ontapi.IsNull() {
+		data.Ontapi = types.BoolValue(restInfo.Ontapi)
+	}
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security/audit")...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the audit settings, as they always exist on the cluster
+func (r *SecurityAuditResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityAuditResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SecurityAuditResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecurityAuditResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security/audit")...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings, the other settings are left unchanged.
+func (r *SecurityAuditResource) apply(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *SecurityAuditResourceModel) error {
+	body := interfaces.SecurityAuditResourceBodyDataModelONTAP{
+		Cli:    data.Cli.ValueBoolPointer(),
+		HTTP:   data.HTTP.ValueBoolPointer(),
+		Ontapi: data.Ontapi.ValueBoolPointer(),
+	}
+	return interfaces.UpdateSecurityAudit(errorHandler, client, body)
+}
+
+// Delete removes the resource from the Terraform state, the cluster settings are left unchanged.
+func (r *SecurityAuditResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityAuditResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("security audit %s removed from state, cluster settings are left unchanged", data.ID.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SecurityAuditResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityAuthenticationClusterOAuth2ClientResource{}
+var _ resource.ResourceWithImportState = &SecurityAuthenticationClusterOAuth2ClientResource{}
+
+// NewSecurityAuthenticationClusterOAuth2ClientResource is a helper function to simplify the provider implementation.
+func NewSecurityAuthenticationClusterOAuth2ClientResource() resource.Resource {
+	return &SecurityAuthenticationClusterOAuth2ClientResource{
+		config: resourceOrDataSourceConfig{
+			name: "security_authentication_cluster_oauth2_client_resource",
+		},
+	}
+}
+
+// SecurityAuthenticationClusterOAuth2ClientResource defines the resource implementation.
+type SecurityAuthenticationClusterOAuth2ClientResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityAuthenticationClusterOAuth2ClientResourceModel describes the resource data model.
+type SecurityAuthenticationClusterOAuth2ClientResourceModel struct {
+	CxProfileName          types.String                                    `tfsdk:"cx_profile_name"`
+	Name                   types.String                                    `tfsdk:"name"`
+	Application            types.String                                    `tfsdk:"application"`
+	Issuer                 types.String                                    `tfsdk:"issuer"`
+	Audience               types.String                                    `tfsdk:"audience"`
+	ClientID               types.String                                    `tfsdk:"client_id"`
+	ClientSecret           types.String                                    `tfsdk:"client_secret"`
+	Jwks                   *SecurityAuthenticationClusterOAuth2ClientJwks  `tfsdk:"jwks"`
+	Introspection          *SecurityAuthenticationClusterOAuth2ClientIntro `tfsdk:"introspection"`
+	RemoteUserClaim        types.String                                    `tfsdk:"remote_user_claim"`
+	UseLocalRolesIfPresent types.Bool                                      `tfsdk:"use_local_roles_if_present"`
+	UseMutualTLS           types.String                                    `tfsdk:"use_mutual_tls"`
+	OutgoingProxy          types.String                                    `tfsdk:"outgoing_proxy"`
+	ID                     types.String                                    `tfsdk:"id"`
+}
+
+// SecurityAuthenticationClusterOAuth2ClientJwks describes the JSON web key set used to validate the tokens locally.
+type SecurityAuthenticationClusterOAuth2ClientJwks struct {
+	ProviderURI     types.String `tfsdk:"provider_uri"`
+	RefreshInterval types.String `tfsdk:"refresh_interval"`
+}
+
+// SecurityAuthenticationClusterOAuth2ClientIntro describes the endpoint used to validate the tokens remotely.
+type SecurityAuthenticationClusterOAuth2ClientIntro struct {
+	EndpointURI types.String `tfsdk:"endpoint_uri"`
+	Interval    types.String `tfsdk:"interval"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OAuth 2.0 client resource, to configure an authorization server whose access tokens are accepted by the cluster. Requires ONTAP 9.14 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the OAuth 2.0 configuration",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application": schema.StringAttribute{
+				MarkdownDescription: "Application accepting the access tokens, defaults to http",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("http"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("http"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "URI of the authorization server issuing the access tokens",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "Audience expected in the access tokens",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client identifier, used with introspection",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret, used with introspection. It is not returned by ONTAP, so changes made outside of terraform are not detected",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jwks": schema.SingleNestedAttribute{
+				MarkdownDescription: "JSON web key set, to validate the access tokens locally. One of jwks or introspection is required",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"provider_uri": schema.StringAttribute{
+						MarkdownDescription: "URI of the JSON web key set",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"refresh_interval": schema.StringAttribute{
+						MarkdownDescription: "Refresh interval of the key set, in ISO 8601 duration format, eg PT2H",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"introspection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Token introspection endpoint, to validate the access tokens with the authorization server. One of jwks or introspection is required",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint_uri": schema.StringAttribute{
+						MarkdownDescription: "URI of the introspection endpoint",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "Interval during which a validated token is not introspected again, in ISO 8601 duration format, eg PT1H",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"remote_user_claim": schema.StringAttribute{
+				MarkdownDescription: "Claim of the access token holding the user name, defaults to sub on ONTAP",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_local_roles_if_present": schema.BoolAttribute{
+				MarkdownDescription: "Whether the roles of a local user with the same name are used, instead of the scope of the access token",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_mutual_tls": schema.StringAttribute{
+				MarkdownDescription: "Whether access tokens bound to a client certificate are accepted (request), required (required), or not checked (none). Defaults to request on ONTAP",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "request", "required"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"outgoing_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to connect to the authorization server",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityAuthenticationClusterOAuth2ClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityOAuth2Client(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSecurityOAuth2Client
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("OAuth 2.0 client %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.setModel(&data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setModel copies the ONTAP settings into the model, the client secret is not returned by ONTAP and is kept as is.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) setModel(data *SecurityAuthenticationClusterOAuth2ClientResourceModel, restInfo *interfaces.SecurityOAuth2ClientGetDataModelONTAP) {
+	data.Application = types.StringValue(restInfo.Application)
+	data.Issuer = types.StringValue(restInfo.Issuer)
+	if restInfo.Audience != "" || !data.Audience.IsNull() {
+		data.Audience = types.StringValue(restInfo.Audience)
+	}
+	if restInfo.ClientID != "" || !data.ClientID.IsNull() {
+		data.ClientID = types.StringValue(restInfo.ClientID)
+	}
+	data.Jwks = nil
+	if restInfo.Jwks != nil && restInfo.Jwks.ProviderURI != "" {
+		data.Jwks = &SecurityAuthenticationClusterOAuth2ClientJwks{
+			ProviderURI:     types.StringValue(restInfo.Jwks.ProviderURI),
+			RefreshInterval: types.StringValue(restInfo.Jwks.RefreshInterval),
+		}
+	}
+	data.Introspection = nil
+	if restInfo.Introspection != nil && restInfo.Introspection.EndpointURI != "" {
+		data.Introspection = &SecurityAuthenticationClusterOAuth2ClientIntro{
+			EndpointURI: types.StringValue(restInfo.Introspection.EndpointURI),
+			Interval:    types.StringValue(restInfo.Introspection.Interval),
+		}
+	}
+	data.RemoteUserClaim = types.StringValue(restInfo.RemoteUserClaim)
+	data.UseLocalRolesIfPresent = types.BoolValue(restInfo.UseLocalRolesIfPresent)
+	data.UseMutualTLS = types.StringValue(restInfo.UseMutualTLS)
+	if restInfo.OutgoingProxy != "" || !data.OutgoingProxy.IsNull() {
+		data.OutgoingProxy = types.StringValue(restInfo.OutgoingProxy)
+	}
+	data.ID = types.StringValue(restInfo.Name)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityAuthenticationClusterOAuth2ClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.Jwks == nil) == (data.Introspection == nil) {
+		resp.Diagnostics.AddError(
+			"Invalid OAuth 2.0 client configuration",
+			"Exactly one of jwks or introspection must be set",
+		)
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SecurityOAuth2ClientResourceBodyDataModelONTAP{
+		Name:                   data.Name.ValueString(),
+		Application:            data.Application.ValueString(),
+		Issuer:                 data.Issuer.ValueString(),
+		Audience:               data.Audience.ValueString(),
+		ClientID:               data.ClientID.ValueString(),
+		ClientSecret:           data.ClientSecret.ValueString(),
+		RemoteUserClaim:        data.RemoteUserClaim.ValueString(),
+		UseLocalRolesIfPresent: data.UseLocalRolesIfPresent.ValueBoolPointer(),
+		UseMutualTLS:           data.UseMutualTLS.ValueString(),
+		OutgoingProxy:          data.OutgoingProxy.ValueString(),
+	}
+	if data.Jwks != nil {
+		body.Jwks = &interfaces.SecurityOAuth2ClientJwks{
+			ProviderURI:     data.Jwks.ProviderURI.ValueString(),
+			RefreshInterval: data.Jwks.RefreshInterval.ValueString(),
+		}
+	}
+	if data.Introspection != nil {
+		body.Introspection = &interfaces.SecurityOAuth2ClientIntrospection{
+			EndpointURI: data.Introspection.EndpointURI.ValueString(),
+			Interval:    data.Introspection.Interval.ValueString(),
+		}
+	}
+	if err = interfaces.CreateSecurityOAuth2Client(errorHandler, *client, body); err != nil {
+		return
+	}
+
+	// read back the settings defaulted by ONTAP
+	restInfo, err := interfaces.GetSecurityOAuth2Client(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error creating OAuth 2.0 client", fmt.Sprintf("OAuth 2.0 client %s not found after create", data.Name.ValueString()))
+		return
+	}
+	r.setModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the settings that can be modified in place, the other settings require a new configuration.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SecurityAuthenticationClusterOAuth2ClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.SecurityOAuth2ClientResourceBodyDataModelONTAP
+	if data.Jwks != nil && state.Jwks != nil && !data.Jwks.RefreshInterval.Equal(state.Jwks.RefreshInterval) {
+		body.Jwks = &interfaces.SecurityOAuth2ClientJwks{RefreshInterval: data.Jwks.RefreshInterval.ValueString()}
+	}
+	if data.Introspection != nil && state.Introspection != nil && !data.Introspection.Interval.Equal(state.Introspection.Interval) {
+		body.Introspection = &interfaces.SecurityOAuth2ClientIntrospection{Interval: data.Introspection.Interval.ValueString()}
+	}
+	if !data.RemoteUserClaim.Equal(state.RemoteUserClaim) {
+		body.RemoteUserClaim = data.RemoteUserClaim.ValueString()
+	}
+	if !data.UseLocalRolesIfPresent.Equal(state.UseLocalRolesIfPresent) {
+		body.UseLocalRolesIfPresent = data.UseLocalRolesIfPresent.ValueBoolPointer()
+	}
+	if !data.OutgoingProxy.Equal(state.OutgoingProxy) {
+		body.OutgoingProxy = data.OutgoingProxy.ValueString()
+	}
+	if err = interfaces.UpdateSecurityOAuth2Client(errorHandler, *client, data.Name.ValueString(), body); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityAuthenticationClusterOAuth2ClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteSecurityOAuth2Client(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SecurityAuthenticationClusterOAuth2ClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an OAuth 2.0 client resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
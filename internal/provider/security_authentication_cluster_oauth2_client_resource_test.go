@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityAuthenticationClusterOAuth2ClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSecurityAuthenticationClusterOAuth2ClientResourceConfig("sub"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_client_resource.example", "name", "acc_test"),
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_client_resource.example", "application", "http"),
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_client_resource.example", "jwks.refresh_interval", "PT2H"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSecurityAuthenticationClusterOAuth2ClientResourceConfig("email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_client_resource.example", "remote_user_claim", "email"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_security_authentication_cluster_oauth2_client_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "acc_test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_client_resource.example", "remote_user_claim", "email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityAuthenticationClusterOAuth2ClientResourceConfig(remoteUserClaim string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_security_authentication_cluster_oauth2_client_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "acc_test"
+  issuer = "https://examplelab.customer.com"
+  audience = "https://ontap"
+  jwks = {
+    provider_uri = "https://examplelab.customer.com/.well-known/jwks.json"
+    refresh_interval = "PT2H"
+  }
+  remote_user_claim = "%s"
+}`, host, admin, password, remoteUserClaim)
+}
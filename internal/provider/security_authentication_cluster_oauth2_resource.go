@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityAuthenticationClusterOAuth2Resource{}
+var _ resource.ResourceWithImportState = &SecurityAuthenticationClusterOAuth2Resource{}
+
+// NewSecurityAuthenticationClusterOAuth2Resource is a helper function to simplify the provider implementation.
+func NewSecurityAuthenticationClusterOAuth2Resource() resource.Resource {
+	return &SecurityAuthenticationClusterOAuth2Resource{
+		config: resourceOrDataSourceConfig{
+			name: "security_authentication_cluster_oauth2_resource",
+		},
+	}
+}
+
+// SecurityAuthenticationClusterOAuth2Resource defines the resource implementation.
+type SecurityAuthenticationClusterOAuth2Resource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityAuthenticationClusterOAuth2ResourceModel describes the resource data model.
+type SecurityAuthenticationClusterOAuth2ResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster OAuth 2.0 resource, to enable or disable the authorization of REST API requests with access tokens. The setting is cluster wide and is left unchanged on delete. Requires ONTAP 9.14 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables OAuth 2.0 authorization. At least one authorization server must be configured before it is enabled",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityAuthenticationClusterOAuth2ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityOAuth2(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetSecurityOAuth2
+		return
+	}
+
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security/authentication/cluster/oauth2")...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the OAuth 2.0 setting, as it always exists on the cluster
+func (r *SecurityAuthenticationClusterOAuth2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityAuthenticationClusterOAuth2ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecurityAuthenticationClusterOAuth2ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	if err = r.apply(errorHandler, *client, data); err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security/authentication/cluster/oauth2")...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply enables or disables OAuth 2.0 authorization.
+func (r *SecurityAuthenticationClusterOAuth2Resource) apply(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *SecurityAuthenticationClusterOAuth2ResourceModel) error {
+	return interfaces.UpdateSecurityOAuth2(errorHandler, client, data.Enabled.ValueBool())
+}
+
+// Delete removes the resource from the Terraform state, the cluster setting is left unchanged.
+func (r *SecurityAuthenticationClusterOAuth2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityAuthenticationClusterOAuth2ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("OAuth 2.0 %s removed from state, cluster setting is left unchanged", data.ID.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SecurityAuthenticationClusterOAuth2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
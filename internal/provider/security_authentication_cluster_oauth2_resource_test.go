@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityAuthenticationClusterOAuth2Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSecurityAuthenticationClusterOAuth2ResourceConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_resource.example", "enabled", "false"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSecurityAuthenticationClusterOAuth2ResourceConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_resource.example", "enabled", "true"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_security_authentication_cluster_oauth2_resource.example",
+				ImportState:   true,
+				ImportStateId: "cluster4",
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_authentication_cluster_oauth2_resource.example", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityAuthenticationClusterOAuth2ResourceConfig(enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_security_authentication_cluster_oauth2_client_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "acc_test_enable"
+  issuer = "https://examplelab.customer.com"
+  jwks = {
+    provider_uri = "https://examplelab.customer.com/.well-known/jwks.json"
+  }
+}
+
+resource "netapp-ontap_security_authentication_cluster_oauth2_resource" "example" {
+  cx_profile_name = "cluster4"
+  enabled = %t
+  depends_on = [netapp-ontap_security_authentication_cluster_oauth2_client_resource.example]
+}`, host, admin, password, enabled)
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SecurityCertificatesDataSource{}
+
+// NewSecurityCertificatesDataSource is a helper function to simplify the provider implementation.
+func NewSecurityCertificatesDataSource() datasource.DataSource {
+	return &SecurityCertificatesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "security_certificates_data_source",
+		},
+	}
+}
+
+// SecurityCertificatesDataSource defines the data source implementation.
+type SecurityCertificatesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityCertificatesDataSourceModel describes the data source data model.
+type SecurityCertificatesDataSourceModel struct {
+	CxProfileName        types.String                              `tfsdk:"cx_profile_name"`
+	SecurityCertificates []SecurityCertificateDataSourceModel      `tfsdk:"security_certificates"`
+	Filter               *SecurityCertificateDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// SecurityCertificateDataSourceModel describes the data model for a certificate.
+type SecurityCertificateDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	ID           types.String `tfsdk:"id"`
+	CommonName   types.String `tfsdk:"common_name"`
+	Type         types.String `tfsdk:"type"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	CA           types.String `tfsdk:"ca"`
+	ExpiryTime   types.String `tfsdk:"expiry_time"`
+	Scope        types.String `tfsdk:"scope"`
+	SVMName      types.String `tfsdk:"svm_name"`
+}
+
+// SecurityCertificateDataSourceFilterModel describes the data source data model for queries.
+type SecurityCertificateDataSourceFilterModel struct {
+	Type       types.String `tfsdk:"type"`
+	CommonName types.String `tfsdk:"common_name"`
+	SVMName    types.String `tfsdk:"svm_name"`
+	Scope      types.String `tfsdk:"scope"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecurityCertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *SecurityCertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Security certificates data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Certificate type, eg server, client, root_ca, server_ca, client_ca",
+						Optional:            true,
+					},
+					"common_name": schema.StringAttribute{
+						MarkdownDescription: "Certificate common name, wildcards are supported",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Certificate svm name",
+						Optional:            true,
+					},
+					"scope": schema.StringAttribute{
+						MarkdownDescription: "Certificate scope, cluster or svm",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("cluster", "svm"),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"security_certificates": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Certificate name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Certificate UUID",
+							Computed:            true,
+						},
+						"common_name": schema.StringAttribute{
+							MarkdownDescription: "Certificate common name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Certificate type",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "Certificate serial number",
+							Computed:            true,
+						},
+						"ca": schema.StringAttribute{
+							MarkdownDescription: "Certificate authority that issued the certificate",
+							Computed:            true,
+						},
+						"expiry_time": schema.StringAttribute{
+							MarkdownDescription: "Certificate expiration time, in RFC 3339 format, eg 2025-01-20T10:00:00-05:00",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Certificate scope, cluster or svm",
+							Computed:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Certificate svm name, empty for cluster scoped certificates",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecurityCertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecurityCertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecurityCertificatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.SecurityCertificateDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.SecurityCertificateDataSourceFilterModel{
+			Type:       data.Filter.Type.ValueString(),
+			CommonName: data.Filter.CommonName.ValueString(),
+			SVMName:    data.Filter.SVMName.ValueString(),
+			Scope:      data.Filter.Scope.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListSecurityCertificates(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListSecurityCertificates
+		return
+	}
+
+	data.SecurityCertificates = make([]SecurityCertificateDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.SecurityCertificates[index] = SecurityCertificateDataSourceModel{
+			Name:         types.StringValue(record.Name),
+			ID:           types.StringValue(record.UUID),
+			CommonName:   types.StringValue(record.CommonName),
+			Type:         types.StringValue(record.Type),
+			SerialNumber: types.StringValue(record.SerialNumber),
+			CA:           types.StringValue(record.CA),
+			ExpiryTime:   types.StringValue(record.ExpiryTime),
+			Scope:        types.StringValue(record.Scope),
+			SVMName:      types.StringValue(record.SVM.Name),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityConfigResource{}
+var _ resource.ResourceWithImportState = &SecurityConfigResource{}
+
+// NewSecurityConfigResource is a helper function to simplify the provider implementation.
+func NewSecurityConfigResource() resource.Resource {
+	return &SecurityConfigResource{
+		config: resourceOrDataSourceConfig{
+			name: "security_config_resource",
+		},
+	}
+}
+
+// SecurityConfigResource defines the resource implementation.
+type SecurityConfigResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityConfigResourceModel describes the resource data model.
+type SecurityConfigResourceModel struct {
+	CxProfileName       types.String   `tfsdk:"cx_profile_name"`
+	FipsEnabled         types.Bool     `tfsdk:"fips_enabled"`
+	TLSProtocolVersions []types.String `tfsdk:"tls_protocol_versions"`
+	TLSCipherSuites     []types.String `tfsdk:"tls_cipher_suites"`
+	ID                  types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cluster security config resource. The settings are cluster wide and are left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"fips_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables FIPS 140-2 compliant mode. The HTTPS server restarts and nodes need to be rebooted for the change to take effect",
+				Optional:            true,
+			},
+			"tls_protocol_versions": schema.ListAttribute{
+				MarkdownDescription: "Supported protocol versions, eg tls1.2, tls1.3. tls1 and tls1.1 are not allowed in FIPS mode",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tls_cipher_suites": schema.ListAttribute{
+				MarkdownDescription: "Supported cipher suites, using IANA names",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Security config identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityConfig(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetSecurityConfig
+		return
+	}
+
+	// only report the settings that are managed by terraform, or all of them on import
+	imported := data.ID.IsNull()
+	if imported || !data.FipsEnabled.IsNull() {
+		data.FipsEnabled = types.BoolValue(restInfo.Fips.Enabled)
+	}
+	if imported || data.TLSProtocolVersions != nil {
+		data.TLSProtocolVersions = flattenTypesStringList(restInfo.TLS.ProtocolVersions)
+	}
+	if imported || data.TLSCipherSuites != nil {
+		data.TLSCipherSuites = flattenTypesStringList(restInfo.TLS.CipherSuites)
+	}
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security")...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create applies the security config, as it always exists on the cluster
+func (r *SecurityConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	current, err := interfaces.GetSecurityConfig(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	if err = r.apply(errorHandler, *client, data, current); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.CxProfileName.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SecurityConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecurityConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	current, err := interfaces.GetSecurityConfig(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	if err = r.apply(errorHandler, *client, data, current); err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "security")...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the TLS and FIPS changes in an order ONTAP accepts:
+// older TLS versions need to be removed before enabling FIPS, and can only be added back after disabling FIPS.
+func (r *SecurityConfigResource) apply(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *SecurityConfigResourceModel, current *interfaces.SecurityConfigGetDataModelONTAP) error {
+	var fips *interfaces.SecurityConfigFips
+	if !data.FipsEnabled.IsNull() && data.FipsEnabled.ValueBool() != current.Fips.Enabled {
+		fips = &interfaces.SecurityConfigFips{Enabled: data.FipsEnabled.ValueBool()}
+	}
+	var tls *interfaces.SecurityConfigTLS
+	if data.TLSProtocolVersions != nil || data.TLSCipherSuites != nil {
+		tls = &interfaces.SecurityConfigTLS{}
+		for _, version := range data.TLSProtocolVersions {
+			if data.FipsEnabled.ValueBool() && (version.ValueString() == "tls1" || version.ValueString() == "tls1.1") {
+				return errorHandler.MakeAndReportError("invalid tls_protocol_versions", fmt.Sprintf("%s is not supported when fips_enabled is true", version.ValueString()))
+			}
+			tls.ProtocolVersions = append(tls.ProtocolVersions, version.ValueString())
+		}
+		for _, suite := range data.TLSCipherSuites {
+			tls.CipherSuites = append(tls.CipherSuites, suite.ValueString())
+		}
+	}
+
+	timeout := r.config.providerConfig.JobCompletionTimeOut
+	if fips != nil && !fips.Enabled {
+		// disable FIPS first, so that older TLS versions are accepted
+		if err := interfaces.UpdateSecurityConfig(errorHandler, client, interfaces.SecurityConfigResourceBodyDataModelONTAP{Fips: fips}, timeout); err != nil {
+			return err
+		}
+		fips = nil
+	}
+	if tls != nil {
+		if err := interfaces.UpdateSecurityConfig(errorHandler, client, interfaces.SecurityConfigResourceBodyDataModelONTAP{TLS: tls}, timeout); err != nil {
+			return err
+		}
+	}
+	if fips != nil {
+		if err := interfaces.UpdateSecurityConfig(errorHandler, client, interfaces.SecurityConfigResourceBodyDataModelONTAP{Fips: fips}, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the resource from the Terraform state, the cluster settings are left unchanged.
+func (r *SecurityConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("security config %s removed from state, cluster settings are left unchanged", data.ID.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SecurityConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
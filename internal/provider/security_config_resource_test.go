@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSecurityConfigResourceConfig(`["tls1.3", "tls1.2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_config_resource.example", "tls_protocol_versions.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_security_config_resource.example", "tls_protocol_versions.0", "tls1.3"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSecurityConfigResourceConfig(`["tls1.2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_config_resource.example", "tls_protocol_versions.#", "1"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_security_config_resource.example",
+				ImportState:   true,
+				ImportStateId: "cluster4",
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_config_resource.example", "fips_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityConfigResourceConfig(protocolVersions string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_security_config_resource" "example" {
+  cx_profile_name = "cluster4"
+  fips_enabled = false
+  tls_protocol_versions = %s
+}`, host, admin, password, protocolVersions)
+}
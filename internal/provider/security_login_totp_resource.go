@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecurityLoginTotpResource{}
+var _ resource.ResourceWithImportState = &SecurityLoginTotpResource{}
+
+// NewSecurityLoginTotpResource is a helper function to simplify the provider implementation.
+func NewSecurityLoginTotpResource() resource.Resource {
+	return &SecurityLoginTotpResource{
+		config: resourceOrDataSourceConfig{
+			name: "security_login_totp_resource",
+		},
+	}
+}
+
+// SecurityLoginTotpResource defines the resource implementation.
+type SecurityLoginTotpResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SecurityLoginTotpResourceModel describes the resource data model.
+type SecurityLoginTotpResourceModel struct {
+	CxProfileName  types.String `tfsdk:"cx_profile_name"`
+	SVMName        types.String `tfsdk:"svm_name"`
+	AccountName    types.String `tfsdk:"account_name"`
+	Comment        types.String `tfsdk:"comment"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	EnforceForSSH  types.Bool   `tfsdk:"enforce_for_ssh"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	InstallURL     types.String `tfsdk:"install_url"`
+	EmergencyCodes types.List   `tfsdk:"emergency_codes"`
+	ID             types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SecurityLoginTotpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SecurityLoginTotpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "TOTP profile resource, to configure a time-based one-time password for an account, and optionally require it as the second factor of SSH logins. Requires ONTAP 9.13 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM owning the account, the account is a cluster account when not set",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the login account",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment for the TOTP profile",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the TOTP profile is enabled, defaults to true",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"enforce_for_ssh": schema.BoolAttribute{
+				MarkdownDescription: "Whether TOTP is required as the second authentication method of the SSH logins of the account, defaults to false. When true, it is reset to none before the profile is deleted",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "Secret key to configure the authenticator app, only returned by ONTAP when the profile is created",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"install_url": schema.StringAttribute{
+				MarkdownDescription: "otpauth URL to configure the authenticator app, only returned by ONTAP when the profile is created",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emergency_codes": schema.ListAttribute{
+				MarkdownDescription: "Emergency codes to log in without the authenticator app, only returned by ONTAP when the profile is created",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TOTP profile identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SecurityLoginTotpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SecurityLoginTotpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityLoginTotpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSecurityLoginTotp(errorHandler, *client, data.SVMName.ValueString(), data.AccountName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSecurityLoginTotp
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("TOTP profile of %s not found, removing it from state", data.AccountName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	if restInfo.Comment != "" || !data.Comment.IsNull() {
+		data.Comment = types.StringValue(restInfo.Comment)
+	}
+	account, err := interfaces.GetSecurityAccount(errorHandler, *client, data.SVMName.ValueString(), data.AccountName.ValueString())
+	if err != nil {
+		return
+	}
+	data.EnforceForSSH = types.BoolValue(account != nil && sshSecondFactorIsTotp(account.Applications))
+	// the secrets are only returned on create
+	if data.SecretKey.IsNull() {
+		data.SecretKey = types.StringValue("")
+		data.InstallURL = types.StringValue("")
+		data.EmergencyCodes = types.ListValueMust(types.StringType, nil)
+	}
+	data.ID = types.StringValue(restInfo.Owner.UUID + "/" + restInfo.Account.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sshSecondFactorIsTotp returns true if all the SSH logins require TOTP as the second authentication method
+func sshSecondFactorIsTotp(applications []interfaces.SecurityAccountApplication) bool {
+	found := false
+	for _, application := range applications {
+		if application.Application != "ssh" {
+			continue
+		}
+		if application.SecondAuthenticationMethod != "totp" {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// setSSHSecondFactor sets the second authentication method of the SSH logins of the account to totp or none
+func (r *SecurityLoginTotpResource) setSSHSecondFactor(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *SecurityLoginTotpResourceModel, enforce bool) error {
+	account, err := interfaces.GetSecurityAccount(errorHandler, client, data.SVMName.ValueString(), data.AccountName.ValueString())
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errorHandler.MakeAndReportError("error updating account", fmt.Sprintf("account %s not found", data.AccountName.ValueString()))
+	}
+	method := "none"
+	if enforce {
+		method = "totp"
+	}
+	found := false
+	for i := range account.Applications {
+		if account.Applications[i].Application == "ssh" {
+			account.Applications[i].SecondAuthenticationMethod = method
+			found = true
+		}
+	}
+	if !found {
+		return errorHandler.MakeAndReportError("error updating account", fmt.Sprintf("account %s cannot log in with ssh", data.AccountName.ValueString()))
+	}
+	return interfaces.UpdateSecurityAccountApplications(errorHandler, client, account.Owner.UUID, account.Name, account.Applications)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SecurityLoginTotpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecurityLoginTotpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SecurityLoginTotpResourceBodyDataModelONTAP{
+		Account: &interfaces.SecurityLoginTotpUser{Name: data.AccountName.ValueString()},
+		Comment: data.Comment.ValueStringPointer(),
+	}
+	if !data.SVMName.IsNull() {
+		body.Owner = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	}
+	restInfo, err := interfaces.CreateSecurityLoginTotp(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+	ownerUUID := restInfo.Owner.UUID
+	if ownerUUID == "" {
+		totp, err := interfaces.GetSecurityLoginTotp(errorHandler, *client, data.SVMName.ValueString(), data.AccountName.ValueString())
+		if err != nil {
+			return
+		}
+		if totp == nil {
+			errorHandler.MakeAndReportError("error creating TOTP profile", fmt.Sprintf("TOTP profile of %s not found after create", data.AccountName.ValueString()))
+			return
+		}
+		ownerUUID = totp.Owner.UUID
+	}
+	data.SecretKey = types.StringValue(restInfo.SecretKey)
+	data.InstallURL = types.StringValue(restInfo.InstallURL)
+	emergencyCodes, diags := types.ListValueFrom(ctx, types.StringType, restInfo.EmergencyCodes)
+	resp.Diagnostics.Append(diags...)
+	data.EmergencyCodes = emergencyCodes
+	data.ID = types.StringValue(ownerUUID + "/" + data.AccountName.ValueString())
+
+	// a profile is enabled when it is created
+	if !data.Enabled.ValueBool() {
+		enabled := false
+		if err = interfaces.UpdateSecurityLoginTotp(errorHandler, *client, ownerUUID, data.AccountName.ValueString(), interfaces.SecurityLoginTotpResourceBodyDataModelONTAP{Enabled: &enabled}); err != nil {
+			return
+		}
+	}
+	if data.EnforceForSSH.ValueBool() {
+		if err = r.setSSHSecondFactor(errorHandler, *client, data, true); err != nil {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SecurityLoginTotpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SecurityLoginTotpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	ownerUUID := strings.SplitN(state.ID.ValueString(), "/", 2)[0]
+	var body interfaces.SecurityLoginTotpResourceBodyDataModelONTAP
+	if !data.Enabled.Equal(state.Enabled) {
+		body.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	if !data.Comment.Equal(state.Comment) {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	if err = interfaces.UpdateSecurityLoginTotp(errorHandler, *client, ownerUUID, data.AccountName.ValueString(), body); err != nil {
+		return
+	}
+	if !data.EnforceForSSH.Equal(state.EnforceForSSH) {
+		if err = r.setSSHSecondFactor(errorHandler, *client, data, data.EnforceForSSH.ValueBool()); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SecurityLoginTotpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecurityLoginTotpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// ONTAP rejects deleting a profile that is required to log in
+	if data.EnforceForSSH.ValueBool() {
+		if err = r.setSSHSecondFactor(errorHandler, *client, data, false); err != nil {
+			return
+		}
+	}
+	ownerUUID := strings.SplitN(data.ID.ValueString(), "/", 2)[0]
+	err = interfaces.DeleteSecurityLoginTotp(errorHandler, *client, ownerUUID, data.AccountName.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ID is account_name,cx_profile_name for a cluster account, or account_name,svm_name,cx_profile_name for an SVM account.
+func (r *SecurityLoginTotpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a TOTP profile resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	switch {
+	case len(idParts) == 2 && idParts[0] != "" && idParts[1] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+	case len(idParts) == 3 && idParts[0] != "" && idParts[1] != "" && idParts[2] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: account_name,cx_profile_name or account_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityLoginTotpResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSecurityLoginTotpResourceConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_login_totp_resource.example", "account_name", "acc_test_totp"),
+					resource.TestCheckResourceAttr("netapp-ontap_security_login_totp_resource.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_security_login_totp_resource.example", "secret_key"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSecurityLoginTotpResourceConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_login_totp_resource.example", "enforce_for_ssh", "true"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_security_login_totp_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "acc_test_totp", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_security_login_totp_resource.example", "enforce_for_ssh", "true"),
+				),
+			},
+		},
+	})
+}
+
+// the acc_test_totp cluster account must exist, with the ssh application and the password authentication method
+func testAccSecurityLoginTotpResourceConfig(enforceForSSH bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_security_login_totp_resource" "example" {
+  cx_profile_name = "cluster4"
+  account_name = "acc_test_totp"
+  comment = "terraform acceptance test"
+  enforce_for_ssh = %t
+}`, host, admin, password, enforceForSSH)
+}
@@ -31,16 +31,20 @@ type SnapmirrorDataSource struct {
 
 // SnapmirrorDataSourceModel describes the data source data model.
 type SnapmirrorDataSourceModel struct {
-	CxProfileName types.String      `tfsdk:"cx_profile_name"`
-	Source        *Source           `tfsdk:"source"`
-	Destination   *Destination      `tfsdk:"destination"`
-	Healthy       types.Bool        `tfsdk:"healthy"`
-	Restore       types.Bool        `tfsdk:"restore"`
-	ID            types.String      `tfsdk:"id"`
-	State         types.String      `tfsdk:"state"`
-	Policy        *SnapmirrorPolicy `tfsdk:"policy"`
-	GroupType     types.String      `tfsdk:"group_type"`
-	Throttle      types.Int64       `tfsdk:"throttle"`
+	CxProfileName    types.String        `tfsdk:"cx_profile_name"`
+	Source           *Source             `tfsdk:"source"`
+	Destination      *Destination        `tfsdk:"destination"`
+	Healthy          types.Bool          `tfsdk:"healthy"`
+	Restore          types.Bool          `tfsdk:"restore"`
+	ID               types.String        `tfsdk:"id"`
+	State            types.String        `tfsdk:"state"`
+	Policy           *SnapmirrorPolicy   `tfsdk:"policy"`
+	GroupType        types.String        `tfsdk:"group_type"`
+	Throttle         types.Int64         `tfsdk:"throttle"`
+	LagTime          types.String        `tfsdk:"lag_time"`
+	LagTimeSeconds   types.Int64         `tfsdk:"lag_time_seconds"`
+	UnhealthyReasons []types.String      `tfsdk:"unhealthy_reasons"`
+	LastTransfer     *SnapmirrorTransfer `tfsdk:"last_transfer"`
 }
 
 // Source describes data source model
@@ -68,6 +72,14 @@ type SnapmirrorCluster struct {
 	UUID types.String `tfsdk:"uuid"`
 }
 
+// SnapmirrorTransfer describes data source model
+type SnapmirrorTransfer struct {
+	State            types.String `tfsdk:"state"`
+	BytesTransferred types.Int64  `tfsdk:"bytes_transferred"`
+	EndTime          types.String `tfsdk:"end_time"`
+	TotalDuration    types.String `tfsdk:"total_duration"`
+}
+
 // SnapmirrorPolicy describes data source model
 type SnapmirrorPolicy struct {
 	UUID types.String `tfsdk:"uuid"`
@@ -87,7 +99,7 @@ func (d *SnapmirrorDataSource) Schema(ctx context.Context, req datasource.Schema
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"source": schema.SingleNestedAttribute{
 				MarkdownDescription: "Snapmirror source endpoint",
@@ -185,6 +197,41 @@ func (d *SnapmirrorDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "throttle of the relationship",
 				Computed:            true,
 			},
+			"lag_time": schema.StringAttribute{
+				MarkdownDescription: "Time since the exported snapshot was created, as an ISO 8601 duration, eg PT8H35M42S",
+				Computed:            true,
+			},
+			"lag_time_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Time since the exported snapshot was created, in seconds",
+				Computed:            true,
+			},
+			"unhealthy_reasons": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Reasons why the relationship is not healthy",
+				Computed:            true,
+			},
+			"last_transfer": schema.SingleNestedAttribute{
+				MarkdownDescription: "Current or last transfer, null until a first transfer starts",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Transfer state, eg transferring, success, failed, or aborted",
+						Computed:            true,
+					},
+					"bytes_transferred": schema.Int64Attribute{
+						MarkdownDescription: "Bytes transferred",
+						Computed:            true,
+					},
+					"end_time": schema.StringAttribute{
+						MarkdownDescription: "End time of the transfer, in ISO 8601 format, empty while the transfer is running",
+						Computed:            true,
+					},
+					"total_duration": schema.StringAttribute{
+						MarkdownDescription: "Duration of the transfer, as an ISO 8601 duration, eg PT3M20S",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -265,8 +312,9 @@ func (d *SnapmirrorDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		ID:      types.StringValue(restInfo.UUID),
 		State:   types.StringValue(restInfo.State),
 	}
+	flattenSnapmirrorHealth(ctx, &data, restInfo)
 
-	if cluster.Version.Generation == 9 && cluster.Version.Major > 10 {
+	if interfaces.SupportsField("snapmirror/relationships", cluster.Version, "throttle") {
 		data.Throttle = types.Int64Value(int64(restInfo.Throttle))
 		data.GroupType = types.StringValue(restInfo.GroupType)
 	}
@@ -278,3 +326,33 @@ func (d *SnapmirrorDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// flattenSnapmirrorHealth sets the lag time, the unhealthy reasons, and the last transfer of a relationship
+func flattenSnapmirrorHealth(ctx context.Context, data *SnapmirrorDataSourceModel, record *interfaces.SnapmirrorDataSourceModel) {
+	data.LagTime = types.StringNull()
+	data.LagTimeSeconds = types.Int64Null()
+	// lag_time is not reported until a first transfer completes
+	if record.LagTime != "" {
+		data.LagTime = types.StringValue(record.LagTime)
+		seconds, err := interfaces.ParseSnapmirrorLagTime(record.LagTime)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("ignoring lag time for relationship %s: %s", record.UUID, err))
+		} else {
+			data.LagTimeSeconds = types.Int64Value(seconds)
+		}
+	}
+	reasons := make([]string, len(record.UnhealthyReason))
+	for index, reason := range record.UnhealthyReason {
+		reasons[index] = reason.Message
+	}
+	data.UnhealthyReasons = flattenTypesStringList(reasons)
+	data.LastTransfer = nil
+	if record.Transfer != nil {
+		data.LastTransfer = &SnapmirrorTransfer{
+			State:            types.StringValue(record.Transfer.State),
+			BytesTransferred: types.Int64Value(record.Transfer.BytesTransferred),
+			EndTime:          types.StringValue(record.Transfer.EndTime),
+			TotalDuration:    types.StringValue(record.Transfer.TotalDuration),
+		}
+	}
+}
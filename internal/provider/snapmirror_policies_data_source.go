@@ -51,7 +51,7 @@ func (d *SnapmirrorPoliciesDataSource) Schema(ctx context.Context, req datasourc
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -237,10 +237,10 @@ func (d *SnapmirrorPoliciesDataSource) Read(ctx context.Context, req datasource.
 			ID:                        types.StringValue(record.UUID),
 		}
 
-		if cluster.Version.Generation == 9 && cluster.Version.Major > 9 {
+		if interfaces.SupportsField("snapmirror/policies", cluster.Version, "copy_all_source_snapshots") {
 			data.SnapmirrorPolicies[index].CopyAllSourceSnapshots = types.BoolValue(record.CopyAllSourceSnapshots)
 		}
-		if cluster.Version.Generation == 9 && cluster.Version.Major > 10 {
+		if interfaces.SupportsField("snapmirror/policies", cluster.Version, "copy_latest_source_snapshot") {
 			data.SnapmirrorPolicies[index].CreateSnapshotOnSource = types.BoolValue(record.CreateSnapshotOnSource)
 			data.SnapmirrorPolicies[index].CopyLatestSourceSnapshot = types.BoolValue(record.CopyLatestSourceSnapshot)
 		}
@@ -75,7 +75,7 @@ func (d *SnapmirrorPolicyDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "SnapmirrorPolicy name",
@@ -238,10 +238,10 @@ func (d *SnapmirrorPolicyDataSource) Read(ctx context.Context, req datasource.Re
 		data.Retention = retentions
 	}
 
-	if cluster.Version.Generation == 9 && cluster.Version.Major > 9 {
+	if interfaces.SupportsField("snapmirror/policies", cluster.Version, "copy_all_source_snapshots") {
 		data.CopyAllSourceSnapshots = types.BoolValue(restInfo.CopyAllSourceSnapshots)
 	}
-	if cluster.Version.Generation == 9 && cluster.Version.Major > 10 {
+	if interfaces.SupportsField("snapmirror/policies", cluster.Version, "copy_latest_source_snapshot") {
 		data.CreateSnapshotOnSource = types.BoolValue(restInfo.CreateSnapshotOnSource)
 		data.CopyLatestSourceSnapshot = types.BoolValue(restInfo.CopyLatestSourceSnapshot)
 	}
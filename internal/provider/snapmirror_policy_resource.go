@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -26,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &SnapmirrorPolicyResource{}
 var _ resource.ResourceWithImportState = &SnapmirrorPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &SnapmirrorPolicyResource{}
 
 // NewSnapmirrorPolicyResource is a helper function to simplify the provider implementation.
 func NewSnapmirrorPolicyResource() resource.Resource {
@@ -56,6 +58,7 @@ type SnapmirrorPolicyResourceModel struct {
 	CopyAllSourceSnapshots    types.Bool       `tfsdk:"copy_all_source_snapshots"`
 	CopyLatestSourceSnapshot  types.Bool       `tfsdk:"copy_latest_source_snapshot"`
 	CreateSnapshotOnSource    types.Bool       `tfsdk:"create_snapshot_on_source"`
+	RetainOnDestroy           types.Bool       `tfsdk:"retain_on_destroy"`
 	ID                        types.String     `tfsdk:"id"`
 }
 
@@ -80,7 +83,7 @@ func (r *SnapmirrorPolicyResource) Schema(ctx context.Context, req resource.Sche
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "SnapmirrorPolicy name",
@@ -131,7 +134,7 @@ func (r *SnapmirrorPolicyResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"retention": schema.ListNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "Rules for Snapshot copy retention.",
+				MarkdownDescription: "Rules for Snapshot copy retention. Rules are identified by their label, they can be added, modified, or removed without replacing the policy.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"creation_schedule_name": schema.StringAttribute{
@@ -155,9 +158,6 @@ func (r *SnapmirrorPolicyResource) Schema(ctx context.Context, req resource.Sche
 							MarkdownDescription: "Specifies the prefix for the Snapshot copy name to be created as per the schedule",
 							Optional:            true,
 							Computed:            true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 							Validators: []validator.String{
 								stringvalidator.ConflictsWith(path.Expressions{
 									path.MatchRoot("sync_type"),
@@ -210,6 +210,10 @@ func (r *SnapmirrorPolicyResource) Schema(ctx context.Context, req resource.Sche
 					}...),
 				},
 			},
+			"retain_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When true, destroying the resource only removes it from the Terraform state, and the snapmirror policy is kept on the cluster. Defaults to false",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -255,6 +259,20 @@ func (r *SnapmirrorPolicyResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// on import, only the name and svm are known, resolve the policy UUID from them
+	if data.ID.IsNull() {
+		policy, err := interfaces.GetSnapmirrorPolicyByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+		if err != nil {
+			// error reporting done inside GetSnapmirrorPolicyByName
+			return
+		}
+		if policy.UUID == "" {
+			errorHandler.MakeAndReportError("No snapmirror policy found", fmt.Sprintf("snapmirror policy %s not found.", data.Name.ValueString()))
+			return
+		}
+		data.ID = types.StringValue(policy.UUID)
+	}
+
 	restInfo, err := interfaces.GetSnapmirrorPolicy(errorHandler, *client, data.ID.ValueString())
 	if err != nil {
 		// error reporting done inside GETSnapmirrorPolicy
@@ -305,6 +323,7 @@ func (r *SnapmirrorPolicyResource) Read(ctx context.Context, req resource.ReadRe
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a snapmirror policy resource: %#v", data))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "snapmirror/policies/"+data.ID.ValueString())...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -443,6 +462,7 @@ func (r *SnapmirrorPolicyResource) Update(ctx context.Context, req resource.Upda
 		// error reporting done inside NewClient
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 	// sync type -
 	// not support: transfer_schedule_name, retention.prefix, retention.creation_schedule_name, identity_preservation
 	// max count of retention is 1
@@ -527,29 +547,16 @@ func (r *SnapmirrorPolicyResource) Update(ctx context.Context, req resource.Upda
 				"error deleting all retention rules of a policy that has the create_snapshot_on_source is set to false is not supported.")
 			return
 		}
-		if plan.Retention == nil {
-			body.Retention = nil
-		} else {
-			retention := []interfaces.RetentionGetDataModel{}
-			for _, item := range plan.Retention {
-				var aRetention interfaces.RetentionGetDataModel
-				aRetention.Count = item.Count.ValueInt64()
-				aRetention.Label = item.Label.ValueString()
-				aRetention.Prefix = item.Prefix.ValueString()
-				aRetention.CreationSchedule.Name = item.CreationScheduleName.ValueString()
-
-				retention = append(retention, aRetention)
-			}
-			err := mapstructure.Decode(retention, &body.Retention)
-			if err != nil {
-				errorHandler.MakeAndReportError("error updating snapshot policies", fmt.Sprintf("error on encoding copies info: %s, copies %#v", err, retention))
-				return
-			}
-		}
+		// retention rules are updated on their own, only when they change, so that the policy is kept for the relationships using it
 		err = interfaces.UpdateSnapmirrorPolicy(errorHandler, *client, body, plan.ID.ValueString())
 		if err != nil {
 			return
 		}
+		if snapmirrorPolicyRetentionChanged(plan.Retention, state.Retention) {
+			if err = interfaces.UpdateSnapmirrorPolicyRetention(errorHandler, *client, plan.ID.ValueString(), snapmirrorPolicyRetentionToONTAP(plan.Retention)); err != nil {
+				return
+			}
+		}
 	}
 
 	restInfo, err := interfaces.GetSnapmirrorPolicy(errorHandler, *client, plan.ID.ValueString())
@@ -585,10 +592,115 @@ func (r *SnapmirrorPolicyResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("updated a snapmirror policy resource, UUID=%s", plan.ID))
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "snapmirror/policies/"+plan.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ModifyPlan reports the attributes that are not supported by the ONTAP version of the cluster, before any change is applied.
+// It also matches the retention rules of the plan with the rules of the state by label, so that adding or removing a rule
+// does not shift the prefix computed by ONTAP to another rule.
+func (r *SnapmirrorPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to check on destroy
+		return
+	}
+	var config *SnapmirrorPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+	attributes := map[string]string{}
+	if !config.CopyAllSourceSnapshots.IsNull() {
+		attributes["copy_all_source_snapshots"] = "copy_all_source_snapshots"
+	}
+	if !config.CopyLatestSourceSnapshot.IsNull() {
+		attributes["copy_latest_source_snapshot"] = "copy_latest_source_snapshot"
+	}
+	if !config.CreateSnapshotOnSource.IsNull() {
+		attributes["create_snapshot_on_source"] = "create_snapshot_on_source"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkVersionedAttributes(errorHandler, r.config, config.CxProfileName, "snapmirror/policies", attributes)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var retention types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("retention"), &retention)...)
+	if resp.Diagnostics.HasError() || retention.IsNull() || retention.IsUnknown() {
+		return
+	}
+	var plan []RetentionModel
+	resp.Diagnostics.Append(retention.ElementsAs(ctx, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	labels := make(map[string]bool, len(plan))
+	for _, rule := range plan {
+		if rule.Label.IsUnknown() {
+			continue
+		}
+		if labels[rule.Label.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("retention"), "Duplicate retention label",
+				fmt.Sprintf("label %s is used by more than one retention rule, each rule must have a unique label", rule.Label.ValueString()))
+			return
+		}
+		labels[rule.Label.ValueString()] = true
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state []RetentionModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("retention"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prefixes := make(map[string]types.String, len(state))
+	for _, rule := range state {
+		prefixes[rule.Label.ValueString()] = rule.Prefix
+	}
+	modified := false
+	for index, rule := range plan {
+		if prefix, ok := prefixes[rule.Label.ValueString()]; ok && rule.Prefix.IsUnknown() {
+			plan[index].Prefix = prefix
+			modified = true
+		}
+	}
+	if modified {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("retention"), plan)...)
+	}
+}
+
+// snapmirrorPolicyRetentionChanged returns true when a retention rule is added, modified, removed, or moved
+func snapmirrorPolicyRetentionChanged(plan []RetentionModel, state []RetentionModel) bool {
+	if len(plan) != len(state) {
+		return true
+	}
+	for index, rule := range plan {
+		current := state[index]
+		if !rule.Label.Equal(current.Label) || !rule.Count.Equal(current.Count) ||
+			!rule.CreationScheduleName.Equal(current.CreationScheduleName) || !rule.Prefix.Equal(current.Prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// snapmirrorPolicyRetentionToONTAP converts the retention rules to the ONTAP data model, a prefix to be computed by ONTAP is left empty
+func snapmirrorPolicyRetentionToONTAP(rules []RetentionModel) []interfaces.RetentionGetDataModel {
+	retention := make([]interfaces.RetentionGetDataModel, 0, len(rules))
+	for _, rule := range rules {
+		var aRetention interfaces.RetentionGetDataModel
+		aRetention.Label = rule.Label.ValueString()
+		aRetention.Count = rule.Count.ValueInt64()
+		aRetention.Prefix = rule.Prefix.ValueString()
+		aRetention.CreationSchedule.Name = rule.CreationScheduleName.ValueString()
+		retention = append(retention, aRetention)
+	}
+	return retention
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *SnapmirrorPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *SnapmirrorPolicyResourceModel
@@ -600,6 +712,11 @@ func (r *SnapmirrorPolicyResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	if data.RetainOnDestroy.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("retain_on_destroy is set, snapmirror policy %s (%s) removed from state only", data.Name.ValueString(), data.ID.ValueString()))
+		return
+	}
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -621,5 +738,17 @@ func (r *SnapmirrorPolicyResource) Delete(ctx context.Context, req resource.Dele
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *SnapmirrorPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
 }
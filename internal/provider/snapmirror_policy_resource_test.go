@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -82,6 +83,18 @@ func TestAccSnapmirrorPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.1.count", "5"),
 				),
 			},
+			// Test update snapmirror policy with modifying the count of a retention rule in place
+			{
+				Config: testAccSnapmirrorPolicyResourceAddTwoRetentionConfig("ansibleSVM", "update comment", "exclude_network_config", "weekly", 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "name", "carchitestme4"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.0.label", "hourly"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.0.count", "7"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.1.label", "weekly"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_policy_resource.example", "retention.1.count", "8"),
+				),
+			},
 			// Test update snapmirror policy with removing one retention rule
 			{
 				Config: testAccSnapmirrorPolicyResourceRemoveOneRetentionConfig("ansibleSVM", "update comment", "exclude_network_config"),
@@ -132,6 +145,36 @@ func TestAccSnapmirrorPolicyResource(t *testing.T) {
 	})
 }
 
+func TestSnapmirrorPolicyRetentionChanged(t *testing.T) {
+	hourly := RetentionModel{Label: types.StringValue("hourly"), Count: types.Int64Value(7), CreationScheduleName: types.StringValue("hourly"), Prefix: types.StringValue("hourly")}
+	weekly := RetentionModel{Label: types.StringValue("weekly"), Count: types.Int64Value(5), CreationScheduleName: types.StringNull(), Prefix: types.StringValue("weekly")}
+	weeklyCount := weekly
+	weeklyCount.Count = types.Int64Value(8)
+	weeklyNewPrefix := weekly
+	weeklyNewPrefix.Prefix = types.StringUnknown()
+	tests := []struct {
+		name  string
+		plan  []RetentionModel
+		state []RetentionModel
+		want  bool
+	}{
+		{name: "test_unchanged", plan: []RetentionModel{hourly, weekly}, state: []RetentionModel{hourly, weekly}, want: false},
+		{name: "test_no_rules", plan: nil, state: nil, want: false},
+		{name: "test_add_rule", plan: []RetentionModel{hourly, weekly}, state: []RetentionModel{hourly}, want: true},
+		{name: "test_remove_rule", plan: []RetentionModel{hourly}, state: []RetentionModel{hourly, weekly}, want: true},
+		{name: "test_modify_count", plan: []RetentionModel{hourly, weeklyCount}, state: []RetentionModel{hourly, weekly}, want: true},
+		{name: "test_unknown_prefix", plan: []RetentionModel{hourly, weeklyNewPrefix}, state: []RetentionModel{hourly, weekly}, want: true},
+		{name: "test_move_rule", plan: []RetentionModel{weekly, hourly}, state: []RetentionModel{hourly, weekly}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapmirrorPolicyRetentionChanged(tt.plan, tt.state); got != tt.want {
+				t.Errorf("snapmirrorPolicyRetentionChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccSnapmirrorPolicyResourceBasicConfig(svm string) string {
 	host := os.Getenv("TF_ACC_NETAPP_HOST")
 	admin := os.Getenv("TF_ACC_NETAPP_USER")
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SnapmirrorReleaseResource{}
+
+// NewSnapmirrorReleaseResource is a helper function to simplify the provider implementation.
+func NewSnapmirrorReleaseResource() resource.Resource {
+	return &SnapmirrorReleaseResource{
+		config: resourceOrDataSourceConfig{
+			name: "snapmirror_release_resource",
+		},
+	}
+}
+
+// SnapmirrorReleaseResource defines the resource implementation.
+type SnapmirrorReleaseResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SnapmirrorReleaseResourceModel describes the resource data model.
+type SnapmirrorReleaseResourceModel struct {
+	CxProfileName            types.String   `tfsdk:"cx_profile_name"`
+	SourcePath               types.String   `tfsdk:"source_path"`
+	DestinationPaths         []types.String `tfsdk:"destination_paths"`
+	Trigger                  types.String   `tfsdk:"trigger"`
+	ReleasedDestinationPaths types.List     `tfsdk:"released_destination_paths"`
+	ID                       types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SnapmirrorReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SnapmirrorReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Release SnapMirror destinations on the source cluster. The destinations are released on create, any change recreates the resource and releases them again",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name, for the source cluster",
+				Optional:            true,
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Source path, eg svm1:vol1",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_paths": schema.ListAttribute{
+				MarkdownDescription: "Destination paths to release, eg svm2:vol1_dest. Destinations that are not found, for instance already released, are ignored",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value. Changing it releases the destinations again",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"released_destination_paths": schema.ListAttribute{
+				MarkdownDescription: "Destination paths released on create",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Snapmirror release identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SnapmirrorReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read keeps the Terraform state, as the released destinations are no longer expected to exist.
+func (r *SnapmirrorReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnapmirrorReleaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create releases the destinations on the source cluster
+func (r *SnapmirrorReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnapmirrorReleaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	destinations, err := interfaces.GetSnapmirrorDestinations(errorHandler, *client, data.SourcePath.ValueString())
+	if err != nil {
+		return
+	}
+
+	released := []types.String{}
+	for _, path := range data.DestinationPaths {
+		for _, destination := range destinations {
+			if destination.Destination.Path != path.ValueString() {
+				continue
+			}
+			if err = interfaces.ReleaseSnapmirror(errorHandler, *client, destination.UUID); err != nil {
+				return
+			}
+			released = append(released, path)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("released %d of %d destinations for %s", len(released), len(data.DestinationPaths), data.SourcePath.ValueString()))
+	// a list, as the value is unknown until the relationships are released
+	releasedList, diags := types.ListValueFrom(ctx, types.StringType, released)
+	resp.Diagnostics.Append(diags...)
+	data.ReleasedDestinationPaths = releasedList
+
+	data.ID = types.StringValue(data.SourcePath.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not expected, as all the attributes require a replacement.
+func (r *SnapmirrorReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnapmirrorReleaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *SnapmirrorReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnapmirrorReleaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("snapmirror release %s removed from state", data.ID.ValueString()))
+}
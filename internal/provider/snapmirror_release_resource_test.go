@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSnapmirrorReleaseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the destination does not exist, nothing to release
+			{
+				Config: testAccSnapmirrorReleaseResourceConfig("snapmirror_dest_svm:not_a_destination"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_release_resource.example", "source_path", "ansibleSVM:ansibleVolume"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapmirror_release_resource.example", "released_destination_paths.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSnapmirrorReleaseResourceConfig(destinationPath string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_snapmirror_release_resource" "example" {
+  cx_profile_name = "cluster4"
+  source_path = "ansibleSVM:ansibleVolume"
+  destination_paths = ["%s"]
+}`, host, admin, password, destinationPath)
+}
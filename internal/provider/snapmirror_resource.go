@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -13,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -43,10 +47,13 @@ type SnapmirrorResourceModel struct {
 	SourceEndPoint      *EndPoint          `tfsdk:"source_endpoint"`
 	DestinationEndPoint *EndPoint          `tfsdk:"destination_endpoint"`
 	CreateDestination   *CreateDestination `tfsdk:"create_destination"`
-	Initialize          types.Bool         `tfsdk:"initialize"`
-	Healthy             types.Bool         `tfsdk:"healthy"`
-	State               types.String       `tfsdk:"state"`
-	ID                  types.String       `tfsdk:"id"`
+	// UUID of the object store endpoint, for SnapMirror Cloud relationships
+	ObjectStoreEndpointUUID types.String `tfsdk:"object_store_endpoint_uuid"`
+	Initialize              types.Bool   `tfsdk:"initialize"`
+	Policy                  types.String `tfsdk:"policy"`
+	Healthy                 types.Bool   `tfsdk:"healthy"`
+	State                   types.String `tfsdk:"state"`
+	ID                      types.String `tfsdk:"id"`
 }
 
 // EndPoint describes source/destination endpoint data model.
@@ -78,7 +85,7 @@ func (r *SnapmirrorResource) Schema(ctx context.Context, req resource.SchemaRequ
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"source_endpoint": schema.SingleNestedAttribute{
 				MarkdownDescription: "Snapmirror source endpoint",
@@ -130,6 +137,16 @@ func (r *SnapmirrorResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 				},
 			},
+			"object_store_endpoint_uuid": schema.StringAttribute{
+				MarkdownDescription: "UUID of the object store endpoint, for SnapMirror Cloud relationships whose destination_endpoint is an object store path, eg objstore1:/objstore/vol1_backup. " +
+					"Set it to replicate to an existing endpoint, it is returned by ONTAP otherwise. Requires the SnapMirror Cloud license",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"initialize": schema.BoolAttribute{
 				MarkdownDescription: "initialize the relationship",
 				Optional:            true,
@@ -137,13 +154,25 @@ func (r *SnapmirrorResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Default:             booldefault.StaticBool(true),
 				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "SnapMirror policy name. For SVM DR relationships, the identity_preservation setting of the policy defines which configuration of the source SVM is replicated",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"healthy": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
 			},
 			"state": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				MarkdownDescription: "State of the relationship. Set it to paused to quiesce the relationship, to broken_off to break it, and to snapmirrored to resume or resync it",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("snapmirrored", "paused", "broken_off"),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -190,6 +219,32 @@ func (r *SnapmirrorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// on import, only the destination path is known, resolve the relationship UUID from it
+	if data.ID.IsNull() {
+		cluster, err := interfaces.GetCluster(errorHandler, *client)
+		if err != nil {
+			// error reporting done inside GetCluster
+			return
+		}
+		if cluster == nil {
+			errorHandler.MakeAndReportError("No cluster found", "No Cluster found")
+			return
+		}
+		relationship, err := interfaces.GetSnapmirrorByDestinationPath(errorHandler, *client, data.DestinationEndPoint.Path.ValueString(), cluster.Version)
+		if err != nil {
+			// error reporting done inside GetSnapmirrorByDestinationPath
+			return
+		}
+		if relationship.UUID == "" {
+			errorHandler.MakeAndReportError("No snapmirror found", fmt.Sprintf("snapmirror with destination path %s not found.", data.DestinationEndPoint.Path.ValueString()))
+			return
+		}
+		data.ID = types.StringValue(relationship.UUID)
+		if data.SourceEndPoint == nil {
+			data.SourceEndPoint = &EndPoint{Path: types.StringValue(relationship.Source.Path)}
+		}
+	}
+
 	restInfo, err := interfaces.GetSnapmirrorByID(errorHandler, *client, data.ID.ValueString())
 	if err != nil {
 		// error reporting done inside GetSnapmirrorByID
@@ -198,12 +253,15 @@ func (r *SnapmirrorResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	data.ID = types.StringValue(restInfo.UUID)
 	data.Healthy = types.BoolValue(restInfo.Healthy)
-	data.State = types.StringValue(restInfo.State)
+	data.State = types.StringValue(snapmirrorState(data.State, restInfo.State))
+	data.Policy = types.StringValue(restInfo.Policy.Name)
+	data.ObjectStoreEndpointUUID = snapmirrorObjectStoreEndpointUUID(restInfo)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a snapmirror resource: %#v", data))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "snapmirror/relationships/"+data.ID.ValueString())...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -220,6 +278,8 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// the state set in the configuration, applied once the relationship is initialized
+	plan := data.State
 
 	body.SourceEndPoint.Path = data.SourceEndPoint.Path.ValueString()
 	body.DestinationEndPoint.Path = data.DestinationEndPoint.Path.ValueString()
@@ -238,13 +298,49 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 			body.CreateDestination.Enabled = data.CreateDestination.Enabled.ValueBool()
 		}
 	}
+	if !data.ObjectStoreEndpointUUID.IsUnknown() && !data.ObjectStoreEndpointUUID.IsNull() {
+		body.DestinationEndPoint.UUID = data.ObjectStoreEndpointUUID.ValueString()
+	}
+	if !data.Policy.IsUnknown() && !data.Policy.IsNull() {
+		body.Policy = &interfaces.SnapmirrorRelationshipPolicy{Name: data.Policy.ValueString()}
+	}
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// SVM DR relationships use svm: paths on both ends, the destination SVM is created with the dp_destination subtype
+	if interfaces.IsSnapmirrorSvmPath(body.SourceEndPoint.Path) != interfaces.IsSnapmirrorSvmPath(body.DestinationEndPoint.Path) {
+		errorHandler.MakeAndReportError("invalid endpoints",
+			fmt.Sprintf("source_endpoint %s and destination_endpoint %s must both be SVM paths (svm:) or volume paths (svm:volume)", body.SourceEndPoint.Path, body.DestinationEndPoint.Path))
+		return
+	}
+	objectStore := interfaces.IsSnapmirrorObjectStorePath(body.DestinationEndPoint.Path)
+	if body.DestinationEndPoint.UUID != "" && !objectStore {
+		errorHandler.MakeAndReportError("invalid object_store_endpoint_uuid",
+			fmt.Sprintf("object_store_endpoint_uuid is only supported when destination_endpoint %s is an object store path (objstore:/objstore/name)", body.DestinationEndPoint.Path))
+		return
+	}
+	if objectStore && body.CreateDestination.Enabled {
+		errorHandler.MakeAndReportError("invalid create_destination",
+			fmt.Sprintf("create_destination is not supported when destination_endpoint %s is an object store path", body.DestinationEndPoint.Path))
+		return
+	}
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
 	}
+	if objectStore {
+		// report a missing license before creating the relationship, rather than the generic error returned by ONTAP
+		licenses, err := interfaces.GetListClusterLicensingLicenses(errorHandler, *client, &interfaces.ClusterLicensingLicenseFilterModel{Name: "snapmirror_cloud"})
+		if err != nil {
+			// error reporting done inside GetListClusterLicensingLicenses
+			return
+		}
+		if len(licenses) == 0 || licenses[0].State == "unlicensed" {
+			errorHandler.MakeAndReportError("SnapMirror Cloud license required",
+				fmt.Sprintf("the snapmirror_cloud license is required to replicate to object store destination_endpoint %s", body.DestinationEndPoint.Path))
+			return
+		}
+	}
 
 	resource, err := interfaces.CreateSnapmirror(errorHandler, *client, body)
 	if err != nil {
@@ -264,7 +360,7 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 
 	if data.Initialize.ValueBool() && data.State.ValueString() == "uninitialized" {
 		time.Sleep(3 * time.Second)
-		err := interfaces.InitializeSnapmirror(errorHandler, *client, data.ID.ValueString(), "snapmirrored")
+		err := interfaces.InitializeSnapmirror(errorHandler, *client, data.ID.ValueString(), "snapmirrored", r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
 		if err != nil {
 			// error reporting done inside InitializeSnapmirror
 			return
@@ -278,10 +374,20 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 		// error reporting done inside GetSnapmirror
 		return
 	}
+	if !plan.IsUnknown() && !plan.IsNull() && snapmirrorState(plan, restInfo.State) != plan.ValueString() {
+		if err = r.setState(errorHandler, *client, data.ID.ValueString(), restInfo.State, plan.ValueString()); err != nil {
+			return
+		}
+		if restInfo, err = interfaces.GetSnapmirrorByID(errorHandler, *client, data.ID.ValueString()); err != nil {
+			return
+		}
+	}
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snapmirror info: %#v", restInfo))
 	// Update the computed parameters
 	data.Healthy = types.BoolValue(restInfo.Healthy)
-	data.State = types.StringValue(restInfo.State)
+	data.State = types.StringValue(snapmirrorState(plan, restInfo.State))
+	data.Policy = types.StringValue(restInfo.Policy.Name)
+	data.ObjectStoreEndpointUUID = snapmirrorObjectStoreEndpointUUID(restInfo)
 	data.ID = types.StringValue(resource.UUID)
 
 	tflog.Trace(ctx, fmt.Sprintf("created a snapmirror resource, UUID=%s", data.ID))
@@ -303,15 +409,88 @@ func (r *SnapmirrorResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	// License updates are not supported
-	err := errorHandler.MakeAndReportError("Update not supported for snapmirror", "Update not supported for snapmirror")
+	// only the policy and the state can be modified
+	if !plan.SourceEndPoint.Path.Equal(state.SourceEndPoint.Path) || !plan.DestinationEndPoint.Path.Equal(state.DestinationEndPoint.Path) ||
+		!snapmirrorClusterNameEqual(plan.SourceEndPoint.Cluster, state.SourceEndPoint.Cluster) ||
+		!snapmirrorClusterNameEqual(plan.DestinationEndPoint.Cluster, state.DestinationEndPoint.Cluster) {
+		errorHandler.MakeAndReportError("Update not supported for snapmirror", "Update not supported for snapmirror endpoints, only policy and state can be modified")
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+	if !plan.Policy.IsUnknown() && !plan.Policy.Equal(state.Policy) {
+		if err = interfaces.UpdateSnapmirrorRelationshipPolicy(errorHandler, *client, state.ID.ValueString(), plan.Policy.ValueString()); err != nil {
+			return
+		}
+	}
+	if !plan.State.IsUnknown() && !plan.State.IsNull() && !plan.State.Equal(state.State) {
+		if err = r.setState(errorHandler, *client, state.ID.ValueString(), state.State.ValueString(), plan.State.ValueString()); err != nil {
+			return
+		}
+	}
+
+	restInfo, err := interfaces.GetSnapmirrorByID(errorHandler, *client, state.ID.ValueString())
 	if err != nil {
+		// error reporting done inside GetSnapmirrorByID
 		return
 	}
+	plan.ID = state.ID
+	plan.Healthy = types.BoolValue(restInfo.Healthy)
+	plan.State = types.StringValue(snapmirrorState(plan.State, restInfo.State))
+	plan.Policy = types.StringValue(restInfo.Policy.Name)
+	plan.ObjectStoreEndpointUUID = snapmirrorObjectStoreEndpointUUID(restInfo)
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "snapmirror/relationships/"+state.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// setState changes the state of the relationship from current to target, eg paused to quiesce it.
+// A relationship is quiesced before it is broken, and resuming or resyncing a relationship both set it to snapmirrored.
+func (r *SnapmirrorResource) setState(errorHandler *utils.ErrorHandler, client restclient.RestClient, id string, current string, target string) error {
+	timeout := r.config.providerConfig.JobCompletionTimeOut
+	interval := r.config.providerConfig.JobPollInterval
+	if target == "broken_off" && current != "paused" && current != "broken_off" {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("quiescing snapmirror %s before breaking it", id))
+		// error reporting done inside InitializeSnapmirror
+		if err := interfaces.InitializeSnapmirror(errorHandler, client, id, "paused", timeout, interval); err != nil {
+			return err
+		}
+	}
+	// error reporting done inside InitializeSnapmirror
+	return interfaces.InitializeSnapmirror(errorHandler, client, id, target, timeout, interval)
+}
+
+// snapmirrorState returns the state of the relationship for Terraform.
+// Synchronous relationships report in_sync or out_of_sync rather than snapmirrored, they are reported as snapmirrored when it is the configured state.
+func snapmirrorState(configured types.String, ontapState string) string {
+	if configured.ValueString() == "snapmirrored" && (ontapState == "in_sync" || ontapState == "out_of_sync" || ontapState == "synchronizing") {
+		return configured.ValueString()
+	}
+	return ontapState
+}
+
+// snapmirrorObjectStoreEndpointUUID returns the UUID of the object store endpoint of a SnapMirror Cloud relationship, null for other relationships
+func snapmirrorObjectStoreEndpointUUID(restInfo *interfaces.SnapmirrorGetDataModelONTAP) types.String {
+	if !interfaces.IsSnapmirrorObjectStorePath(restInfo.Destination.Path) || restInfo.Destination.UUID == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(restInfo.Destination.UUID)
+}
+
+// snapmirrorClusterNameEqual compares the optional cluster of two endpoints
+func snapmirrorClusterNameEqual(plan *Cluster, state *Cluster) bool {
+	if plan == nil || state == nil {
+		return plan == nil && state == nil
+	}
+	return plan.Name.Equal(state.Name)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *SnapmirrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *SnapmirrorResourceModel
@@ -344,5 +523,16 @@ func (r *SnapmirrorResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *SnapmirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: destination_path,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_endpoint").AtName("path"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
 }
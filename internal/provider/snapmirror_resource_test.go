@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
 )
 
 func TestAccSnapmirrorResource(t *testing.T) {
@@ -30,6 +32,48 @@ func TestAccSnapmirrorResource(t *testing.T) {
 	})
 }
 
+func TestSnapmirrorState(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		ontapState string
+		want       string
+	}{
+		{name: "test_snapmirrored", configured: types.StringValue("snapmirrored"), ontapState: "snapmirrored", want: "snapmirrored"},
+		{name: "test_in_sync", configured: types.StringValue("snapmirrored"), ontapState: "in_sync", want: "snapmirrored"},
+		{name: "test_paused_outside", configured: types.StringValue("snapmirrored"), ontapState: "paused", want: "paused"},
+		{name: "test_not_configured", configured: types.StringNull(), ontapState: "in_sync", want: "in_sync"},
+		{name: "test_broken_off", configured: types.StringValue("broken_off"), ontapState: "broken_off", want: "broken_off"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapmirrorState(tt.configured, tt.ontapState); got != tt.want {
+				t.Errorf("snapmirrorState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnapmirrorObjectStoreEndpointUUID(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination interfaces.SnapmirrorGetEndPoint
+		want        types.String
+	}{
+		{name: "test_object_store", destination: interfaces.SnapmirrorGetEndPoint{Path: "objstore1:/objstore/vol1_backup", UUID: "endpoint-uuid"}, want: types.StringValue("endpoint-uuid")},
+		{name: "test_volume", destination: interfaces.SnapmirrorGetEndPoint{Path: "svm1:vol1", UUID: "volume-uuid"}, want: types.StringNull()},
+		{name: "test_no_uuid", destination: interfaces.SnapmirrorGetEndPoint{Path: "objstore1:/objstore/vol1_backup"}, want: types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restInfo := &interfaces.SnapmirrorGetDataModelONTAP{Destination: tt.destination}
+			if got := snapmirrorObjectStoreEndpointUUID(restInfo); !got.Equal(tt.want) {
+				t.Errorf("snapmirrorObjectStoreEndpointUUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccSnapmirrorResourceBasicConfig(sourceEndpoint string, destinationEndpoint string) string {
 	host := os.Getenv("TF_ACC_NETAPP_HOST3")
 	admin := os.Getenv("TF_ACC_NETAPP_USER")
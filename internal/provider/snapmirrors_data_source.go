@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,6 +33,9 @@ type SnapmirrorsDataSource struct {
 // SnapmirrorDataSourceFilterModel describes the data source model.
 type SnapmirrorDataSourceFilterModel struct {
 	DestinantionPath types.String `tfsdk:"destination_path"`
+	SourcePath       types.String `tfsdk:"source_path"`
+	State            types.String `tfsdk:"state"`
+	Healthy          types.Bool   `tfsdk:"healthy"`
 }
 
 // SnapmirrorsDataSourceModel describes the data source data model.
@@ -55,7 +59,7 @@ func (d *SnapmirrorsDataSource) Schema(ctx context.Context, req datasource.Schem
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -63,6 +67,18 @@ func (d *SnapmirrorsDataSource) Schema(ctx context.Context, req datasource.Schem
 						MarkdownDescription: "Destination path",
 						Optional:            true,
 					},
+					"source_path": schema.StringAttribute{
+						MarkdownDescription: "Source path",
+						Optional:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Relationship state, eg snapmirrored, broken_off, uninitialized, paused",
+						Optional:            true,
+					},
+					"healthy": schema.BoolAttribute{
+						MarkdownDescription: "Set to false to return the unhealthy relationships only",
+						Optional:            true,
+					},
 				},
 				Optional: true,
 			},
@@ -169,6 +185,41 @@ func (d *SnapmirrorsDataSource) Schema(ctx context.Context, req datasource.Schem
 							MarkdownDescription: "throttle of the relationship",
 							Computed:            true,
 						},
+						"lag_time": schema.StringAttribute{
+							MarkdownDescription: "Time since the exported snapshot was created, as an ISO 8601 duration, eg PT8H35M42S",
+							Computed:            true,
+						},
+						"lag_time_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Time since the exported snapshot was created, in seconds",
+							Computed:            true,
+						},
+						"unhealthy_reasons": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Reasons why the relationship is not healthy",
+							Computed:            true,
+						},
+						"last_transfer": schema.SingleNestedAttribute{
+							MarkdownDescription: "Current or last transfer, null until a first transfer starts",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"state": schema.StringAttribute{
+									MarkdownDescription: "Transfer state, eg transferring, success, failed, or aborted",
+									Computed:            true,
+								},
+								"bytes_transferred": schema.Int64Attribute{
+									MarkdownDescription: "Bytes transferred",
+									Computed:            true,
+								},
+								"end_time": schema.StringAttribute{
+									MarkdownDescription: "End time of the transfer, in ISO 8601 format, empty while the transfer is running",
+									Computed:            true,
+								},
+								"total_duration": schema.StringAttribute{
+									MarkdownDescription: "Duration of the transfer, as an ISO 8601 duration, eg PT3M20S",
+									Computed:            true,
+								},
+							},
+						},
 					},
 				},
 				Computed:            true,
@@ -227,6 +278,11 @@ func (d *SnapmirrorsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if data.Filter != nil {
 		filter = &interfaces.SnapmirrorFilterModel{
 			DestinationPath: data.Filter.DestinantionPath.ValueString(),
+			SourcePath:      data.Filter.SourcePath.ValueString(),
+			State:           data.Filter.State.ValueString(),
+		}
+		if !data.Filter.Healthy.IsNull() {
+			filter.Healthy = strconv.FormatBool(data.Filter.Healthy.ValueBool())
 		}
 	}
 	restInfo, err := interfaces.GetSnapmirrors(errorHandler, *client, filter, cluster.Version)
@@ -262,8 +318,9 @@ func (d *SnapmirrorsDataSource) Read(ctx context.Context, req datasource.ReadReq
 			ID:      types.StringValue(record.UUID),
 			State:   types.StringValue(record.State),
 		}
+		flattenSnapmirrorHealth(ctx, &data.Snapmirrors[index], &record)
 
-		if cluster.Version.Generation == 9 && cluster.Version.Major > 10 {
+		if interfaces.SupportsField("snapmirror/relationships", cluster.Version, "throttle") {
 			data.Snapmirrors[index].Throttle = types.Int64Value(int64(record.Throttle))
 			data.Snapmirrors[index].GroupType = types.StringValue(record.GroupType)
 		}
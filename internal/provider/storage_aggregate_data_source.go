@@ -31,18 +31,22 @@ type StorageAggregateDataSource struct {
 
 // StorageAggregateDataSourceModel describes the data source data model.
 type StorageAggregateDataSourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	Name          types.String `tfsdk:"name"`
-	ID            types.String `tfsdk:"id"`
-	State         types.String `tfsdk:"state"`
-	Node          types.String `tfsdk:"node"`
-	DiskClass     types.String `tfsdk:"disk_class"`
-	DiskCount     types.Int64  `tfsdk:"disk_count"`
-	RaidSize      types.Int64  `tfsdk:"raid_size"`
-	RaidType      types.String `tfsdk:"raid_type"`
-	IsMirrored    types.Bool   `tfsdk:"is_mirrored"`
-	SnaplockType  types.String `tfsdk:"snaplock_type"`
-	Encryption    types.Bool   `tfsdk:"encryption"`
+	CxProfileName         types.String `tfsdk:"cx_profile_name"`
+	Name                  types.String `tfsdk:"name"`
+	ID                    types.String `tfsdk:"id"`
+	State                 types.String `tfsdk:"state"`
+	Node                  types.String `tfsdk:"node"`
+	DiskClass             types.String `tfsdk:"disk_class"`
+	DiskCount             types.Int64  `tfsdk:"disk_count"`
+	RaidSize              types.Int64  `tfsdk:"raid_size"`
+	RaidType              types.String `tfsdk:"raid_type"`
+	IsMirrored            types.Bool   `tfsdk:"is_mirrored"`
+	SnaplockType          types.String `tfsdk:"snaplock_type"`
+	Encryption            types.Bool   `tfsdk:"encryption"`
+	SpaceSize             types.Int64  `tfsdk:"space_size"`
+	SpaceAvailable        types.Int64  `tfsdk:"space_available"`
+	SpaceUsed             types.Int64  `tfsdk:"space_used"`
+	TieringAttachEligible types.Bool   `tfsdk:"tiering_attach_eligible"`
 }
 
 // StorageAggregateDataSourceFilterModel describes the data source data model for queries.
@@ -65,7 +69,7 @@ func (d *StorageAggregateDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "StorageAggregate name",
@@ -118,6 +122,22 @@ func (d *StorageAggregateDataSource) Schema(ctx context.Context, req datasource.
 				MarkdownDescription: "Whether to enable software encryption. This is equivalent to -encrypt-with-aggr-key when using the CLI.Requires a VE license.",
 				Computed:            true,
 			},
+			"space_size": schema.Int64Attribute{
+				MarkdownDescription: "Total usable space in the aggregate, in bytes",
+				Computed:            true,
+			},
+			"space_available": schema.Int64Attribute{
+				MarkdownDescription: "Space available in the aggregate, in bytes",
+				Computed:            true,
+			},
+			"space_used": schema.Int64Attribute{
+				MarkdownDescription: "Space used or reserved in the aggregate, in bytes",
+				Computed:            true,
+			},
+			"tiering_attach_eligible": schema.BoolAttribute{
+				MarkdownDescription: "Whether an object store can be attached to the aggregate for FabricPool tiering",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -174,6 +194,10 @@ func (d *StorageAggregateDataSource) Read(ctx context.Context, req datasource.Re
 	data.State = types.StringValue(restInfo.State)
 	data.Name = types.StringValue(restInfo.Name)
 	data.Node = types.StringValue(restInfo.Node.Name)
+	data.SpaceSize = types.Int64Value(restInfo.Space.BlockStorage.Size)
+	data.SpaceAvailable = types.Int64Value(restInfo.Space.BlockStorage.Available)
+	data.SpaceUsed = types.Int64Value(restInfo.Space.BlockStorage.Used)
+	data.TieringAttachEligible = types.BoolValue(restInfo.CloudStorage.AttachEligible)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
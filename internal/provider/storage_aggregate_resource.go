@@ -26,6 +26,7 @@ import (
 var _ resource.Resource = &AggregateResource{}
 
 var _ resource.ResourceWithImportState = &AggregateResource{}
+var _ resource.ResourceWithModifyPlan = &AggregateResource{}
 
 // NewAggregateResource is a helper function to simplify the provider implementation.
 func NewAggregateResource() resource.Resource {
@@ -73,7 +74,7 @@ func (r *AggregateResource) Schema(ctx context.Context, req resource.SchemaReque
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the aggregate to manage",
@@ -116,7 +117,7 @@ func (r *AggregateResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: `Number of disks to place into the aggregate, including parity disks.
 				The disks in this newly-created aggregate come from the spare disk pool.
 				The smallest disks in this pool join the aggregate first, unless the disk_size argument is provided.
-				Modifiable only if specified disk_count is larger than current disk_count.
+				Modifiable only if specified disk_count is larger than current disk_count, the disks are added in place.
 				If the disk_count % raid_size == 1, only disk_count/raid_size * raid_size will be added.
 				If disk_count is 6, raid_type is raid4, raid_size 4, all 6 disks will be added.
 				If disk_count is 5, raid_type is raid4, raid_size 4, 5/4 * 4 = 4 will be added. 1 will not be added.
@@ -366,6 +367,25 @@ func (r *AggregateResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan rejects removing disks, ONTAP can only add disks to an existing aggregate
+func (r *AggregateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *AggregateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan == nil || state == nil {
+		return
+	}
+	if plan.DiskCount.IsUnknown() || state.DiskCount.IsNull() {
+		return
+	}
+	if plan.DiskCount.ValueInt64() < state.DiskCount.ValueInt64() {
+		errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+		errorHandler.MakeAndReportError("error updating aggregate",
+			fmt.Sprintf("disk_count can only be increased, from %d to %d: disks can not be removed from an aggregate", state.DiskCount.ValueInt64(), plan.DiskCount.ValueInt64()))
+	}
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *AggregateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state *AggregateResourceModel
@@ -415,18 +435,17 @@ func (r *AggregateResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		request.BlockStorage["primary"] = body
 	}
-	// \"disk_size\" can only be specified in a PATCH operation when \"block_storage.primary.disk_count\" is being modified."
-	if request.BlockStorage != nil {
-		if _, ok := request.BlockStorage["disk_count"]; !ok {
-			diskSize = 0
-		}
+	// disk_size can only be specified in a PATCH operation when disk_count is being modified
+	if !plan.DiskCount.Equal(state.DiskCount) && !plan.DiskSize.IsNull() {
+		diskSize = int(plan.DiskSize.ValueInt64()) * interfaces.POW2BYTEMAP[plan.DiskSizeUnit.ValueString()]
 	}
 	if (!plan.DiskSize.Equal(state.DiskSize) || !plan.DiskSizeUnit.Equal(state.DiskSizeUnit)) && plan.DiskCount.Equal(state.DiskCount) {
 		errorHandler.MakeAndReportError("error updating aggregate", "disk_size and disk_unit can only be specified in a PATCH operation when disk_count is being modified.")
 		return
 	}
 
-	err = interfaces.UpdateStorageAggregate(errorHandler, *client, request, diskSize, plan.ID.ValueString())
+	// adding disks runs as a job
+	err = interfaces.UpdateStorageAggregate(errorHandler, *client, request, diskSize, plan.ID.ValueString(), r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageAggregateSparesDataSource{}
+
+// NewStorageAggregateSparesDataSource is a helper function to simplify the provider implementation.
+func NewStorageAggregateSparesDataSource() datasource.DataSource {
+	return &StorageAggregateSparesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_aggregate_spares_data_source",
+		},
+	}
+}
+
+// StorageAggregateSparesDataSource defines the data source implementation.
+type StorageAggregateSparesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageAggregateSparesDataSourceModel describes the data source data model.
+type StorageAggregateSparesDataSourceModel struct {
+	CxProfileName types.String                                 `tfsdk:"cx_profile_name"`
+	Spares        []StorageAggregateSpareDataSourceModel       `tfsdk:"spares"`
+	Filter        *StorageAggregateSparesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// StorageAggregateSpareDataSourceModel describes the spare disks of a node for a disk class and type.
+type StorageAggregateSpareDataSourceModel struct {
+	NodeName        types.String `tfsdk:"node_name"`
+	DiskClass       types.String `tfsdk:"disk_class"`
+	DiskType        types.String `tfsdk:"disk_type"`
+	Count           types.Int64  `tfsdk:"count"`
+	TotalUsableSize types.Int64  `tfsdk:"total_usable_size"`
+}
+
+// StorageAggregateSparesDataSourceFilterModel describes the data source data model for queries.
+type StorageAggregateSparesDataSourceFilterModel struct {
+	NodeName  types.String `tfsdk:"node_name"`
+	DiskClass types.String `tfsdk:"disk_class"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageAggregateSparesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageAggregateSparesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Spare disks available for aggregates, counted per node, disk class and disk type",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"node_name": schema.StringAttribute{
+						MarkdownDescription: "Owner node name",
+						Optional:            true,
+					},
+					"disk_class": schema.StringAttribute{
+						MarkdownDescription: "Disk class, eg capacity, performance, solid_state",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"spares": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Owner node name",
+							Computed:            true,
+						},
+						"disk_class": schema.StringAttribute{
+							MarkdownDescription: "Disk class",
+							Computed:            true,
+						},
+						"disk_type": schema.StringAttribute{
+							MarkdownDescription: "Disk type, eg ssd, sas, fsas",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of spare disks",
+							Computed:            true,
+						},
+						"total_usable_size": schema.Int64Attribute{
+							MarkdownDescription: "Sum of the usable size of the spare disks in bytes",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageAggregateSparesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageAggregateSparesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageAggregateSparesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.StorageAggregateSparesFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.StorageAggregateSparesFilterModel{
+			NodeName:  data.Filter.NodeName.ValueString(),
+			DiskClass: data.Filter.DiskClass.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListStorageAggregateSpares(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListStorageAggregateSpares
+		return
+	}
+
+	data.Spares = make([]StorageAggregateSpareDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.Spares[index] = StorageAggregateSpareDataSourceModel{
+			NodeName:        types.StringValue(record.NodeName),
+			DiskClass:       types.StringValue(record.DiskClass),
+			DiskType:        types.StringValue(record.DiskType),
+			Count:           types.Int64Value(record.Count),
+			TotalUsableSize: types.Int64Value(record.TotalUsableSize),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -50,7 +50,7 @@ func (d *StorageAggregatesDataSource) Schema(ctx context.Context, req datasource
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -122,6 +122,22 @@ func (d *StorageAggregatesDataSource) Schema(ctx context.Context, req datasource
 							MarkdownDescription: "Whether to enable software encryption. This is equivalent to -encrypt-with-aggr-key when using the CLI.Requires a VE license.",
 							Computed:            true,
 						},
+						"space_size": schema.Int64Attribute{
+							MarkdownDescription: "Total usable space in the aggregate, in bytes",
+							Computed:            true,
+						},
+						"space_available": schema.Int64Attribute{
+							MarkdownDescription: "Space available in the aggregate, in bytes",
+							Computed:            true,
+						},
+						"space_used": schema.Int64Attribute{
+							MarkdownDescription: "Space used or reserved in the aggregate, in bytes",
+							Computed:            true,
+						},
+						"tiering_attach_eligible": schema.BoolAttribute{
+							MarkdownDescription: "Whether an object store can be attached to the aggregate for FabricPool tiering",
+							Computed:            true,
+						},
 					},
 				},
 				Computed:            true,
@@ -183,18 +199,22 @@ func (d *StorageAggregatesDataSource) Read(ctx context.Context, req datasource.R
 	data.StorageAggregates = make([]StorageAggregateDataSourceModel, len(restInfo))
 	for index, record := range restInfo {
 		data.StorageAggregates[index] = StorageAggregateDataSourceModel{
-			CxProfileName: data.CxProfileName,
-			ID:            types.StringValue(record.UUID),
-			DiskCount:     types.Int64Value(record.BlockStorage.Primary.DiskCount),
-			DiskClass:     types.StringValue(record.BlockStorage.Primary.DiskClass),
-			RaidType:      types.StringValue(record.BlockStorage.Primary.RaidType),
-			RaidSize:      types.Int64Value(record.BlockStorage.Primary.RaidSize),
-			Encryption:    types.BoolValue(record.DataEncryption.SoftwareEncryptionEnabled),
-			IsMirrored:    types.BoolValue(record.BlockStorage.Mirror.Enabled),
-			SnaplockType:  types.StringValue(record.SnaplockType),
-			State:         types.StringValue(record.State),
-			Name:          types.StringValue(record.Name),
-			Node:          types.StringValue(record.Node.Name),
+			CxProfileName:         data.CxProfileName,
+			ID:                    types.StringValue(record.UUID),
+			DiskCount:             types.Int64Value(record.BlockStorage.Primary.DiskCount),
+			DiskClass:             types.StringValue(record.BlockStorage.Primary.DiskClass),
+			RaidType:              types.StringValue(record.BlockStorage.Primary.RaidType),
+			RaidSize:              types.Int64Value(record.BlockStorage.Primary.RaidSize),
+			Encryption:            types.BoolValue(record.DataEncryption.SoftwareEncryptionEnabled),
+			IsMirrored:            types.BoolValue(record.BlockStorage.Mirror.Enabled),
+			SnaplockType:          types.StringValue(record.SnaplockType),
+			State:                 types.StringValue(record.State),
+			Name:                  types.StringValue(record.Name),
+			Node:                  types.StringValue(record.Node.Name),
+			SpaceSize:             types.Int64Value(record.Space.BlockStorage.Size),
+			SpaceAvailable:        types.Int64Value(record.Space.BlockStorage.Available),
+			SpaceUsed:             types.Int64Value(record.Space.BlockStorage.Used),
+			TieringAttachEligible: types.BoolValue(record.CloudStorage.AttachEligible),
 		}
 	}
 
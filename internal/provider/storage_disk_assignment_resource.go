@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageDiskAssignmentResource{}
+
+// NewStorageDiskAssignmentResource is a helper function to simplify the provider implementation.
+func NewStorageDiskAssignmentResource() resource.Resource {
+	return &StorageDiskAssignmentResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_disk_assignment_resource",
+		},
+	}
+}
+
+// StorageDiskAssignmentResource defines the resource implementation.
+type StorageDiskAssignmentResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageDiskAssignmentResourceModel describes the resource data model.
+type StorageDiskAssignmentResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	NodeName      types.String   `tfsdk:"node_name"`
+	DiskNames     []types.String `tfsdk:"disk_names"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageDiskAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageDiskAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assign unowned disks to a node. Destroying the resource, or removing a disk from the list, does not remove the ownership",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node the disks are assigned to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the disks to assign, eg 1.0.12",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Disk assignment identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageDiskAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+// A disk no longer owned by the node is removed from the list, so that it is assigned again on the next apply.
+func (r *StorageDiskAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageDiskAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetListStorageDisks(errorHandler, *client, &interfaces.StorageDiskDataSourceFilterModel{NodeName: data.NodeName.ValueString()})
+	if err != nil {
+		// error reporting done inside GetListStorageDisks
+		return
+	}
+	owned := make(map[string]bool, len(restInfo))
+	for _, disk := range restInfo {
+		owned[disk.Name] = true
+	}
+	var diskNames []types.String
+	for _, diskName := range data.DiskNames {
+		if owned[diskName.ValueString()] {
+			diskNames = append(diskNames, diskName)
+		}
+	}
+	data.DiskNames = diskNames
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// assignStorageDisks assigns the disks that are not already owned by the node, disks owned by another node are reported as an error
+func assignStorageDisks(errorHandler *utils.ErrorHandler, client restclient.RestClient, nodeName string, diskNames []string) error {
+	var unowned []string
+	for _, diskName := range diskNames {
+		disk, err := interfaces.GetStorageDiskByName(errorHandler, client, diskName)
+		if err != nil {
+			return err
+		}
+		if disk.Node.Name == nodeName {
+			continue
+		}
+		if disk.Node.Name != "" {
+			return errorHandler.MakeAndReportError("error assigning storage disk",
+				fmt.Sprintf("disk %s is already owned by node %s, container type %s", diskName, disk.Node.Name, disk.ContainerType))
+		}
+		unowned = append(unowned, diskName)
+	}
+	// only assign once all the disks are known to be available
+	for _, diskName := range unowned {
+		if err := interfaces.AssignStorageDisk(errorHandler, client, diskName, nodeName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create assigns the disks to the node
+func (r *StorageDiskAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageDiskAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = assignStorageDisks(errorHandler, *client, data.NodeName.ValueString(), expandTypesStringList(data.DiskNames)); err != nil {
+		return
+	}
+	data.ID = data.NodeName
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update assigns the disks added to the list
+func (r *StorageDiskAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StorageDiskAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = assignStorageDisks(errorHandler, *client, data.NodeName.ValueString(), expandTypesStringList(data.DiskNames)); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state, the disks remain assigned to the node.
+func (r *StorageDiskAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageDiskAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("disk assignment %s removed from state, disks remain assigned", data.ID.ValueString()))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageDiskAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStorageDiskAssignmentResourceConfig("non_existant_disk"),
+				ExpectError: regexp.MustCompile("no disk found"),
+			},
+			// Create and read testing, the disk is already owned by the node
+			{
+				Config: testAccStorageDiskAssignmentResourceConfig("1.0.12"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_disk_assignment_resource.example", "node_name", "swenjun-vsim1"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_disk_assignment_resource.example", "disk_names.0", "1.0.12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageDiskAssignmentResourceConfig(diskName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_disk_assignment_resource" "example" {
+  cx_profile_name = "cluster4"
+  node_name = "swenjun-vsim1"
+  disk_names = ["%s"]
+}`, host, admin, password, diskName)
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageDisksDataSource{}
+
+// NewStorageDisksDataSource is a helper function to simplify the provider implementation.
+func NewStorageDisksDataSource() datasource.DataSource {
+	return &StorageDisksDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_disks_data_source",
+		},
+	}
+}
+
+// StorageDisksDataSource defines the data source implementation.
+type StorageDisksDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageDisksDataSourceModel describes the data source data model.
+type StorageDisksDataSourceModel struct {
+	CxProfileName types.String                      `tfsdk:"cx_profile_name"`
+	Disks         []StorageDiskDataSourceModel      `tfsdk:"disks"`
+	Filter        *StorageDiskDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// StorageDiskDataSourceModel describes the data model for a disk.
+type StorageDiskDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	ID            types.String `tfsdk:"id"`
+	ContainerType types.String `tfsdk:"container_type"`
+	Type          types.String `tfsdk:"type"`
+	Class         types.String `tfsdk:"class"`
+	Model         types.String `tfsdk:"model"`
+	SerialNumber  types.String `tfsdk:"serial_number"`
+	NodeName      types.String `tfsdk:"node_name"`
+	HomeNodeName  types.String `tfsdk:"home_node_name"`
+	State         types.String `tfsdk:"state"`
+	UsableSize    types.Int64  `tfsdk:"usable_size"`
+	Pool          types.String `tfsdk:"pool"`
+	Bay           types.Int64  `tfsdk:"bay"`
+}
+
+// StorageDiskDataSourceFilterModel describes the data source data model for queries.
+type StorageDiskDataSourceFilterModel struct {
+	Name          types.String `tfsdk:"name"`
+	ContainerType types.String `tfsdk:"container_type"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Class         types.String `tfsdk:"class"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageDisksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageDisksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage disks data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Disk name, wildcards are supported, eg 1.0.*",
+						Optional:            true,
+					},
+					"container_type": schema.StringAttribute{
+						MarkdownDescription: "Container type, eg aggregate, spare, unassigned, shared",
+						Optional:            true,
+					},
+					"node_name": schema.StringAttribute{
+						MarkdownDescription: "Owner node name",
+						Optional:            true,
+					},
+					"class": schema.StringAttribute{
+						MarkdownDescription: "Disk class, eg capacity, performance, solid_state",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"disks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Disk name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Disk UUID",
+							Computed:            true,
+						},
+						"container_type": schema.StringAttribute{
+							MarkdownDescription: "Container type",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Disk type, eg ssd, sas, fsas",
+							Computed:            true,
+						},
+						"class": schema.StringAttribute{
+							MarkdownDescription: "Disk class",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Disk model",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "Disk serial number",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Owner node name, empty for an unassigned disk",
+							Computed:            true,
+						},
+						"home_node_name": schema.StringAttribute{
+							MarkdownDescription: "Home node name",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Disk state, eg present, broken",
+							Computed:            true,
+						},
+						"usable_size": schema.Int64Attribute{
+							MarkdownDescription: "Usable size in bytes",
+							Computed:            true,
+						},
+						"pool": schema.StringAttribute{
+							MarkdownDescription: "Pool, eg pool0, pool1",
+							Computed:            true,
+						},
+						"bay": schema.Int64Attribute{
+							MarkdownDescription: "Shelf bay",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageDisksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageDisksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageDisksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.StorageDiskDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.StorageDiskDataSourceFilterModel{
+			Name:          data.Filter.Name.ValueString(),
+			ContainerType: data.Filter.ContainerType.ValueString(),
+			NodeName:      data.Filter.NodeName.ValueString(),
+			Class:         data.Filter.Class.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListStorageDisks(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListStorageDisks
+		return
+	}
+
+	data.Disks = make([]StorageDiskDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.Disks[index] = StorageDiskDataSourceModel{
+			Name:          types.StringValue(record.Name),
+			ID:            types.StringValue(record.UUID),
+			ContainerType: types.StringValue(record.ContainerType),
+			Type:          types.StringValue(record.Type),
+			Class:         types.StringValue(record.Class),
+			Model:         types.StringValue(record.Model),
+			SerialNumber:  types.StringValue(record.SerialNumber),
+			NodeName:      types.StringValue(record.Node.Name),
+			HomeNodeName:  types.StringValue(record.HomeNode.Name),
+			State:         types.StringValue(record.State),
+			UsableSize:    types.Int64Value(record.UsableSize),
+			Pool:          types.StringValue(record.Pool),
+			Bay:           types.Int64Value(record.Bay),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
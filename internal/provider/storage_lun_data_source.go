@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageLunDataSource{}
+
+// NewStorageLunDataSource is a helper function to simplify the provider implementation.
+func NewStorageLunDataSource() datasource.DataSource {
+	return &StorageLunDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_lun_data_source",
+		},
+	}
+}
+
+// StorageLunDataSource defines the data source implementation.
+type StorageLunDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageLunDataSourceModel describes the data source data model.
+type StorageLunDataSourceModel struct {
+	CxProfileName types.String                   `tfsdk:"cx_profile_name"`
+	Name          types.String                   `tfsdk:"name"`
+	SVMName       types.String                   `tfsdk:"svm_name"`
+	ID            types.String                   `tfsdk:"id"`
+	VolumeName    types.String                   `tfsdk:"volume_name"`
+	SerialNumber  types.String                   `tfsdk:"serial_number"`
+	OsType        types.String                   `tfsdk:"os_type"`
+	Enabled       types.Bool                     `tfsdk:"enabled"`
+	Comment       types.String                   `tfsdk:"comment"`
+	Size          types.Int64                    `tfsdk:"size"`
+	UsedSize      types.Int64                    `tfsdk:"used_size"`
+	State         types.String                   `tfsdk:"state"`
+	Mapped        types.Bool                     `tfsdk:"mapped"`
+	LunMaps       []StorageLunMapDataSourceModel `tfsdk:"lun_maps"`
+}
+
+// StorageLunMapDataSourceModel describes an igroup a LUN is mapped to.
+type StorageLunMapDataSourceModel struct {
+	IgroupName        types.String `tfsdk:"igroup_name"`
+	LogicalUnitNumber types.Int64  `tfsdk:"logical_unit_number"`
+}
+
+// StorageLunDataSourceFilterModel describes the data source data model for queries.
+type StorageLunDataSourceFilterModel struct {
+	Name       types.String `tfsdk:"name"`
+	SVMName    types.String `tfsdk:"svm_name"`
+	VolumeName types.String `tfsdk:"volume_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageLunDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageLunDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LUN data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "LUN path, eg /vol/vol1/lun1",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "LUN svm name",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "LUN UUID",
+				Computed:            true,
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Volume containing the LUN",
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "LUN serial number, used by hosts to identify the LUN",
+				Computed:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system type of the LUN, eg linux, windows, vmware",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the LUN is enabled",
+				Computed:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "LUN comment",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "LUN size in bytes",
+				Computed:            true,
+			},
+			"used_size": schema.Int64Attribute{
+				MarkdownDescription: "Space used by the LUN in bytes",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "LUN state, eg online, offline",
+				Computed:            true,
+			},
+			"mapped": schema.BoolAttribute{
+				MarkdownDescription: "Whether the LUN is mapped to at least one igroup",
+				Computed:            true,
+			},
+			"lun_maps": schema.ListNestedAttribute{
+				MarkdownDescription: "Igroups the LUN is mapped to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"igroup_name": schema.StringAttribute{
+							MarkdownDescription: "Igroup name",
+							Computed:            true,
+						},
+						"logical_unit_number": schema.Int64Attribute{
+							MarkdownDescription: "Logical unit number presented to the hosts of the igroup",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageLunDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// flattenStorageLun sets the computed attributes from a LUN record
+func flattenStorageLun(data *StorageLunDataSourceModel, record interfaces.StorageLunGetDataModelONTAP) {
+	data.Name = types.StringValue(record.Name)
+	data.SVMName = types.StringValue(record.SVM.Name)
+	data.ID = types.StringValue(record.UUID)
+	data.VolumeName = types.StringValue(record.Location.Volume.Name)
+	data.SerialNumber = types.StringValue(record.SerialNumber)
+	data.OsType = types.StringValue(record.OsType)
+	data.Enabled = types.BoolValue(record.Enabled)
+	data.Comment = types.StringValue(record.Comment)
+	data.Size = types.Int64Value(record.Space.Size)
+	data.UsedSize = types.Int64Value(record.Space.Used)
+	data.State = types.StringValue(record.Status.State)
+	data.Mapped = types.BoolValue(record.Status.Mapped)
+	data.LunMaps = make([]StorageLunMapDataSourceModel, len(record.LunMaps))
+	for index, lunMap := range record.LunMaps {
+		data.LunMaps[index] = StorageLunMapDataSourceModel{
+			IgroupName:        types.StringValue(lunMap.Igroup.Name),
+			LogicalUnitNumber: types.Int64Value(lunMap.LogicalUnitNumber),
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageLunDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageLunDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetStorageLunByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetStorageLunByName
+		return
+	}
+	flattenStorageLun(&data, *restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageLunMetricsDataSource{}
+
+// NewStorageLunMetricsDataSource is a helper function to simplify the provider implementation.
+func NewStorageLunMetricsDataSource() datasource.DataSource {
+	return &StorageLunMetricsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_lun_metrics_data_source",
+		},
+	}
+}
+
+// StorageLunMetricsDataSource defines the data source implementation.
+type StorageLunMetricsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageLunMetricsDataSourceModel describes the data source data model.
+type StorageLunMetricsDataSourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	Name          types.String                       `tfsdk:"name"`
+	SVMName       types.String                       `tfsdk:"svm_name"`
+	Interval      types.String                       `tfsdk:"interval"`
+	Metrics       []PerformanceMetricDataSourceModel `tfsdk:"metrics"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageLunMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageLunMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LUN metrics data source, to read the latency, IOPS, and throughput of a LUN",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "LUN name, the LUN path, eg /vol/vol1/lun1",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"interval": metricsIntervalAttribute(),
+			"metrics":  metricsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageLunMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageLunMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageLunMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	lun, err := interfaces.GetStorageLunByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetStorageLunByName
+		return
+	}
+
+	restInfo, err := interfaces.GetStorageLunMetrics(errorHandler, *client, lun.UUID, metricsInterval(data.Interval))
+	if err != nil {
+		// error reporting done inside GetStorageLunMetrics
+		return
+	}
+	data.Metrics = flattenPerformanceMetrics(restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %s/%s, %d samples", data.SVMName.ValueString(), data.Name.ValueString(), len(data.Metrics)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
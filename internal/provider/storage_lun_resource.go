@@ -0,0 +1,461 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageLunResource{}
+var _ resource.ResourceWithImportState = &StorageLunResource{}
+var _ resource.ResourceWithModifyPlan = &StorageLunResource{}
+
+// NewStorageLunResource is a helper function to simplify the provider implementation.
+func NewStorageLunResource() resource.Resource {
+	return &StorageLunResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_lun_resource",
+		},
+	}
+}
+
+// StorageLunResource defines the resource implementation.
+type StorageLunResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageLunResourceModel describes the resource data model.
+type StorageLunResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Name          types.String `tfsdk:"name"`
+	OsType        types.String `tfsdk:"os_type"`
+	Size          types.Int64  `tfsdk:"size"`
+	AllowShrink   types.Bool   `tfsdk:"allow_shrink"`
+	State         types.String `tfsdk:"state"`
+	Comment       types.String `tfsdk:"comment"`
+	CloneSource   types.String `tfsdk:"clone_source"`
+	SerialNumber  types.String `tfsdk:"serial_number"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageLunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageLunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage LUN resource, to create a LUN or a clone of a LUN, resize it online, and take it online or offline",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "LUN path, eg /vol/vol1/lun1 or /vol/vol1/qtree1/lun1. The volume and qtree must exist",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/vol/[^/]+/.+`), "must be a LUN path, eg /vol/vol1/lun1"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the host accessing the LUN, eg linux, windows_2008, vmware. Required unless clone_source is set, a clone inherits the os_type of its source",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("clone_source"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "LUN size in bytes. ONTAP may round it up, the configured size is kept unless the LUN is resized outside of Terraform. The LUN is grown online. Shrinking it requires allow_shrink to be set. Required unless clone_source is set, a clone has the size of its source by default",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(4096),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"clone_source": schema.StringAttribute{
+				MarkdownDescription: "Path of the LUN to clone on create, eg /vol/vol1/lun1, or of a LUN in a snapshot, eg /vol/vol1/.snapshot/snap1/lun1. The source must be in the same SVM",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/vol/[^/]+/.+`), "must be a LUN path, eg /vol/vol1/lun1"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_shrink": schema.BoolAttribute{
+				MarkdownDescription: "Confirm that size can be reduced. Shrinking a LUN can lose the data at the end of the LUN if the host file system was not shrunk first",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "LUN state, online or offline. An offline LUN is not available to the hosts",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("online", "offline"),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "LUN comment",
+				Optional:            true,
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "LUN serial number",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "LUN UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageLunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan checks that a new LUN has a size and an os_type unless it is a clone,
+// and rejects a size reduction unless allow_shrink is set, before any change is applied.
+func (r *StorageLunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// nothing to check on destroy
+		return
+	}
+	var plan, state *StorageLunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		var config *StorageLunResourceModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() || config == nil {
+			return
+		}
+		if config.CloneSource.IsNull() && (config.Size.IsNull() || config.OsType.IsNull()) {
+			resp.Diagnostics.AddError("Missing LUN attributes", "size and os_type are required to create a LUN, unless clone_source is set")
+		}
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+	if plan.Size.IsUnknown() || plan.Size.ValueInt64() >= state.Size.ValueInt64() {
+		return
+	}
+	if !plan.AllowShrink.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("size"), "LUN shrink not allowed",
+			fmt.Sprintf("size of LUN %s would be reduced from %d to %d bytes, set allow_shrink to true to confirm", state.Name.ValueString(), state.Size.ValueInt64(), plan.Size.ValueInt64()))
+	}
+}
+
+// lunSizeRounding is the largest amount ONTAP adds to a requested LUN size when aligning it
+const lunSizeRounding = 1024 * 1024
+
+// lunSizeMatches returns true if size is the requested size, as rounded up by ONTAP
+func lunSizeMatches(requested int64, size int64) bool {
+	return size >= requested && size-requested < lunSizeRounding
+}
+
+// setState copies the LUN info returned by ONTAP into the resource data model
+// The size in data is kept if ONTAP only rounded it, otherwise the configured size would never match
+func (data *StorageLunResourceModel) setState(restInfo *interfaces.StorageLunGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.UUID)
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.OsType = types.StringValue(restInfo.OsType)
+	if data.Size.IsNull() || data.Size.IsUnknown() || !lunSizeMatches(data.Size.ValueInt64(), restInfo.Space.Size) {
+		data.Size = types.Int64Value(restInfo.Space.Size)
+	}
+	data.State = types.StringValue("offline")
+	if restInfo.Enabled {
+		data.State = types.StringValue("online")
+	}
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.SerialNumber = types.StringValue(restInfo.SerialNumber)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StorageLunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageLunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var restInfo *interfaces.StorageLunGetDataModelONTAP
+	if data.ID.IsNull() {
+		// on import
+		restInfo, err = interfaces.GetStorageLunByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	} else {
+		restInfo, err = interfaces.GetStorageLun(errorHandler, *client, data.ID.ValueString())
+	}
+	if err != nil {
+		// error reporting done inside GetStorageLun
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("LUN %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setState(restInfo)
+	if data.AllowShrink.IsNull() {
+		// on import
+		data.AllowShrink = types.BoolValue(false)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "storage/luns/"+data.ID.ValueString())...)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the LUN, then takes it offline if requested
+func (r *StorageLunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageLunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.StorageLunResourceBodyDataModelONTAP{
+		Name: data.Name.ValueString(),
+		SVM:  &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+	}
+	if data.CloneSource.IsNull() {
+		body.OsType = data.OsType.ValueString()
+		body.Space = &interfaces.StorageLunSpaceBody{Size: data.Size.ValueInt64()}
+	} else {
+		// a clone gets the os_type and size of its source
+		body.Clone = &interfaces.StorageLunCloneBody{Source: interfaces.StorageLunCloneSource{Name: data.CloneSource.ValueString()}}
+	}
+	if !data.Comment.IsUnknown() {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	if data.State.ValueString() == "offline" {
+		enabled := false
+		body.Enabled = &enabled
+	}
+	restInfo, err := interfaces.CreateStorageLun(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read the LUN to know the size rounded by ONTAP and the serial number
+	lun, err := interfaces.GetStorageLun(errorHandler, *client, restInfo.UUID)
+	if err != nil {
+		return
+	}
+	if lun == nil {
+		errorHandler.MakeAndReportError("error creating LUN", fmt.Sprintf("LUN %s not found after create", data.Name.ValueString()))
+		return
+	}
+	// a clone is resized once created
+	if !data.CloneSource.IsNull() && !data.Size.IsUnknown() && !lunSizeMatches(data.Size.ValueInt64(), lun.Space.Size) {
+		if data.Size.ValueInt64() < lun.Space.Size && !data.AllowShrink.ValueBool() {
+			errorHandler.MakeAndReportError("error resizing LUN clone", fmt.Sprintf("size of LUN %s would be reduced from the %d bytes of %s, set allow_shrink to true to confirm", data.Name.ValueString(), lun.Space.Size, data.CloneSource.ValueString()))
+		} else if err = interfaces.UpdateStorageLun(errorHandler, *client, lun.UUID, interfaces.StorageLunResourceBodyDataModelONTAP{Space: &interfaces.StorageLunSpaceBody{Size: data.Size.ValueInt64()}}); err == nil {
+			lun, err = interfaces.GetStorageLun(errorHandler, *client, lun.UUID)
+			if err != nil || lun == nil {
+				return
+			}
+		}
+	}
+	// save the LUN even on a resize error, so that it is tainted rather than orphaned
+	data.setState(lun)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update resizes the LUN, changes its comment, or takes it online or offline.
+func (r *StorageLunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *StorageLunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
+
+	var body interfaces.StorageLunResourceBodyDataModelONTAP
+	if !data.Size.Equal(state.Size) {
+		if data.Size.ValueInt64() < state.Size.ValueInt64() && !data.AllowShrink.ValueBool() {
+			errorHandler.MakeAndReportError("error updating LUN", fmt.Sprintf("size of LUN %s can not be reduced unless allow_shrink is true", data.Name.ValueString()))
+			return
+		}
+		body.Space = &interfaces.StorageLunSpaceBody{Size: data.Size.ValueInt64()}
+	}
+	if !data.Comment.IsUnknown() && !data.Comment.Equal(state.Comment) {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	if !data.State.IsUnknown() && !data.State.Equal(state.State) {
+		enabled := data.State.ValueString() == "online"
+		body.Enabled = &enabled
+	}
+	if err = interfaces.UpdateStorageLun(errorHandler, *client, state.ID.ValueString(), body); err != nil {
+		return
+	}
+
+	lun, err := interfaces.GetStorageLun(errorHandler, *client, state.ID.ValueString())
+	if err != nil {
+		return
+	}
+	if lun == nil {
+		errorHandler.MakeAndReportError("error updating LUN", fmt.Sprintf("LUN %s not found after update", data.Name.ValueString()))
+		return
+	}
+	data.setState(lun)
+
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "storage/luns/"+state.ID.ValueString())...)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *StorageLunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageLunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "storage_lun UUID is null")
+		return
+	}
+	err = interfaces.DeleteStorageLun(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *StorageLunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a storage LUN resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
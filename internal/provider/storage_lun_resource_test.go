@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+)
+
+func TestStorageLunResourceModelSetStateSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      types.Int64
+		ontapSize int64
+		want      int64
+	}{
+		{name: "aligned", size: types.Int64Value(1073741824), ontapSize: 1073741824, want: 1073741824},
+		{name: "rounded by ONTAP", size: types.Int64Value(1000000000), ontapSize: 1000001536, want: 1000000000},
+		{name: "resized outside of Terraform", size: types.Int64Value(1073741824), ontapSize: 2147483648, want: 2147483648},
+		{name: "smaller than requested", size: types.Int64Value(1073741824), ontapSize: 1073737728, want: 1073737728},
+		{name: "import", size: types.Int64Null(), ontapSize: 1000001536, want: 1000001536},
+		{name: "clone", size: types.Int64Unknown(), ontapSize: 1073741824, want: 1073741824},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := StorageLunResourceModel{Size: tt.size}
+			data.setState(&interfaces.StorageLunGetDataModelONTAP{Space: interfaces.StorageLunSpace{Size: tt.ontapSize}})
+			if data.Size.ValueInt64() != tt.want {
+				t.Errorf("setState() size = %d, want %d", data.Size.ValueInt64(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAccStorageLunResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccStorageLunResourceConfig(1073741824, false, "online"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "name", "/vol/carchi_test_root/acc_test_lun"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "size", "1073741824"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "state", "online"),
+				),
+			},
+			// Grow and offline testing
+			{
+				Config: testAccStorageLunResourceConfig(2147483648, false, "offline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "size", "2147483648"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "state", "offline"),
+				),
+			},
+			// Shrink without confirmation
+			{
+				Config:      testAccStorageLunResourceConfig(1073741824, false, "offline"),
+				ExpectError: regexp.MustCompile("LUN shrink not allowed"),
+			},
+			// Shrink with confirmation
+			{
+				Config: testAccStorageLunResourceConfig(1073741824, true, "online"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "size", "1073741824"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "state", "online"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_lun_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "/vol/carchi_test_root/acc_test_lun", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.example", "name", "/vol/carchi_test_root/acc_test_lun"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageLunResourceClone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing size and os_type
+			{
+				Config:      testAccStorageLunResourceCloneConfig("", ""),
+				ExpectError: regexp.MustCompile("Missing LUN attributes"),
+			},
+			// Create a clone and read testing
+			{
+				Config: testAccStorageLunResourceCloneConfig(`clone_source = netapp-ontap_storage_lun_resource.source.name`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.clone", "name", "/vol/carchi_test_root/acc_test_lun_clone"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.clone", "os_type", "linux"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.clone", "size", "1073741824"),
+				),
+			},
+			// Grow the clone
+			{
+				Config: testAccStorageLunResourceCloneConfig(`clone_source = netapp-ontap_storage_lun_resource.source.name`, "size = 2147483648"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_lun_resource.clone", "size", "2147483648"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageLunResourceCloneConfig(cloneSource string, size string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_lun_resource" "source" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "/vol/carchi_test_root/acc_test_lun_source"
+  os_type = "linux"
+  size = 1073741824
+}
+
+resource "netapp-ontap_storage_lun_resource" "clone" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "/vol/carchi_test_root/acc_test_lun_clone"
+  %s
+  %s
+}`, host, admin, password, cloneSource, size)
+}
+
+func testAccStorageLunResourceConfig(size int64, allowShrink bool, state string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_lun_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "/vol/carchi_test_root/acc_test_lun"
+  os_type = "linux"
+  size = %d
+  allow_shrink = %t
+  state = "%s"
+}`, host, admin, password, size, allowShrink, state)
+}
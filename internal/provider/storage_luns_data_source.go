@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageLunsDataSource{}
+
+// NewStorageLunsDataSource is a helper function to simplify the provider implementation.
+func NewStorageLunsDataSource() datasource.DataSource {
+	return &StorageLunsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_luns_data_source",
+		},
+	}
+}
+
+// StorageLunsDataSource defines the data source implementation.
+type StorageLunsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageLunsDataSourceModel describes the data source data model.
+type StorageLunsDataSourceModel struct {
+	CxProfileName types.String                     `tfsdk:"cx_profile_name"`
+	StorageLuns   []StorageLunDataSourceModel      `tfsdk:"storage_luns"`
+	Filter        *StorageLunDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageLunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageLunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LUNs data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "LUN path, wildcards are supported, eg /vol/vol1/*",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "LUN svm name",
+						Optional:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						MarkdownDescription: "Volume containing the LUN",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"storage_luns": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "LUN path, eg /vol/vol1/lun1",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "LUN svm name",
+							Required:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "LUN UUID",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Volume containing the LUN",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "LUN serial number, used by hosts to identify the LUN",
+							Computed:            true,
+						},
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "Operating system type of the LUN, eg linux, windows, vmware",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the LUN is enabled",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "LUN comment",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "LUN size in bytes",
+							Computed:            true,
+						},
+						"used_size": schema.Int64Attribute{
+							MarkdownDescription: "Space used by the LUN in bytes",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "LUN state, eg online, offline",
+							Computed:            true,
+						},
+						"mapped": schema.BoolAttribute{
+							MarkdownDescription: "Whether the LUN is mapped to at least one igroup",
+							Computed:            true,
+						},
+						"lun_maps": schema.ListNestedAttribute{
+							MarkdownDescription: "Igroups the LUN is mapped to",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"igroup_name": schema.StringAttribute{
+										MarkdownDescription: "Igroup name",
+										Computed:            true,
+									},
+									"logical_unit_number": schema.Int64Attribute{
+										MarkdownDescription: "Logical unit number presented to the hosts of the igroup",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageLunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageLunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageLunsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.StorageLunDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.StorageLunDataSourceFilterModel{
+			Name:       data.Filter.Name.ValueString(),
+			SVMName:    data.Filter.SVMName.ValueString(),
+			VolumeName: data.Filter.VolumeName.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListStorageLuns(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListStorageLuns
+		return
+	}
+
+	data.StorageLuns = make([]StorageLunDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.StorageLuns[index] = StorageLunDataSourceModel{
+			CxProfileName: data.CxProfileName,
+		}
+		flattenStorageLun(&data.StorageLuns[index], record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageObjectStoreProfilerResource{}
+
+// NewStorageObjectStoreProfilerResource is a helper function to simplify the provider implementation.
+func NewStorageObjectStoreProfilerResource() resource.Resource {
+	return &StorageObjectStoreProfilerResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_object_store_profiler_resource",
+		},
+	}
+}
+
+// StorageObjectStoreProfilerResource defines the resource implementation.
+type StorageObjectStoreProfilerResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageObjectStoreProfilerResourceModel describes the resource data model.
+type StorageObjectStoreProfilerResourceModel struct {
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	ObjectStoreName types.String `tfsdk:"object_store_name"`
+	NodeName        types.String `tfsdk:"node_name"`
+	Trigger         types.String `tfsdk:"trigger"`
+	FailOnError     types.Bool   `tfsdk:"fail_on_error"`
+	Status          types.String `tfsdk:"status"`
+	Results         types.List   `tfsdk:"results"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// StorageObjectStoreProfilerResultModel describes the profiler result for an operation.
+type StorageObjectStoreProfilerResultModel struct {
+	OpName         types.String `tfsdk:"op_name"`
+	OpSize         types.String `tfsdk:"op_size"`
+	OpCount        types.Int64  `tfsdk:"op_count"`
+	FailedCount    types.Int64  `tfsdk:"failed_count"`
+	LatencyMinimum types.String `tfsdk:"latency_minimum"`
+	LatencyMaximum types.String `tfsdk:"latency_maximum"`
+	LatencyAverage types.String `tfsdk:"latency_average"`
+	Throughput     types.String `tfsdk:"throughput"`
+}
+
+var storageObjectStoreProfilerResultAttrTypes = map[string]attr.Type{
+	"op_name":         types.StringType,
+	"op_size":         types.StringType,
+	"op_count":        types.Int64Type,
+	"failed_count":    types.Int64Type,
+	"latency_minimum": types.StringType,
+	"latency_maximum": types.StringType,
+	"latency_average": types.StringType,
+	"throughput":      types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *StorageObjectStoreProfilerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageObjectStoreProfilerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Run the object store profiler against a cloud target, to measure latency and throughput before attaching it to an aggregate. The profiler runs on create, changing the object store, the node or trigger runs it again",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"object_store_name": schema.StringAttribute{
+				MarkdownDescription: "Cloud target (object store configuration) name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node running the profiler",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value. Changing it runs the profiler again",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				MarkdownDescription: "Report an error when an operation failed during the profiling, so that resources depending on this one are not created. Defaults to true",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Profiler status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Profiler results, one entry per operation (PUT, GET)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"op_name": schema.StringAttribute{
+							MarkdownDescription: "Operation name",
+							Computed:            true,
+						},
+						"op_size": schema.StringAttribute{
+							MarkdownDescription: "Size of the objects",
+							Computed:            true,
+						},
+						"op_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of operations",
+							Computed:            true,
+						},
+						"failed_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed operations",
+							Computed:            true,
+						},
+						"latency_minimum": schema.StringAttribute{
+							MarkdownDescription: "Minimum latency",
+							Computed:            true,
+						},
+						"latency_maximum": schema.StringAttribute{
+							MarkdownDescription: "Maximum latency",
+							Computed:            true,
+						},
+						"latency_average": schema.StringAttribute{
+							MarkdownDescription: "Average latency",
+							Computed:            true,
+						},
+						"throughput": schema.StringAttribute{
+							MarkdownDescription: "Throughput",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Object store profiler identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageObjectStoreProfilerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read keeps the Terraform state, as the profiling is a one time action.
+func (r *StorageObjectStoreProfilerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageObjectStoreProfilerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create runs the profiler and waits for the results
+func (r *StorageObjectStoreProfilerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageObjectStoreProfilerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	objectStoreName := data.ObjectStoreName.ValueString()
+	nodeName := data.NodeName.ValueString()
+	// report a missing cloud target before starting the profiler
+	if _, err = interfaces.GetCloudTargetByName(errorHandler, *client, objectStoreName); err != nil {
+		return
+	}
+	if err = interfaces.StartObjectStoreProfiler(errorHandler, *client, nodeName, objectStoreName); err != nil {
+		return
+	}
+	records, err := interfaces.WaitForObjectStoreProfiler(errorHandler, *client, nodeName, objectStoreName, r.config.providerConfig.JobCompletionTimeOut, 10)
+	if err != nil {
+		return
+	}
+
+	results := make([]StorageObjectStoreProfilerResultModel, len(records))
+	var failedCount int64
+	for index, record := range records {
+		results[index] = StorageObjectStoreProfilerResultModel{
+			OpName:         types.StringValue(record.OpName),
+			OpSize:         types.StringValue(record.OpSize),
+			OpCount:        types.Int64Value(record.OpCount),
+			FailedCount:    types.Int64Value(record.OpFailed),
+			LatencyMinimum: types.StringValue(record.OpLatencyMinimum),
+			LatencyMaximum: types.StringValue(record.OpLatencyMaximum),
+			LatencyAverage: types.StringValue(record.OpLatencyAverage),
+			Throughput:     types.StringValue(record.OpThroughput),
+		}
+		failedCount += record.OpFailed
+	}
+	if failedCount > 0 && data.FailOnError.ValueBool() {
+		errorHandler.MakeAndReportError("object store profiler reported errors",
+			fmt.Sprintf("%d operations failed while profiling object store %s from node %s: %#v", failedCount, objectStoreName, nodeName, records))
+		return
+	}
+
+	resultList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: storageObjectStoreProfilerResultAttrTypes}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Results = resultList
+	data.Status = types.StringValue("")
+	if len(records) > 0 {
+		data.Status = types.StringValue(records[0].Status)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", nodeName, objectStoreName))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only applies to fail_on_error, as the other attributes require a replacement.
+func (r *StorageObjectStoreProfilerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StorageObjectStoreProfilerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *StorageObjectStoreProfilerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageObjectStoreProfilerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("object store profiler %s removed from state", data.ID.ValueString()))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageObjectStoreProfilerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStorageObjectStoreProfilerResourceConfig("non_existant_target"),
+				ExpectError: regexp.MustCompile("no cloud target found"),
+			},
+			// Create and read testing
+			{
+				Config: testAccStorageObjectStoreProfilerResourceConfig("carchi_s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_object_store_profiler_resource.example", "object_store_name", "carchi_s3"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_storage_object_store_profiler_resource.example", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageObjectStoreProfilerResourceConfig(objectStoreName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_object_store_profiler_resource" "example" {
+  cx_profile_name = "cluster4"
+  object_store_name = "%s"
+  node_name = "swenjun-vsim1"
+}`, host, admin, password, objectStoreName)
+}
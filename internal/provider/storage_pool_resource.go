@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StoragePoolResource{}
+var _ resource.ResourceWithImportState = &StoragePoolResource{}
+
+// NewStoragePoolResource is a helper function to simplify the provider implementation.
+func NewStoragePoolResource() resource.Resource {
+	return &StoragePoolResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_pool_resource",
+		},
+	}
+}
+
+// StoragePoolResource defines the resource implementation.
+type StoragePoolResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StoragePoolAllocationUnitsResourceModel describes the allocation units assigned to a node.
+type StoragePoolAllocationUnitsResourceModel struct {
+	NodeName types.String `tfsdk:"node_name"`
+	Count    types.Int64  `tfsdk:"count"`
+}
+
+// StoragePoolResourceModel describes the resource data model.
+type StoragePoolResourceModel struct {
+	CxProfileName        types.String                              `tfsdk:"cx_profile_name"`
+	Name                 types.String                              `tfsdk:"name"`
+	NodeNames            []types.String                            `tfsdk:"node_names"`
+	DiskCount            types.Int64                               `tfsdk:"disk_count"`
+	SpareAllocationUnits []StoragePoolAllocationUnitsResourceModel `tfsdk:"spare_allocation_units"`
+	StorageType          types.String                              `tfsdk:"storage_type"`
+	TotalSize            types.Int64                               `tfsdk:"total_size"`
+	ID                   types.String                              `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StoragePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StoragePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage pool (shared SSD) resource, the allocation units can be used to build Flash Pool aggregates",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Storage pool name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Nodes that can use the storage pool, usually the two nodes of an HA pair",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of SSDs in the storage pool, can only be increased",
+				Required:            true,
+			},
+			"spare_allocation_units": schema.ListNestedAttribute{
+				MarkdownDescription: "Spare allocation units assigned to each node, by default ONTAP splits them evenly",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Node owning the allocation units",
+							Required:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of allocation units owned by the node",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"storage_type": schema.StringAttribute{
+				MarkdownDescription: "Storage type of the disks in the pool",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_size": schema.Int64Attribute{
+				MarkdownDescription: "Total capacity of the storage pool in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Storage pool UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StoragePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// expandStoragePoolAllocationUnits converts the allocation units to the ONTAP model
+func expandStoragePoolAllocationUnits(units []StoragePoolAllocationUnitsResourceModel) []interfaces.StoragePoolAllocationUnits {
+	dataONTAP := make([]interfaces.StoragePoolAllocationUnits, len(units))
+	for index, unit := range units {
+		dataONTAP[index] = interfaces.StoragePoolAllocationUnits{
+			Node:  interfaces.NameDataModel{Name: unit.NodeName.ValueString()},
+			Count: unit.Count.ValueInt64(),
+		}
+	}
+	return dataONTAP
+}
+
+// setStoragePoolResourceModel copies the ONTAP record into the model
+// The allocation units are only reported when they are managed by the configuration, keeping the configured node order.
+func setStoragePoolResourceModel(data *StoragePoolResourceModel, restInfo *interfaces.StoragePoolGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.UUID)
+	data.Name = types.StringValue(restInfo.Name)
+	data.DiskCount = types.Int64Value(restInfo.Capacity.DiskCount)
+	data.StorageType = types.StringValue(restInfo.StorageType)
+	data.TotalSize = types.Int64Value(restInfo.Capacity.Total)
+	nodeNames := make([]string, len(restInfo.Nodes))
+	for index, node := range restInfo.Nodes {
+		nodeNames[index] = node.Name
+	}
+	data.NodeNames = flattenUnorderedStringList(data.NodeNames, nodeNames)
+	if data.SpareAllocationUnits == nil {
+		return
+	}
+	counts := make(map[string]int64, len(restInfo.Capacity.SpareAllocationUnits))
+	for _, unit := range restInfo.Capacity.SpareAllocationUnits {
+		counts[unit.Node.Name] = unit.Count
+	}
+	for index, unit := range data.SpareAllocationUnits {
+		data.SpareAllocationUnits[index].Count = types.Int64Value(counts[unit.NodeName.ValueString()])
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StoragePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StoragePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetStoragePoolByName(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetStoragePoolByName
+		return
+	}
+	setStoragePoolResourceModel(&data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the storage pool, then assigns the allocation units if they are set
+func (r *StoragePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StoragePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.StoragePoolResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	for _, nodeName := range data.NodeNames {
+		body.Nodes = append(body.Nodes, map[string]string{"name": nodeName.ValueString()})
+	}
+	body.Capacity = map[string]interface{}{"disk_count": data.DiskCount.ValueInt64()}
+	if err = interfaces.CreateStoragePool(errorHandler, *client, body); err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetStoragePoolByName(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetStoragePoolByName
+		return
+	}
+	if data.SpareAllocationUnits != nil {
+		body = interfaces.StoragePoolResourceBodyDataModelONTAP{
+			Capacity: interfaces.StoragePoolSpareAllocationUnits(expandStoragePoolAllocationUnits(data.SpareAllocationUnits)),
+		}
+		if err = interfaces.UpdateStoragePool(errorHandler, *client, body, restInfo.UUID); err != nil {
+			return
+		}
+		restInfo, err = interfaces.GetStoragePoolByName(errorHandler, *client, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+	}
+	setStoragePoolResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update adds disks to the storage pool and reassigns the allocation units
+func (r *StoragePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *StoragePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.DiskCount.ValueInt64() < state.DiskCount.ValueInt64() {
+		errorHandler.MakeAndReportError("error updating storage pool",
+			fmt.Sprintf("disk_count cannot be reduced from %d to %d, disks cannot be removed from a storage pool", state.DiskCount.ValueInt64(), data.DiskCount.ValueInt64()))
+		return
+	}
+	if data.DiskCount.ValueInt64() > state.DiskCount.ValueInt64() {
+		body := interfaces.StoragePoolResourceBodyDataModelONTAP{
+			Capacity: map[string]interface{}{"disk_count": data.DiskCount.ValueInt64()},
+		}
+		if err = interfaces.UpdateStoragePool(errorHandler, *client, body, state.ID.ValueString()); err != nil {
+			return
+		}
+	}
+	if data.SpareAllocationUnits != nil {
+		body := interfaces.StoragePoolResourceBodyDataModelONTAP{
+			Capacity: interfaces.StoragePoolSpareAllocationUnits(expandStoragePoolAllocationUnits(data.SpareAllocationUnits)),
+		}
+		if err = interfaces.UpdateStoragePool(errorHandler, *client, body, state.ID.ValueString()); err != nil {
+			return
+		}
+	}
+
+	restInfo, err := interfaces.GetStoragePoolByName(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetStoragePoolByName
+		return
+	}
+	setStoragePoolResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the storage pool, the SSDs are returned to the spares
+func (r *StoragePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StoragePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "storage pool UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteStoragePool(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *StoragePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a storage pool resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
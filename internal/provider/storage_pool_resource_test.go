@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStoragePoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStoragePoolResourceConfig("non_existant_node", 2),
+				ExpectError: regexp.MustCompile("error creating storage pool"),
+			},
+			// Create and read testing
+			{
+				Config: testAccStoragePoolResourceConfig("swenjun-vsim1", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_pool_resource.example", "name", "acc_test_sp"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_pool_resource.example", "disk_count", "2"),
+				),
+			},
+			// Update testing, disks can only be added
+			{
+				Config: testAccStoragePoolResourceConfig("swenjun-vsim1", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_pool_resource.example", "disk_count", "3"),
+				),
+			},
+			{
+				Config:      testAccStoragePoolResourceConfig("swenjun-vsim1", 2),
+				ExpectError: regexp.MustCompile("disk_count cannot be reduced"),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_pool_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "acc_test_sp", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_pool_resource.example", "name", "acc_test_sp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStoragePoolResourceConfig(nodeName string, diskCount int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_pool_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "acc_test_sp"
+  node_names = ["%s"]
+  disk_count = %d
+}`, host, admin, password, nodeName, diskCount)
+}
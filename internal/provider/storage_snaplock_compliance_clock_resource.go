@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageSnaplockComplianceClockResource{}
+var _ resource.ResourceWithImportState = &StorageSnaplockComplianceClockResource{}
+
+// NewStorageSnaplockComplianceClockResource is a helper function to simplify the provider implementation.
+func NewStorageSnaplockComplianceClockResource() resource.Resource {
+	return &StorageSnaplockComplianceClockResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_snaplock_compliance_clock_resource",
+		},
+	}
+}
+
+// StorageSnaplockComplianceClockResource defines the resource implementation.
+type StorageSnaplockComplianceClockResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageSnaplockComplianceClockResourceModel describes the resource data model.
+type StorageSnaplockComplianceClockResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Time          types.String `tfsdk:"time"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageSnaplockComplianceClockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageSnaplockComplianceClockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SnapLock compliance clock resource, to initialize the compliance clock of a node. The clock can not be changed once initialized, it is left unchanged on delete",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Time of the compliance clock when last read, in ISO 8601 format",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageSnaplockComplianceClockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StorageSnaplockComplianceClockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageSnaplockComplianceClockResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSnaplockComplianceClock(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSnaplockComplianceClock
+		return
+	}
+	if restInfo == nil {
+		// eg the node was replaced, the clock needs to be initialized again
+		tflog.Debug(ctx, fmt.Sprintf("compliance clock for node %s is not initialized", data.NodeName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(restInfo.Node.Name)
+	data.Time = types.StringValue(restInfo.Time)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create initializes the compliance clock, a clock that is already initialized is left unchanged
+func (r *StorageSnaplockComplianceClockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageSnaplockComplianceClockResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	clock, err := interfaces.GetSnaplockComplianceClock(errorHandler, *client, data.NodeName.ValueString())
+	if err != nil {
+		return
+	}
+	if clock == nil {
+		if err = interfaces.InitializeSnaplockComplianceClock(errorHandler, *client, data.NodeName.ValueString()); err != nil {
+			return
+		}
+		clock, err = interfaces.GetSnaplockComplianceClock(errorHandler, *client, data.NodeName.ValueString())
+		if err != nil {
+			return
+		}
+		if clock == nil {
+			errorHandler.MakeAndReportError("error initializing snaplock compliance clock", fmt.Sprintf("compliance clock for node %s is not initialized", data.NodeName.ValueString()))
+			return
+		}
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("compliance clock for node %s is already initialized", data.NodeName.ValueString()))
+	}
+
+	data.ID = types.StringValue(clock.Node.Name)
+	data.Time = types.StringValue(clock.Time)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not supported, all the attributes require a replace.
+func (r *StorageSnaplockComplianceClockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StorageSnaplockComplianceClockResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only cx_profile_name can change
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state, the compliance clock can not be reset.
+func (r *StorageSnaplockComplianceClockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageSnaplockComplianceClockResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("compliance clock for node %s removed from state, the clock is left unchanged", data.NodeName.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *StorageSnaplockComplianceClockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a snaplock compliance clock resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: node_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
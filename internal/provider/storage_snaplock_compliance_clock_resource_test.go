@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageSnaplockComplianceClockResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing, the clock is left unchanged if it is already initialized
+			{
+				Config: testAccStorageSnaplockComplianceClockResourceConfig("swenjun-vsim1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_compliance_clock_resource.example", "node_name", "swenjun-vsim1"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_storage_snaplock_compliance_clock_resource.example", "time"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_snaplock_compliance_clock_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "swenjun-vsim1", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_compliance_clock_resource.example", "node_name", "swenjun-vsim1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageSnaplockComplianceClockResourceConfig(nodeName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_snaplock_compliance_clock_resource" "example" {
+  cx_profile_name = "cluster4"
+  node_name = "%s"
+}`, host, admin, password, nodeName)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageSnaplockFileRetentionResource{}
+var _ resource.ResourceWithImportState = &StorageSnaplockFileRetentionResource{}
+
+// NewStorageSnaplockFileRetentionResource is a helper function to simplify the provider implementation.
+func NewStorageSnaplockFileRetentionResource() resource.Resource {
+	return &StorageSnaplockFileRetentionResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_snaplock_file_retention_resource",
+		},
+	}
+}
+
+// StorageSnaplockFileRetentionResource defines the resource implementation.
+type StorageSnaplockFileRetentionResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageSnaplockFileRetentionResourceModel describes the resource data model.
+type StorageSnaplockFileRetentionResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	Path          types.String `tfsdk:"path"`
+	RetentionTime types.String `tfsdk:"retention_time"`
+	ExpiryTime    types.String `tfsdk:"expiry_time"`
+	IsExpired     types.Bool   `tfsdk:"is_expired"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageSnaplockFileRetentionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageSnaplockFileRetentionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SnapLock file retention resource, to commit a file of a SnapLock volume to WORM and set its retention time",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SnapLock volume containing the file",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file relative to the volume root, eg dir1/file1",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]`), "must be relative to the volume root, without a leading /"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_time": schema.StringAttribute{
+				MarkdownDescription: "Retention time of the file, in RFC 3339 format, eg 2030-01-01T00:00:00Z, or infinite. The retention time can only be extended",
+				Required:            true,
+			},
+			"expiry_time": schema.StringAttribute{
+				MarkdownDescription: "Expiry time of the file, in ISO 8601 format, it can be later than the retention time when a legal hold or event retention applies",
+				Computed:            true,
+			},
+			"is_expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the retention time of the file has expired",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SnapLock file retention identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageSnaplockFileRetentionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// getVolumeUUID returns the UUID of the volume containing the file
+func (r *StorageSnaplockFileRetentionResource) getVolumeUUID(errorHandler *utils.ErrorHandler, client *restclient.RestClient, data *StorageSnaplockFileRetentionResourceModel) (string, error) {
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.VolumeName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return volume.UUID, nil
+}
+
+// sameRetentionTime returns true if both values are the same instant, ONTAP reports the time with the cluster time zone
+func sameRetentionTime(a string, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return timeA.Equal(timeB)
+}
+
+// setRetention sets the computed attributes, and keeps the configured retention time if ONTAP reports the same instant
+func (data *StorageSnaplockFileRetentionResourceModel) setRetention(restInfo *interfaces.SnaplockFileGetDataModelONTAP) {
+	if data.RetentionTime.IsNull() || !sameRetentionTime(data.RetentionTime.ValueString(), restInfo.RetentionTime) {
+		data.RetentionTime = types.StringValue(restInfo.RetentionTime)
+	}
+	data.ExpiryTime = types.StringValue(restInfo.ExpiryTime)
+	data.IsExpired = types.BoolValue(restInfo.IsExpired)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s", data.SVMName.ValueString(), data.VolumeName.ValueString(), data.Path.ValueString()))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StorageSnaplockFileRetentionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageSnaplockFileRetentionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	volumeUUID, err := r.getVolumeUUID(errorHandler, client, &data)
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetSnaplockFile(errorHandler, *client, volumeUUID, data.Path.ValueString())
+	if err != nil {
+		// error reporting done inside GetSnaplockFile
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("file %s not found, removing it from state", data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.setRetention(restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applyRetention sets the retention time of the file, and reads it back
+func (r *StorageSnaplockFileRetentionResource) applyRetention(errorHandler *utils.ErrorHandler, client *restclient.RestClient, data *StorageSnaplockFileRetentionResourceModel) error {
+	volumeUUID, err := r.getVolumeUUID(errorHandler, client, data)
+	if err != nil {
+		return err
+	}
+	body := interfaces.SnaplockFileResourceBodyDataModelONTAP{RetentionTime: data.RetentionTime.ValueString()}
+	if err = interfaces.UpdateSnaplockFile(errorHandler, *client, volumeUUID, data.Path.ValueString(), body); err != nil {
+		return err
+	}
+	restInfo, err := interfaces.GetSnaplockFile(errorHandler, *client, volumeUUID, data.Path.ValueString())
+	if err != nil {
+		return err
+	}
+	if restInfo == nil {
+		return errorHandler.MakeAndReportError("error setting snaplock file retention", fmt.Sprintf("file %s not found in volume %s", data.Path.ValueString(), data.VolumeName.ValueString()))
+	}
+	data.setRetention(restInfo)
+	return nil
+}
+
+// Create commits the file to WORM with the retention time
+func (r *StorageSnaplockFileRetentionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageSnaplockFileRetentionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = r.applyRetention(errorHandler, client, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update extends the retention time of the file.
+func (r *StorageSnaplockFileRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *StorageSnaplockFileRetentionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.RetentionTime.Equal(state.RetentionTime) {
+		data.ExpiryTime = state.ExpiryTime
+		data.IsExpired = state.IsExpired
+	} else if err = r.applyRetention(errorHandler, client, data); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from the Terraform state, as the retention time of a WORM file can not be reduced.
+func (r *StorageSnaplockFileRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageSnaplockFileRetentionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("file %s is left unchanged, retention time %s", data.Path.ValueString(), data.RetentionTime.ValueString()))
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *StorageSnaplockFileRetentionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a snaplock file retention resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: path,volume_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
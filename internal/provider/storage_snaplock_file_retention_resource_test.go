@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageSnaplockFileRetentionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccStorageSnaplockFileRetentionResourceConfig("2040-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_file_retention_resource.example", "retention_time", "2040-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_file_retention_resource.example", "is_expired", "false"),
+				),
+			},
+			// Update testing, the retention time can only be extended
+			{
+				Config: testAccStorageSnaplockFileRetentionResourceConfig("2041-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_file_retention_resource.example", "retention_time", "2041-01-01T00:00:00Z"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_snaplock_file_retention_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s", "records/acc_test.txt", "snaplock_vol", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_file_retention_resource.example", "path", "records/acc_test.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageSnaplockFileRetentionResourceConfig(retentionTime string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_snaplock_file_retention_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "snaplock_vol"
+  path = "records/acc_test.txt"
+  retention_time = "%s"
+}`, host, admin, password, retentionTime)
+}
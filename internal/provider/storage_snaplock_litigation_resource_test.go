@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageSnaplockLitigationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccStorageSnaplockLitigationResourceConfig("acc_test_litigation", "/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_litigation_resource.example", "name", "acc_test_litigation"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_litigation_resource.example", "path", "/"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_storage_snaplock_litigation_resource.example", "id"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_snaplock_litigation_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s,%s", "acc_test_litigation", "snaplock_vol", "carchi-test", "/", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_snaplock_litigation_resource.example", "name", "acc_test_litigation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageSnaplockLitigationResourceConfig(name string, path string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_snaplock_litigation_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "%s"
+  svm_name = "carchi-test"
+  volume_name = "snaplock_vol"
+  path = "%s"
+}`, host, admin, password, name, path)
+}
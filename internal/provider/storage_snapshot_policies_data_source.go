@@ -50,7 +50,7 @@ func (d *SnapshotPoliciesDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -126,6 +126,10 @@ func (d *SnapshotPoliciesDataSource) Schema(ctx context.Context, req datasource.
 							MarkdownDescription: "Is the Snapshot copy policy enabled?",
 							Computed:            true,
 						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope of the policy, cluster or svm",
+							Computed:            true,
+						},
 					},
 				},
 				Computed:            true,
@@ -210,6 +214,7 @@ func (d *SnapshotPoliciesDataSource) Read(ctx context.Context, req datasource.Re
 			Copies:        copies,
 			Comment:       types.StringValue(record.Comment),
 			Enabled:       types.BoolValue(record.Enabled),
+			Scope:         types.StringValue(record.Scope),
 		}
 	}
 
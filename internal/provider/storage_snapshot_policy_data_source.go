@@ -38,6 +38,7 @@ type SnapshotPolicyDataSourceModel struct {
 	Copies        []CopyResourceModel `tfsdk:"copies"`
 	Comment       types.String        `tfsdk:"comment"`
 	Enabled       types.Bool          `tfsdk:"enabled"`
+	Scope         types.String        `tfsdk:"scope"`
 }
 
 // SnapshotPolicyDataSourceFilterModel describes the data source data model for queries.
@@ -60,14 +61,15 @@ func (d *SnapshotPolicyDataSource) Schema(ctx context.Context, req datasource.Sc
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "SnapshotPolicy name",
 				Required:            true,
 			},
 			"svm_name": schema.StringAttribute{
-				MarkdownDescription: "IPInterface svm name",
+				MarkdownDescription: "SnapshotPolicy svm name, required when several svms have a policy with this name",
+				Optional:            true,
 				Computed:            true,
 			},
 			"id": schema.StringAttribute{
@@ -116,6 +118,10 @@ func (d *SnapshotPolicyDataSource) Schema(ctx context.Context, req datasource.Sc
 				MarkdownDescription: "Is the Snapshot copy policy enabled?",
 				Computed:            true,
 			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the policy, cluster or svm",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -155,7 +161,7 @@ func (d *SnapshotPolicyDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	restInfo, err := interfaces.GetSnapshotPolicyByName(errorHandler, *client, data.Name.ValueString())
+	restInfo, err := interfaces.GetSnapshotPolicyByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
 	if err != nil {
 		// error reporting done inside GetSnapshotPolicy
 		return
@@ -181,6 +187,7 @@ func (d *SnapshotPolicyDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	data.Comment = types.StringValue(restInfo.Comment)
 	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Scope = types.StringValue(restInfo.Scope)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
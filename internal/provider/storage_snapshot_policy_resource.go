@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -62,13 +63,14 @@ type CopyResourceModel struct {
 
 // SnapshotPolicyResourceModel describes the resource data model.
 type SnapshotPolicyResourceModel struct {
-	CxProfileName types.String        `tfsdk:"cx_profile_name"`
-	Name          types.String        `tfsdk:"name"`
-	SVMName       types.String        `tfsdk:"svm_name"` // if needed or relevant
-	ID            types.String        `tfsdk:"id"`
-	Copies        []CopyResourceModel `tfsdk:"copies"`
-	Comment       types.String        `tfsdk:"comment"`
-	Enabled       types.Bool          `tfsdk:"enabled"`
+	CxProfileName   types.String        `tfsdk:"cx_profile_name"`
+	Name            types.String        `tfsdk:"name"`
+	SVMName         types.String        `tfsdk:"svm_name"` // if needed or relevant
+	RetainOnDestroy types.Bool          `tfsdk:"retain_on_destroy"`
+	ID              types.String        `tfsdk:"id"`
+	Copies          []CopyResourceModel `tfsdk:"copies"`
+	Comment         types.String        `tfsdk:"comment"`
+	Enabled         types.Bool          `tfsdk:"enabled"`
 }
 
 // Metadata returns the resource type name.
@@ -85,7 +87,7 @@ func (r *SnapshotPolicyResource) Schema(ctx context.Context, req resource.Schema
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "SnapshotPolicy name",
@@ -149,6 +151,10 @@ func (r *SnapshotPolicyResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "SnapshotPolicy svm name",
 				Optional:            true,
 			},
+			"retain_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When true, destroying the resource only removes it from the Terraform state, and the snapshot policy is kept on the cluster. Defaults to false",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "SnapshotPolicy ID",
 				Computed:            true,
@@ -195,7 +201,13 @@ func (r *SnapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	restInfo, err := interfaces.GetSnapshotPolicy(errorHandler, *client, data.ID.ValueString())
+	var restInfo *interfaces.SnapshotPolicyGetDataModelONTAP
+	if data.ID.IsNull() {
+		// on import, only the name and svm are known
+		restInfo, err = interfaces.GetSnapshotPolicyByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	} else {
+		restInfo, err = interfaces.GetSnapshotPolicy(errorHandler, *client, data.ID.ValueString())
+	}
 	if err != nil {
 		// error reporting done inside GetSnapshotPolicy
 		return
@@ -333,6 +345,11 @@ func (r *SnapshotPolicyResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if data.RetainOnDestroy.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("retain_on_destroy is set, snapshot policy %s (%s) removed from state only", data.Name.ValueString(), data.ID.ValueString()))
+		return
+	}
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -354,5 +371,20 @@ func (r *SnapshotPolicyResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *SnapshotPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ",")
+
+	// svm_name is omitted for cluster scoped policies
+	if (len(idParts) != 2 && len(idParts) != 3) || idParts[0] == "" || idParts[len(idParts)-1] == "" || (len(idParts) == 3 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name or name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	if len(idParts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[len(idParts)-1])...)
 }
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageVolumeComplianceGapsDataSource{}
+
+// NewStorageVolumeComplianceGapsDataSource is a helper function to simplify the provider implementation.
+func NewStorageVolumeComplianceGapsDataSource() datasource.DataSource {
+	return &StorageVolumeComplianceGapsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_volume_compliance_gaps_data_source",
+		},
+	}
+}
+
+// StorageVolumeComplianceGapsDataSource defines the data source implementation.
+type StorageVolumeComplianceGapsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageVolumeComplianceGapsDataSourceModel describes the data source data model.
+type StorageVolumeComplianceGapsDataSourceModel struct {
+	CxProfileName       types.String   `tfsdk:"cx_profile_name"`
+	SVMName             types.String   `tfsdk:"svm_name"`
+	VscanEnabled        types.Bool     `tfsdk:"vscan_enabled"`
+	AuditEnabled        types.Bool     `tfsdk:"audit_enabled"`
+	Volumes             []types.String `tfsdk:"volumes"`
+	VolumesWithoutVscan []types.String `tfsdk:"volumes_without_vscan"`
+	VolumesWithoutAudit []types.String `tfsdk:"volumes_without_audit"`
+	VolumesWithoutARP   []types.String `tfsdk:"volumes_without_arp"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageVolumeComplianceGapsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageVolumeComplianceGapsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Volumes of a SVM not covered by vscan, NAS auditing, or autonomous ransomware protection",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"vscan_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether vscan is configured and enabled on the SVM",
+				Computed:            true,
+			},
+			"audit_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether NAS auditing is configured and enabled on the SVM",
+				Computed:            true,
+			},
+			"volumes": schema.ListAttribute{
+				MarkdownDescription: "Names of the read-write volumes checked, the SVM root volume is excluded",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"volumes_without_vscan": schema.ListAttribute{
+				MarkdownDescription: "Names of the mounted volumes not scanned by an enabled on-access policy",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"volumes_without_audit": schema.ListAttribute{
+				MarkdownDescription: "Names of the volumes not audited, all the volumes when NAS auditing is disabled",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"volumes_without_arp": schema.ListAttribute{
+				MarkdownDescription: "Names of the volumes where autonomous ransomware protection is not enabled, including dry_run",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageVolumeComplianceGapsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageVolumeComplianceGapsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageVolumeComplianceGapsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svmName := data.SVMName.ValueString()
+	volumes, err := interfaces.GetListVolumeCompliance(errorHandler, *client, svmName)
+	if err != nil {
+		// error reporting done inside GetListVolumeCompliance
+		return
+	}
+	vscan, err := interfaces.GetVscanCompliance(errorHandler, *client, svmName)
+	if err != nil {
+		return
+	}
+	audit, err := interfaces.GetAuditCompliance(errorHandler, *client, svmName)
+	if err != nil {
+		return
+	}
+
+	data.VscanEnabled = types.BoolValue(vscan != nil && vscan.Enabled)
+	data.AuditEnabled = types.BoolValue(audit != nil && audit.Enabled)
+	// empty lists rather than null, so that length() can be used in check blocks
+	data.Volumes = []types.String{}
+	data.VolumesWithoutVscan = []types.String{}
+	data.VolumesWithoutAudit = []types.String{}
+	data.VolumesWithoutARP = []types.String{}
+	for _, volume := range volumes {
+		name := types.StringValue(volume.Name)
+		data.Volumes = append(data.Volumes, name)
+		if !interfaces.IsVolumeCoveredByVscan(vscan, volume) {
+			data.VolumesWithoutVscan = append(data.VolumesWithoutVscan, name)
+		}
+		if !data.AuditEnabled.ValueBool() {
+			data.VolumesWithoutAudit = append(data.VolumesWithoutAudit, name)
+		}
+		if volume.AntiRansomware.State != "enabled" {
+			data.VolumesWithoutARP = append(data.VolumesWithoutARP, name)
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
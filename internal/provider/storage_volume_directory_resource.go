@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageVolumeDirectoryResource{}
+var _ resource.ResourceWithImportState = &StorageVolumeDirectoryResource{}
+
+// NewStorageVolumeDirectoryResource is a helper function to simplify the provider implementation.
+func NewStorageVolumeDirectoryResource() resource.Resource {
+	return &StorageVolumeDirectoryResource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_volume_directory_resource",
+		},
+	}
+}
+
+// StorageVolumeDirectoryResource defines the resource implementation.
+type StorageVolumeDirectoryResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageVolumeDirectoryResourceModel describes the resource data model.
+type StorageVolumeDirectoryResourceModel struct {
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	SVMName         types.String `tfsdk:"svm_name"`
+	VolumeName      types.String `tfsdk:"volume_name"`
+	Path            types.String `tfsdk:"path"`
+	UnixPermissions types.Int64  `tfsdk:"unix_permissions"`
+	OwnerID         types.Int64  `tfsdk:"owner_id"`
+	GroupID         types.Int64  `tfsdk:"group_id"`
+	RecursiveDelete types.Bool   `tfsdk:"recursive_delete"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageVolumeDirectoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageVolumeDirectoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage volume directory resource, to create a directory in a volume and set its UNIX permissions and ownership",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the volume containing the directory",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the directory relative to the volume root, eg dir1/dir2. The parent directory must exist",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]`), "must be relative to the volume root, without a leading /"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unix_permissions": schema.Int64Attribute{
+				MarkdownDescription: "UNIX permission bits written as an octal number, eg 755 for rwxr-xr-x",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.Int64Attribute{
+				MarkdownDescription: "UNIX user ID of the owner of the directory",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "UNIX group ID of the directory",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"recursive_delete": schema.BoolAttribute{
+				MarkdownDescription: "Delete the content of the directory when the resource is destroyed. When false, deleting a directory that is not empty fails",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Storage volume directory identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageVolumeDirectoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// getVolumeUUID returns the UUID of the volume containing the directory
+func (r *StorageVolumeDirectoryResource) getVolumeUUID(errorHandler *utils.ErrorHandler, client *restclient.RestClient, data *StorageVolumeDirectoryResourceModel) (string, error) {
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.VolumeName.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return volume.UUID, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StorageVolumeDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageVolumeDirectoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	volumeUUID, err := r.getVolumeUUID(errorHandler, client, &data)
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString())
+	if err != nil {
+		// error reporting done inside GetStorageVolumeFile
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("directory %s not found, removing it from state", data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if restInfo.Type != "directory" {
+		errorHandler.MakeAndReportError("error reading volume directory", fmt.Sprintf("%s in volume %s is a %s, not a directory", data.Path.ValueString(), data.VolumeName.ValueString(), restInfo.Type))
+		return
+	}
+
+	data.UnixPermissions = types.Int64Value(restInfo.UnixPermissions)
+	data.OwnerID = types.Int64Value(restInfo.OwnerID)
+	data.GroupID = types.Int64Value(restInfo.GroupID)
+	if data.RecursiveDelete.IsNull() {
+		// on import
+		data.RecursiveDelete = types.BoolValue(false)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s", data.SVMName.ValueString(), data.VolumeName.ValueString(), data.Path.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the directory, then sets its ownership
+func (r *StorageVolumeDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageVolumeDirectoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	volumeUUID, err := r.getVolumeUUID(errorHandler, client, data)
+	if err != nil {
+		return
+	}
+	body := interfaces.StorageVolumeFileResourceBodyDataModelONTAP{Type: "directory"}
+	if !data.UnixPermissions.IsUnknown() {
+		permissions := data.UnixPermissions.ValueInt64()
+		body.UnixPermissions = &permissions
+	}
+	if err = interfaces.CreateStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString(), body); err != nil {
+		return
+	}
+
+	// the owner and group can not be set on create
+	var ownership interfaces.StorageVolumeFileResourceBodyDataModelONTAP
+	if !data.OwnerID.IsUnknown() {
+		ownerID := data.OwnerID.ValueInt64()
+		ownership.OwnerID = &ownerID
+	}
+	if !data.GroupID.IsUnknown() {
+		groupID := data.GroupID.ValueInt64()
+		ownership.GroupID = &groupID
+	}
+	if err = interfaces.UpdateStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString(), ownership); err != nil {
+		return
+	}
+
+	// read the directory to know the defaults applied by ONTAP
+	restInfo, err := interfaces.GetStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error creating volume directory", fmt.Sprintf("directory %s not found after create", data.Path.ValueString()))
+		return
+	}
+	data.UnixPermissions = types.Int64Value(restInfo.UnixPermissions)
+	data.OwnerID = types.Int64Value(restInfo.OwnerID)
+	data.GroupID = types.Int64Value(restInfo.GroupID)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s", data.SVMName.ValueString(), data.VolumeName.ValueString(), data.Path.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the UNIX permissions and ownership of the directory.
+func (r *StorageVolumeDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *StorageVolumeDirectoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.StorageVolumeFileResourceBodyDataModelONTAP
+	if !data.UnixPermissions.Equal(state.UnixPermissions) {
+		permissions := data.UnixPermissions.ValueInt64()
+		body.UnixPermissions = &permissions
+	}
+	if !data.OwnerID.Equal(state.OwnerID) {
+		ownerID := data.OwnerID.ValueInt64()
+		body.OwnerID = &ownerID
+	}
+	if !data.GroupID.Equal(state.GroupID) {
+		groupID := data.GroupID.ValueInt64()
+		body.GroupID = &groupID
+	}
+	if body.UnixPermissions != nil || body.OwnerID != nil || body.GroupID != nil {
+		volumeUUID, err := r.getVolumeUUID(errorHandler, client, data)
+		if err != nil {
+			return
+		}
+		if err = interfaces.UpdateStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString(), body); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *StorageVolumeDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageVolumeDirectoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	volumeUUID, err := r.getVolumeUUID(errorHandler, client, data)
+	if err != nil {
+		return
+	}
+	err = interfaces.DeleteStorageVolumeFile(errorHandler, *client, volumeUUID, data.Path.ValueString(), data.RecursiveDelete.ValueBool())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *StorageVolumeDirectoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a storage volume directory resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: path,volume_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageVolumeDirectoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccStorageVolumeDirectoryResourceConfig(755),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_directory_resource.example", "path", "acc_test_dir"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_directory_resource.example", "unix_permissions", "755"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccStorageVolumeDirectoryResourceConfig(750),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_directory_resource.example", "unix_permissions", "750"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_storage_volume_directory_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s", "acc_test_dir", "carchi_test_root", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_directory_resource.example", "path", "acc_test_dir"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageVolumeDirectoryResourceConfig(permissions int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_storage_volume_directory_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "carchi_test_root"
+  path = "acc_test_dir"
+  unix_permissions = %d
+}`, host, admin, password, permissions)
+}
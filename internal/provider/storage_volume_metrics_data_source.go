@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageVolumeMetricsDataSource{}
+
+// NewStorageVolumeMetricsDataSource is a helper function to simplify the provider implementation.
+func NewStorageVolumeMetricsDataSource() datasource.DataSource {
+	return &StorageVolumeMetricsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_volume_metrics_data_source",
+		},
+	}
+}
+
+// StorageVolumeMetricsDataSource defines the data source implementation.
+type StorageVolumeMetricsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageVolumeMetricsDataSourceModel describes the data source data model.
+type StorageVolumeMetricsDataSourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	Name          types.String                       `tfsdk:"name"`
+	SVMName       types.String                       `tfsdk:"svm_name"`
+	Interval      types.String                       `tfsdk:"interval"`
+	Metrics       []PerformanceMetricDataSourceModel `tfsdk:"metrics"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageVolumeMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageVolumeMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Volume metrics data source, to read the latency, IOPS, and throughput of a volume",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Volume name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"interval": metricsIntervalAttribute(),
+			"metrics":  metricsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageVolumeMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageVolumeMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageVolumeMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetUUIDVolumeByName
+		return
+	}
+
+	restInfo, err := interfaces.GetStorageVolumeMetrics(errorHandler, *client, volume.UUID, metricsInterval(data.Interval))
+	if err != nil {
+		// error reporting done inside GetStorageVolumeMetrics
+		return
+	}
+	data.Metrics = flattenPerformanceMetrics(restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %s/%s, %d samples", data.SVMName.ValueString(), data.Name.ValueString(), len(data.Metrics)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
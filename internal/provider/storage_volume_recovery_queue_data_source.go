@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageVolumeRecoveryQueueDataSource{}
+
+// NewStorageVolumeRecoveryQueueDataSource is a helper function to simplify the provider implementation.
+func NewStorageVolumeRecoveryQueueDataSource() datasource.DataSource {
+	return &StorageVolumeRecoveryQueueDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_volume_recovery_queue_data_source",
+		},
+	}
+}
+
+// StorageVolumeRecoveryQueueDataSource defines the data source implementation.
+type StorageVolumeRecoveryQueueDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageVolumeRecoveryQueueDataSourceModel describes the data source data model.
+type StorageVolumeRecoveryQueueDataSourceModel struct {
+	CxProfileName types.String                                      `tfsdk:"cx_profile_name"`
+	SVMName       types.String                                      `tfsdk:"svm_name"`
+	Volumes       []StorageVolumeRecoveryQueueVolumeDataSourceModel `tfsdk:"volumes"`
+}
+
+// StorageVolumeRecoveryQueueVolumeDataSourceModel describes a deleted volume.
+type StorageVolumeRecoveryQueueVolumeDataSourceModel struct {
+	SVMName             types.String `tfsdk:"svm_name"`
+	Name                types.String `tfsdk:"name"`
+	DeletionRequestTime types.String `tfsdk:"deletion_request_time"`
+	RetentionHours      types.Int64  `tfsdk:"retention_hours"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageVolumeRecoveryQueueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageVolumeRecoveryQueueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Volume recovery queue data source, to list the deleted volumes that can still be recovered",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name, to only list the deleted volumes of this SVM",
+				Optional:            true,
+			},
+			"volumes": schema.ListNestedAttribute{
+				MarkdownDescription: "Deleted volumes in the recovery queue",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "SVM name",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the deleted volume, the original name suffixed with the volume DSID, eg vol1_1026",
+							Computed:            true,
+						},
+						"deletion_request_time": schema.StringAttribute{
+							MarkdownDescription: "Time the volume was deleted",
+							Computed:            true,
+						},
+						"retention_hours": schema.Int64Attribute{
+							MarkdownDescription: "Number of hours the volume is kept in the recovery queue after it was deleted",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageVolumeRecoveryQueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageVolumeRecoveryQueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageVolumeRecoveryQueueDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetStorageVolumeRecoveryQueue(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetStorageVolumeRecoveryQueue
+		return
+	}
+
+	data.Volumes = make([]StorageVolumeRecoveryQueueVolumeDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.Volumes[index] = StorageVolumeRecoveryQueueVolumeDataSourceModel{
+			SVMName:             types.StringValue(record.Vserver),
+			Name:                types.StringValue(record.Volume),
+			DeletionRequestTime: types.StringValue(record.DeletionRequestTime),
+			RetentionHours:      types.Int64Value(record.RetentionHours),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -7,12 +7,15 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -51,6 +54,7 @@ type StorageVolumeResourceModel struct {
 	SpaceGuarantee types.String                      `tfsdk:"space_guarantee"`
 	Encrypt        types.Bool                        `tfsdk:"encryption"`
 	SnapshotPolicy types.String                      `tfsdk:"snapshot_policy"`
+	SnapdirAccess  types.Bool                        `tfsdk:"snapdir_access"`
 	Language       types.String                      `tfsdk:"language"`
 	QOSPolicyGroup types.String                      `tfsdk:"qos_policy_group"`
 	Comment        types.String                      `tfsdk:"comment"`
@@ -62,6 +66,12 @@ type StorageVolumeResourceModel struct {
 	Efficiency     types.Object                      `tfsdk:"efficiency"`
 	SnapLock       types.Object                      `tfsdk:"snaplock"`
 	Analytics      types.Object                      `tfsdk:"analytics"`
+	// UnmountOnDelete defaults to true when null
+	UnmountOnDelete types.Bool `tfsdk:"unmount_on_delete"`
+	// PurgeOnDelete defaults to false when null
+	PurgeOnDelete               types.Bool  `tfsdk:"purge_on_delete"`
+	RecoveryQueueRetentionHours types.Int64 `tfsdk:"recovery_queue_retention_hours"`
+	SplitClone                  types.Bool  `tfsdk:"split_clone"`
 }
 
 // StorageVolumeResourceAggregates describes the analytics model.
@@ -76,7 +86,16 @@ type StorageVolumeResourceAnalytics struct {
 
 // StorageVolumeResourceSnapLock describes the snaplock model.
 type StorageVolumeResourceSnapLock struct {
-	SnaplockType types.String `tfsdk:"type"`
+	SnaplockType     types.String `tfsdk:"type"`
+	Retention        types.Object `tfsdk:"retention"`
+	AutocommitPeriod types.String `tfsdk:"autocommit_period"`
+}
+
+// StorageVolumeResourceSnapLockRetention describes the snaplock retention model.
+type StorageVolumeResourceSnapLockRetention struct {
+	Default types.String `tfsdk:"default"`
+	Minimum types.String `tfsdk:"minimum"`
+	Maximum types.String `tfsdk:"maximum"`
 }
 
 // StorageVolumeResourceEfficiency describes the efficiency model.
@@ -129,10 +148,10 @@ func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaR
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the volume to manage",
+				MarkdownDescription: "The name of the volume to manage, the volume is renamed in place when it changes",
 				Required:            true,
 			},
 			"svm_name": schema.StringAttribute{
@@ -152,9 +171,31 @@ func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"state": schema.StringAttribute{
-				MarkdownDescription: "Whether the specified volume is online, or not",
+				MarkdownDescription: "Whether the specified volume is online, offline, or restricted. Attributes other than state are not refreshed while the volume is not online",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("online", "offline", "restricted"),
+				},
+			},
+			"unmount_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether the volume is unmounted before it is taken offline and deleted, defaults to true",
+				Optional:            true,
+			},
+			"purge_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether the volume is purged from the recovery queue when it is deleted, so that it can not be recovered and its space is freed immediately, defaults to false",
+				Optional:            true,
+			},
+			"recovery_queue_retention_hours": schema.Int64Attribute{
+				MarkdownDescription: "Number of hours the volume is kept in the recovery queue when it is deleted, the SVM setting applies when not set. Ignored when purge_on_delete is true",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"split_clone": schema.BoolAttribute{
+				MarkdownDescription: "Whether to split a FlexClone volume from its parent volume, the split is started when this is changed to true on update and cannot be undone",
+				Optional:            true,
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The volume type, either read-write (RW) or data-protection (DP)",
@@ -176,6 +217,11 @@ func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 			},
+			"snapdir_access": schema.BoolAttribute{
+				MarkdownDescription: "Whether the .snapshot directory of the volume is visible to clients, requires ONTAP 9.13 or later",
+				Optional:            true,
+				Computed:            true,
+			},
 			"language": schema.StringAttribute{
 				MarkdownDescription: "Language to use for volume",
 				Optional:            true,
@@ -304,6 +350,33 @@ func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaR
 						Optional:            true,
 						Computed:            true,
 					},
+					"retention": schema.SingleNestedAttribute{
+						MarkdownDescription: "Retention periods of the files committed to WORM state, as ISO 8601 durations, eg P30Y, or infinite",
+						Optional:            true,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"default": schema.StringAttribute{
+								MarkdownDescription: "Retention period used when none is set on the file, a duration, infinite, min, max, or unspecified",
+								Optional:            true,
+								Computed:            true,
+							},
+							"minimum": schema.StringAttribute{
+								MarkdownDescription: "Minimum retention period, a duration or infinite",
+								Optional:            true,
+								Computed:            true,
+							},
+							"maximum": schema.StringAttribute{
+								MarkdownDescription: "Maximum retention period, a duration or infinite",
+								Optional:            true,
+								Computed:            true,
+							},
+						},
+					},
+					"autocommit_period": schema.StringAttribute{
+						MarkdownDescription: "Time after which a file that is not modified is committed to WORM state, an ISO 8601 duration, eg PT4H, or none",
+						Optional:            true,
+						Computed:            true,
+					},
 				},
 			},
 			"analytics": schema.SingleNestedAttribute{
@@ -338,15 +411,46 @@ func (r *StorageVolumeResource) ModifyPlan(ctx context.Context, req resource.Mod
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-	if state != nil && !state.State.IsUnknown() && state.State.ValueString() == "offline" {
-		resp.Diagnostics.AddError("Volume is offline", "Provider is not supported to manage offline volume. Please manually switch the volume online")
+	if plan != nil && config != nil && !config.SnapdirAccess.IsNull() {
+		errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+		checkVersionedAttributes(errorHandler, r.config, config.CxProfileName, "storage/volumes", map[string]string{"snapdir_access": "snapshot_directory_access_enabled"})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if state == nil && plan != nil && r.config.providerConfig.ValidateOnly {
+		r.validateCreate(ctx, plan, resp)
+	}
+}
+
+// validateCreate asks ONTAP to validate the create request, when the attributes identifying the volume are known at plan time
+func (r *StorageVolumeResource) validateCreate(ctx context.Context, plan *StorageVolumeResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.CxProfileName.IsUnknown() || plan.Name.IsUnknown() || plan.SVMName.IsUnknown() || plan.Space.IsUnknown() {
+		tflog.Debug(ctx, "skipping validate_only for volume, name, svm_name, or space is not known yet")
 		return
 	}
-	if plan != nil && !plan.State.IsUnknown() && plan.State.ValueString() == "offline" {
+	for _, aggregate := range plan.Aggregates {
+		if aggregate.Name.IsUnknown() {
+			tflog.Debug(ctx, "skipping validate_only for volume, an aggregate name is not known yet")
+			return
+		}
+	}
 
-		resp.Diagnostics.AddError("Volume is offline", "Provider is not supported to manage offline volume. Please manually switch the volume online")
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	request, _ := r.createRequest(ctx, errorHandler, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	client, err := getRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	if supported, _ := interfaces.ValidateStorageVolume(errorHandler, *client, request); !supported {
+		// error reporting done inside ValidateStorageVolume
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("volume %s validated by ONTAP", plan.Name.ValueString()))
 }
 
 // Configure adds the provider configured client to the resource.
@@ -383,23 +487,35 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 		// error reporting done inside NewClient
 		return
 	}
+	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return
+	}
 	// Import don't have id's so we need to get the id from the name
-	var response *interfaces.StorageVolumeGetDataModelONTAP
 	if data.ID.ValueString() == "" {
-		response, err = interfaces.GetStorageVolumeByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
-		if err != nil {
-			return
-		}
-		data.ID = types.StringValue(response.UUID)
-	} else {
-		response, err = interfaces.GetStorageVolume(errorHandler, *client, data.ID.ValueString())
+		volume, err := interfaces.GetStorageVolumeByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
 		if err != nil {
 			return
 		}
+		data.ID = types.StringValue(volume.UUID)
+	}
+	response, err := interfaces.GetStorageVolume(errorHandler, *client, data.ID.ValueString(), cluster.Version)
+	if err != nil {
+		return
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("read a volume resource: %#v", data))
 
+	// the volume may have been renamed outside of Terraform
+	data.Name = types.StringValue(response.Name)
+	data.State = types.StringValue(response.State)
+	if response.State != "online" {
+		// most attributes are not reported for offline or restricted volumes, keep the prior values
+		tflog.Debug(ctx, fmt.Sprintf("volume %s is %s, only refreshing its state", response.Name, response.State))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 	data.Comment = types.StringValue(response.Comment)
 	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
 	data.State = types.StringValue(response.State)
@@ -407,6 +523,7 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 	data.QOSPolicyGroup = types.StringValue(response.QOS.Policy.Name)
 	data.SpaceGuarantee = types.StringValue(response.SpaceGuarantee.Type)
 	data.SnapshotPolicy = types.StringValue(response.SnapshotPolicy.Name)
+	data.SnapdirAccess = types.BoolPointerValue(response.SnapshotDirectoryAccessEnabled)
 	data.Type = types.StringValue(response.Type)
 
 	//Space
@@ -443,13 +560,7 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 	data.Space = objectValue
 
 	//Snaplock
-	elementTypes = map[string]attr.Type{
-		"type": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"type": types.StringValue(response.Snaplock.Type),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	objectValue, diags = flattenStorageVolumeSnaplock(response.Snaplock)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
@@ -530,6 +641,7 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 	}
 	data.Aggregates = aggregates
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "storage/volumes/"+data.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -545,6 +657,148 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	request, sizeUnit := r.createRequest(ctx, errorHandler, &resp.Diagnostics, data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	response, err := interfaces.CreateStorageVolume(errorHandler, *client, request, r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(response.UUID)
+	data.Comment = types.StringValue(response.Comment)
+	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
+	data.State = types.StringValue(response.State)
+	data.Language = types.StringValue(response.Language)
+	data.QOSPolicyGroup = types.StringValue(response.QOS.Policy.Name)
+	data.SpaceGuarantee = types.StringValue(response.SpaceGuarantee.Type)
+	data.SnapshotPolicy = types.StringValue(response.SnapshotPolicy.Name)
+	if data.SnapdirAccess.IsUnknown() {
+		data.SnapdirAccess = types.BoolPointerValue(response.SnapshotDirectoryAccessEnabled)
+	}
+	data.Type = types.StringValue(response.Type)
+
+	//Space
+	nestedElementTypes := map[string]attr.Type{
+		"reporting":   types.BoolType,
+		"enforcement": types.BoolType,
+	}
+	nestedEslements := map[string]attr.Value{
+		"reporting":   types.BoolValue(response.Space.LogicalSpace.Reporting),
+		"enforcement": types.BoolValue(response.Space.LogicalSpace.Enforcement),
+	}
+	logicalObjectValue, _ := types.ObjectValue(nestedElementTypes, nestedEslements)
+
+	elementTypes := map[string]attr.Type{
+		"size":                   types.Int64Type,
+		"size_unit":              types.StringType,
+		"percent_snapshot_space": types.Int64Type,
+		"logical_space":          types.ObjectType{AttrTypes: nestedElementTypes},
+	}
+	elements := map[string]attr.Value{
+		"size":                   types.Int64Value(int64(response.Space.Size / interfaces.POW2BYTEMAP[sizeUnit])),
+		"size_unit":              types.StringValue(sizeUnit),
+		"percent_snapshot_space": types.Int64Value(int64(response.Space.Snapshot.ReservePercent)),
+		"logical_space":          logicalObjectValue,
+	}
+
+	objectValue, diags := types.ObjectValue(elementTypes, elements)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Space = objectValue
+
+	//Snaplock
+	objectValue, diags = flattenStorageVolumeSnaplock(response.Snaplock)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.SnapLock = objectValue
+
+	//Efficiency
+	elementTypes = map[string]attr.Type{
+		"compression": types.StringType,
+		"policy_name": types.StringType,
+	}
+	elements = map[string]attr.Value{
+		"compression": types.StringValue(response.Efficiency.Compression),
+		"policy_name": types.StringValue(response.Efficiency.Policy.Name),
+	}
+	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Efficiency = objectValue
+
+	//Tiering
+	elementTypes = map[string]attr.Type{
+		"minimum_cooling_days": types.Int64Type,
+		"policy_name":          types.StringType,
+	}
+	elements = map[string]attr.Value{
+		"minimum_cooling_days": types.Int64Value(int64(response.TieringPolicy.MinCoolingDays)),
+		"policy_name":          types.StringValue(response.TieringPolicy.Policy),
+	}
+	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Tiering = objectValue
+
+	//Nas
+	elementTypes = map[string]attr.Type{
+		"unix_permissions":   types.Int64Type,
+		"junction_path":      types.StringType,
+		"group_id":           types.Int64Type,
+		"user_id":            types.Int64Type,
+		"security_style":     types.StringType,
+		"export_policy_name": types.StringType,
+	}
+	elements = map[string]attr.Value{
+		"unix_permissions":   types.Int64Value(int64(response.NAS.UnixPermissions)),
+		"junction_path":      types.StringValue(response.NAS.JunctionPath),
+		"group_id":           types.Int64Value(int64(response.NAS.GroupID)),
+		"user_id":            types.Int64Value(int64(response.NAS.UserID)),
+		"security_style":     types.StringValue(response.NAS.SecurityStyle),
+		"export_policy_name": types.StringValue(response.NAS.ExportPolicy.Name),
+	}
+	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Nas = objectValue
+
+	//Analytics
+	elementTypes = map[string]attr.Type{
+		"state": types.StringType,
+	}
+	elements = map[string]attr.Value{
+		"state": types.StringValue(response.Analytics.State),
+	}
+	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Analytics = objectValue
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+}
+
+// createRequest builds the POST body from the plan, and returns the size unit used to convert the size returned by ONTAP
+// errorHandler must report to diagnostics
+func (r *StorageVolumeResource) createRequest(ctx context.Context, errorHandler *utils.ErrorHandler, diagnostics *diag.Diagnostics, data *StorageVolumeResourceModel) (interfaces.StorageVolumeResourceModel, string) {
+	var sizeUnit string
 	var request interfaces.StorageVolumeResourceModel
 
 	//var aggregates = make([]interfaces.Aggregate, len(data.Aggregates))
@@ -561,14 +815,14 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 	err := mapstructure.Decode(aggrgatges, &request.Aggregates)
 	if err != nil {
 		errorHandler.MakeAndReportError("error creating Volume", fmt.Sprintf("error on encoding copies info: %s, copies %#v", err, aggrgatges))
-		return
+		return request, sizeUnit
 	}
 
 	request.Name = data.Name.ValueString()
 	request.SVM.Name = data.SVMName.ValueString()
 
 	if !data.State.IsUnknown() {
-		request.State = data.Type.ValueString()
+		request.State = data.State.ValueString()
 	}
 	if !data.Type.IsUnknown() {
 		request.Type = data.Type.ValueString()
@@ -582,6 +836,9 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 	if !data.SnapshotPolicy.IsUnknown() {
 		request.SnapshotPolicy.Name = data.SnapshotPolicy.ValueString()
 	}
+	if !data.SnapdirAccess.IsUnknown() {
+		request.SnapshotDirectoryAccessEnabled = data.SnapdirAccess.ValueBoolPointer()
+	}
 	if !data.Language.IsUnknown() {
 		request.Language = data.Language.ValueString()
 	}
@@ -596,8 +853,8 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		var nas StorageVolumeResourceNas
 		diags := data.Nas.As(ctx, &nas, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		if !nas.ExportPolicy.IsUnknown() {
 			request.NAS.ExportPolicy.Name = nas.ExportPolicy.ValueString()
@@ -619,16 +876,15 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		}
 	}
 
-	var sizeUnit string
 	var space StorageVolumeResourceSpace
 	diags := data.Space.As(ctx, &space, basetypes.ObjectAsOptions{})
 	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+		diagnostics.Append(diags...)
+		return request, sizeUnit
 	}
 	if _, ok := interfaces.POW2BYTEMAP[space.SizeUnit.ValueString()]; !ok {
 		errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", space.SizeUnit.ValueString()))
-		return
+		return request, sizeUnit
 	}
 	sizeUnit = space.SizeUnit.ValueString()
 	request.Space.Size = int(space.Size.ValueInt64()) * interfaces.POW2BYTEMAP[space.SizeUnit.ValueString()]
@@ -640,8 +896,8 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		var logicalSpace StorageVolumeResourceSpaceLogicalSpace
 		diags = space.LogicalSpace.As(ctx, &logicalSpace, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		if !logicalSpace.Enforcement.IsUnknown() {
 			request.Space.LogicalSpace.Enforcement = logicalSpace.Enforcement.ValueBool()
@@ -655,8 +911,8 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		var efficiency StorageVolumeResourceEfficiency
 		diags := data.Efficiency.As(ctx, &efficiency, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		if !efficiency.Policy.IsUnknown() {
 			request.Efficiency.Policy.Name = efficiency.Policy.ValueString()
@@ -670,8 +926,8 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		var tiering StorageVolumeResourceTiering
 		diags := data.Tiering.As(ctx, &tiering, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		if !tiering.Policy.IsUnknown() {
 			request.TieringPolicy.Policy = tiering.Policy.ValueString()
@@ -685,160 +941,29 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		var snapLock StorageVolumeResourceSnapLock
 		diags := data.SnapLock.As(ctx, &snapLock, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		request.Snaplock.Type = snapLock.SnaplockType.ValueString()
+		request.Snaplock.AutocommitPeriod = snapLock.AutocommitPeriod.ValueString()
+		request.Snaplock.Retention, diags = expandStorageVolumeSnaplockRetention(ctx, snapLock.Retention)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return request, sizeUnit
+		}
 	}
 
 	if !data.Analytics.IsUnknown() {
 		var analytics StorageVolumeResourceAnalytics
 		diags := data.Analytics.As(ctx, &analytics, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
+			diagnostics.Append(diags...)
+			return request, sizeUnit
 		}
 		request.Analytics.State = analytics.State.ValueString()
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
-	if err != nil {
-		// error reporting done inside NewClient
-		return
-	}
-
-	response, err := interfaces.CreateStorageVolume(errorHandler, *client, request)
-	if err != nil {
-		return
-	}
-
-	data.ID = types.StringValue(response.UUID)
-	data.Comment = types.StringValue(response.Comment)
-	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
-	data.State = types.StringValue(response.State)
-	data.Language = types.StringValue(response.Language)
-	data.QOSPolicyGroup = types.StringValue(response.QOS.Policy.Name)
-	data.SpaceGuarantee = types.StringValue(response.SpaceGuarantee.Type)
-	data.SnapshotPolicy = types.StringValue(response.SnapshotPolicy.Name)
-	data.Type = types.StringValue(response.Type)
-
-	//Space
-	nestedElementTypes := map[string]attr.Type{
-		"reporting":   types.BoolType,
-		"enforcement": types.BoolType,
-	}
-	nestedEslements := map[string]attr.Value{
-		"reporting":   types.BoolValue(response.Space.LogicalSpace.Reporting),
-		"enforcement": types.BoolValue(response.Space.LogicalSpace.Enforcement),
-	}
-	logicalObjectValue, _ := types.ObjectValue(nestedElementTypes, nestedEslements)
-
-	elementTypes := map[string]attr.Type{
-		"size":                   types.Int64Type,
-		"size_unit":              types.StringType,
-		"percent_snapshot_space": types.Int64Type,
-		"logical_space":          types.ObjectType{AttrTypes: nestedElementTypes},
-	}
-	elements := map[string]attr.Value{
-		"size":                   types.Int64Value(int64(response.Space.Size / interfaces.POW2BYTEMAP[sizeUnit])),
-		"size_unit":              types.StringValue(sizeUnit),
-		"percent_snapshot_space": types.Int64Value(int64(response.Space.Snapshot.ReservePercent)),
-		"logical_space":          logicalObjectValue,
-	}
-
-	objectValue, diags := types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.Space = objectValue
-
-	//Snaplock
-	elementTypes = map[string]attr.Type{
-		"type": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"type": types.StringValue(response.Snaplock.Type),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.SnapLock = objectValue
-
-	//Efficiency
-	elementTypes = map[string]attr.Type{
-		"compression": types.StringType,
-		"policy_name": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"compression": types.StringValue(response.Efficiency.Compression),
-		"policy_name": types.StringValue(response.Efficiency.Policy.Name),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.Efficiency = objectValue
-
-	//Tiering
-	elementTypes = map[string]attr.Type{
-		"minimum_cooling_days": types.Int64Type,
-		"policy_name":          types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"minimum_cooling_days": types.Int64Value(int64(response.TieringPolicy.MinCoolingDays)),
-		"policy_name":          types.StringValue(response.TieringPolicy.Policy),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.Tiering = objectValue
-
-	//Nas
-	elementTypes = map[string]attr.Type{
-		"unix_permissions":   types.Int64Type,
-		"junction_path":      types.StringType,
-		"group_id":           types.Int64Type,
-		"user_id":            types.Int64Type,
-		"security_style":     types.StringType,
-		"export_policy_name": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"unix_permissions":   types.Int64Value(int64(response.NAS.UnixPermissions)),
-		"junction_path":      types.StringValue(response.NAS.JunctionPath),
-		"group_id":           types.Int64Value(int64(response.NAS.GroupID)),
-		"user_id":            types.Int64Value(int64(response.NAS.UserID)),
-		"security_style":     types.StringValue(response.NAS.SecurityStyle),
-		"export_policy_name": types.StringValue(response.NAS.ExportPolicy.Name),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.Nas = objectValue
-
-	//Analytics
-	elementTypes = map[string]attr.Type{
-		"state": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"state": types.StringValue(response.Analytics.State),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-	}
-	data.Analytics = objectValue
-	tflog.Trace(ctx, "created a resource")
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
+	return request, sizeUnit
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -859,12 +984,19 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 		// error reporting done inside NewClient
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	var request interfaces.StorageVolumeResourceModel
 
-	if !plan.State.IsUnknown() {
-		if !plan.Type.Equal(state.Type) {
-			request.State = plan.State.ValueString()
+	if !plan.Name.Equal(state.Name) {
+		// rename volume, the UUID is unchanged
+		request.Name = plan.Name.ValueString()
+	}
+	// a volume is brought online before other attributes are modified, and taken offline after
+	stateChanged := !plan.State.IsUnknown() && !plan.State.Equal(state.State)
+	if stateChanged && plan.State.ValueString() == "online" {
+		if err = interfaces.UpdateStorageVolumeState(errorHandler, *client, plan.ID.ValueString(), plan.State.ValueString()); err != nil {
+			return
 		}
 	}
 	if !plan.Type.IsUnknown() {
@@ -877,6 +1009,11 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 			request.SnapshotPolicy.Name = plan.SnapshotPolicy.ValueString()
 		}
 	}
+	if !plan.SnapdirAccess.IsUnknown() {
+		if !plan.SnapdirAccess.Equal(state.SnapdirAccess) {
+			request.SnapshotDirectoryAccessEnabled = plan.SnapdirAccess.ValueBoolPointer()
+		}
+	}
 	if !plan.Language.IsUnknown() {
 		if !plan.Language.Equal(state.Language) {
 			request.Language = plan.Language.ValueString()
@@ -1002,13 +1139,31 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 
 	if !plan.SnapLock.IsUnknown() {
 		if !plan.SnapLock.Equal(state.SnapLock) {
-			var snapLock StorageVolumeResourceSnapLock
+			var snapLock, stateSnapLock StorageVolumeResourceSnapLock
 			diags := plan.SnapLock.As(ctx, &snapLock, basetypes.ObjectAsOptions{})
 			if diags.HasError() {
 				resp.Diagnostics.Append(diags...)
 				return
 			}
-			request.Snaplock.Type = snapLock.SnaplockType.ValueString()
+			diags = state.SnapLock.As(ctx, &stateSnapLock, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			// the type can only be set on create, only send it when it changes so that ONTAP reports the error
+			if !snapLock.SnaplockType.Equal(stateSnapLock.SnaplockType) {
+				request.Snaplock.Type = snapLock.SnaplockType.ValueString()
+			}
+			if !snapLock.AutocommitPeriod.Equal(stateSnapLock.AutocommitPeriod) {
+				request.Snaplock.AutocommitPeriod = snapLock.AutocommitPeriod.ValueString()
+			}
+			if !snapLock.Retention.Equal(stateSnapLock.Retention) {
+				request.Snaplock.Retention, diags = expandStorageVolumeSnaplockRetention(ctx, snapLock.Retention)
+				if diags.HasError() {
+					resp.Diagnostics.Append(diags...)
+					return
+				}
+			}
 		}
 	}
 
@@ -1028,15 +1183,55 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 	if err != nil {
 		return
 	}
+	if !storageVolumeAggregatesEqual(plan.Aggregates, state.Aggregates) {
+		// a volume move places the volume on a single destination aggregate
+		if len(plan.Aggregates) != 1 {
+			errorHandler.MakeAndReportError("error moving volume", fmt.Sprintf("a volume can only be moved to a single aggregate, got %d aggregates", len(plan.Aggregates)))
+			return
+		}
+		err = interfaces.MoveStorageVolume(errorHandler, *client, plan.ID.ValueString(), plan.Aggregates[0].Name.ValueString(), r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
+		if err != nil {
+			return
+		}
+	}
+	if plan.SplitClone.ValueBool() && !state.SplitClone.ValueBool() {
+		err = interfaces.SplitStorageVolumeClone(errorHandler, *client, plan.ID.ValueString(), r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
+		if err != nil {
+			return
+		}
+	}
+	if stateChanged && plan.State.ValueString() != "online" {
+		if err = interfaces.UpdateStorageVolumeState(errorHandler, *client, plan.ID.ValueString(), plan.State.ValueString()); err != nil {
+			return
+		}
+	}
 	// Save updated data into Terraform state
 	readDiags := readVolume(ctx, client, plan)
 	resp.Diagnostics.Append(readDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "storage/volumes/"+plan.ID.ValueString())...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// storageVolumeAggregatesEqual reports whether both lists hold the same aggregate names, in any order
+func storageVolumeAggregatesEqual(plan []StorageVolumeResourceAggregates, state []StorageVolumeResourceAggregates) bool {
+	if len(plan) != len(state) {
+		return false
+	}
+	names := make(map[string]bool, len(state))
+	for _, aggregate := range state {
+		names[aggregate.Name.ValueString()] = true
+	}
+	for _, aggregate := range plan {
+		if !names[aggregate.Name.ValueString()] {
+			return false
+		}
+	}
+	return true
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *StorageVolumeResourceModel
@@ -1060,11 +1255,42 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err = interfaces.DeleteStorageVolume(errorHandler, *client, data.ID.ValueString())
+	// a volume is unmounted and taken offline before it is deleted
+	if data.State.ValueString() != "offline" {
+		// restricted volumes are not mounted
+		if data.State.ValueString() != "restricted" && (data.UnmountOnDelete.IsNull() || data.UnmountOnDelete.ValueBool()) {
+			if err = interfaces.UnmountStorageVolume(errorHandler, *client, data.ID.ValueString()); err != nil {
+				return
+			}
+		}
+		if err = interfaces.UpdateStorageVolumeState(errorHandler, *client, data.ID.ValueString(), "offline"); err != nil {
+			return
+		}
+	}
+
+	// a deleted volume is renamed <name>_<dsid> in the recovery queue
+	purge := data.PurgeOnDelete.ValueBool()
+	var dsid int64
+	if purge || !data.RecoveryQueueRetentionHours.IsNull() {
+		if dsid, err = interfaces.GetStorageVolumeDSID(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString()); err != nil {
+			return
+		}
+	}
+
+	err = interfaces.DeleteStorageVolume(errorHandler, *client, data.ID.ValueString(), r.config.providerConfig.JobCompletionTimeOut, r.config.providerConfig.JobPollInterval)
 	if err != nil {
 		return
 	}
 
+	deletedName := fmt.Sprintf("%s_%d", data.Name.ValueString(), dsid)
+	if purge {
+		err = interfaces.PurgeStorageVolumeRecoveryQueue(errorHandler, *client, deletedName, data.SVMName.ValueString())
+	} else if !data.RecoveryQueueRetentionHours.IsNull() {
+		err = interfaces.UpdateStorageVolumeRecoveryQueue(errorHandler, *client, deletedName, data.SVMName.ValueString(), data.RecoveryQueueRetentionHours.ValueInt64())
+	}
+	if err != nil {
+		return
+	}
 }
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
@@ -1089,11 +1315,21 @@ func readVolume(ctx context.Context, client *restclient.RestClient, data *Storag
 
 	errorHandler := utils.NewErrorHandler(ctx, &allDiags)
 
-	response, returnedError := interfaces.GetStorageVolume(errorHandler, *client, data.ID.ValueString())
+	cluster, returnedError := interfaces.GetCluster(errorHandler, *client)
+	if returnedError != nil {
+		// error reporting done inside GetCluster
+		return allDiags
+	}
+	response, returnedError := interfaces.GetStorageVolume(errorHandler, *client, data.ID.ValueString(), cluster.Version)
 	if returnedError != nil {
 		allDiags.AddError("Error reading volume", returnedError.Error())
 		return allDiags
 	}
+	data.State = types.StringValue(response.State)
+	if response.State != "online" {
+		// most attributes are not reported for offline or restricted volumes, keep the planned values
+		return allDiags
+	}
 	data.Comment = types.StringValue(response.Comment)
 	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
 	data.State = types.StringValue(response.State)
@@ -1101,6 +1337,7 @@ func readVolume(ctx context.Context, client *restclient.RestClient, data *Storag
 	data.QOSPolicyGroup = types.StringValue(response.QOS.Policy.Name)
 	data.SpaceGuarantee = types.StringValue(response.SpaceGuarantee.Type)
 	data.SnapshotPolicy = types.StringValue(response.SnapshotPolicy.Name)
+	data.SnapdirAccess = types.BoolPointerValue(response.SnapshotDirectoryAccessEnabled)
 	data.Type = types.StringValue(response.Type)
 
 	//Space
@@ -1146,13 +1383,7 @@ func readVolume(ctx context.Context, client *restclient.RestClient, data *Storag
 	data.Space = objectValue
 
 	//Snaplock
-	elementTypes = map[string]attr.Type{
-		"type": types.StringType,
-	}
-	elements = map[string]attr.Value{
-		"type": types.StringValue(response.Snaplock.Type),
-	}
-	objectValue, diags = types.ObjectValue(elementTypes, elements)
+	objectValue, diags = flattenStorageVolumeSnaplock(response.Snaplock)
 	if diags.HasError() {
 		allDiags.Append(diags...)
 	}
@@ -1226,3 +1457,52 @@ func readVolume(ctx context.Context, client *restclient.RestClient, data *Storag
 
 	return allDiags
 }
+
+// flattenStorageVolumeSnaplock converts the snaplock settings returned by ONTAP to an object, the retention is empty for a non_snaplock volume
+func flattenStorageVolumeSnaplock(snaplock interfaces.Snaplock) (types.Object, diag.Diagnostics) {
+	retentionTypes := map[string]attr.Type{
+		"default": types.StringType,
+		"minimum": types.StringType,
+		"maximum": types.StringType,
+	}
+	var retention interfaces.SnaplockRetention
+	if snaplock.Retention != nil {
+		retention = *snaplock.Retention
+	}
+	retentionValue, diags := types.ObjectValue(retentionTypes, map[string]attr.Value{
+		"default": types.StringValue(retention.Default),
+		"minimum": types.StringValue(retention.Minimum),
+		"maximum": types.StringValue(retention.Maximum),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(map[string]attr.Type{}), diags
+	}
+	elementTypes := map[string]attr.Type{
+		"type":              types.StringType,
+		"retention":         types.ObjectType{AttrTypes: retentionTypes},
+		"autocommit_period": types.StringType,
+	}
+	elements := map[string]attr.Value{
+		"type":              types.StringValue(snaplock.Type),
+		"retention":         retentionValue,
+		"autocommit_period": types.StringValue(snaplock.AutocommitPeriod),
+	}
+	return types.ObjectValue(elementTypes, elements)
+}
+
+// expandStorageVolumeSnaplockRetention returns nil when the retention is not set, unknown periods are not sent
+func expandStorageVolumeSnaplockRetention(ctx context.Context, retention types.Object) (*interfaces.SnaplockRetention, diag.Diagnostics) {
+	if retention.IsNull() || retention.IsUnknown() {
+		return nil, nil
+	}
+	var data StorageVolumeResourceSnapLockRetention
+	diags := retention.As(ctx, &data, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &interfaces.SnaplockRetention{
+		Default: data.Default.ValueString(),
+		Minimum: data.Minimum.ValueString(),
+		Maximum: data.Maximum.ValueString(),
+	}, nil
+}
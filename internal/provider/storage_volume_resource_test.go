@@ -44,6 +44,14 @@ func TestAccStorageVolumeResource(t *testing.T) {
 					resource.TestCheckNoResourceAttr("netapp-ontap_storage_volume_resource.example", "volname"),
 				),
 			},
+			// rename in place
+			{
+				Config: testAccStorageVolumeResourceConfigUpdate("automation", "accVolume1_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_resource.example", "name", "accVolume1_renamed"),
+					resource.TestCheckResourceAttr("netapp-ontap_storage_volume_resource.example", "nas.group_id", "10"),
+				),
+			},
 			// Test importing a resource
 			{
 				ResourceName:  "netapp-ontap_storage_volume_resource.example",
@@ -33,16 +33,17 @@ type StorageVolumeSnapshotDataSource struct {
 type StorageVolumeSnapshotDataSourceModel struct {
 	CxProfileName types.String `tfsdk:"cx_profile_name"`
 	// Snapshot Variables
-	CreateTime      types.String  `tfsdk:"create_time"`
-	Comment         types.String  `tfsdk:"comment"`
-	ExpiryTime      types.String  `tfsdk:"expiry_time"`
-	Name            types.String  `tfsdk:"name"`
-	Size            types.Float64 `tfsdk:"size"`
-	SnapmirrorLabel types.String  `tfsdk:"snapmirror_label"`
-	State           types.String  `tfsdk:"state"`
-	VolumeName      types.String  `tfsdk:"volume_name"`
-	SVMName         types.String  `tfsdk:"svm_name"`
-	ID              types.String  `tfsdk:"id"`
+	CreateTime      types.String            `tfsdk:"create_time"`
+	Comment         types.String            `tfsdk:"comment"`
+	Tags            map[string]types.String `tfsdk:"tags"`
+	ExpiryTime      types.String            `tfsdk:"expiry_time"`
+	Name            types.String            `tfsdk:"name"`
+	Size            types.Float64           `tfsdk:"size"`
+	SnapmirrorLabel types.String            `tfsdk:"snapmirror_label"`
+	State           types.String            `tfsdk:"state"`
+	VolumeName      types.String            `tfsdk:"volume_name"`
+	SVMName         types.String            `tfsdk:"svm_name"`
+	ID              types.String            `tfsdk:"id"`
 }
 
 // Metadata returns the data source type name.
@@ -59,7 +60,7 @@ func (d *StorageVolumeSnapshotDataSource) Schema(ctx context.Context, req dataso
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Snapshot name",
@@ -93,6 +94,11 @@ func (d *StorageVolumeSnapshotDataSource) Schema(ctx context.Context, req dataso
 				MarkdownDescription: "Comment",
 				Computed:            true,
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags decoded from the end of the comment",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 			"snapmirror_label": schema.StringAttribute{
 				MarkdownDescription: "Snapmirror Label",
 				Computed:            true,
@@ -128,20 +134,20 @@ func (d *StorageVolumeSnapshotDataSource) Read(ctx context.Context, req datasour
 		errorHandler.MakeAndReportError("error reading snapshot", "Snapshot name is null")
 		return
 	}
-	// TODO change to volume name
 	if data.VolumeName.IsNull() {
-		errorHandler.MakeAndReportError("error reading snapshot", "Volume UUID is null")
+		errorHandler.MakeAndReportError("error reading snapshot", "Volume name is null")
 		return
 	}
 
+	// the snapshots API is keyed on the volume UUID, resolve it from the volume and SVM names
 	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
 	if err != nil {
-		// error reporting done inside GetStorageVolumeSnapshots
+		// error reporting done inside GetSvmByName
 		return
 	}
-	volume, err := interfaces.GetStorageVolumeByName(errorHandler, *client, data.VolumeName.ValueString(), svm.Name)
+	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.VolumeName.ValueString())
 	if err != nil {
-		// error reporting done inside GetStorageVolumeSnapshots
+		// error reporting done inside GetUUIDVolumeByName
 		return
 	}
 
@@ -154,7 +160,9 @@ func (d *StorageVolumeSnapshotDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 	data.CreateTime = types.StringValue(snapshot.CreateTime)
-	data.Comment = types.StringValue(snapshot.Comment)
+	comment, tags := interfaces.StorageVolumeSnapshotTagsFromComment(snapshot.Comment)
+	data.Comment = types.StringValue(comment)
+	data.Tags = flattenTypesStringMap(tags)
 	data.ExpiryTime = types.StringValue(snapshot.ExpiryTime)
 	data.Name = types.StringValue(snapshot.Name)
 	data.Size = types.Float64Value(snapshot.Size)
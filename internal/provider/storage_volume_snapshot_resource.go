@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -36,15 +37,16 @@ type StorageVolumeSnapshotResource struct {
 
 // StorageVolumeSnapshotResourceModel describes the resource data model.
 type StorageVolumeSnapshotResourceModel struct {
-	CxProfileName      types.String `tfsdk:"cx_profile_name"`
-	Name               types.String `tfsdk:"name"`
-	VolumeName         types.String `tfsdk:"volume_name"`
-	SVMName            types.String `tfsdk:"svm_name"`
-	ExpiryTime         types.String `tfsdk:"expiry_time"`
-	SnaplockExpiryTime types.String `tfsdk:"snaplock_expiry_time"`
-	Comment            types.String `tfsdk:"comment"`
-	SnapmirrorLabel    types.String `tfsdk:"snapmirror_label"`
-	ID                 types.String `tfsdk:"id"`
+	CxProfileName      types.String            `tfsdk:"cx_profile_name"`
+	Name               types.String            `tfsdk:"name"`
+	VolumeName         types.String            `tfsdk:"volume_name"`
+	SVMName            types.String            `tfsdk:"svm_name"`
+	ExpiryTime         types.String            `tfsdk:"expiry_time"`
+	SnaplockExpiryTime types.String            `tfsdk:"snaplock_expiry_time"`
+	Comment            types.String            `tfsdk:"comment"`
+	Tags               map[string]types.String `tfsdk:"tags"`
+	SnapmirrorLabel    types.String            `tfsdk:"snapmirror_label"`
+	ID                 types.String            `tfsdk:"id"`
 }
 
 // Metadata returns the resource type name.
@@ -61,7 +63,7 @@ func (r *StorageVolumeSnapshotResource) Schema(ctx context.Context, req resource
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Snapshot name",
@@ -80,7 +82,12 @@ func (r *StorageVolumeSnapshotResource) Schema(ctx context.Context, req resource
 				Optional:            true,
 			},
 			"comment": schema.StringAttribute{
-				MarkdownDescription: "Comment",
+				MarkdownDescription: "Comment, it cannot end with [key=value;...], which is reserved for tags",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags such as owner, purpose, or ticket. They are stored at the end of the snapshot comment as [key=value;...]",
+				ElementType:         types.StringType,
 				Optional:            true,
 			},
 			"snapmirror_label": schema.StringAttribute{
@@ -158,9 +165,14 @@ func (r *StorageVolumeSnapshotResource) Create(ctx context.Context, req resource
 	if !data.ExpiryTime.IsNull() {
 		request.ExpiryTime = data.ExpiryTime.ValueString()
 	}
-	if !data.Comment.IsNull() {
-		request.Comment = data.Comment.ValueString()
+	tags := expandTypesStringMap(data.Tags)
+	if err := interfaces.ValidateStorageVolumeSnapshotComment(errorHandler, data.Comment.ValueString()); err != nil {
+		return
+	}
+	if err := interfaces.ValidateStorageVolumeSnapshotTags(errorHandler, tags); err != nil {
+		return
 	}
+	request.Comment = interfaces.StorageVolumeSnapshotCommentWithTags(data.Comment.ValueString(), tags)
 	if !data.SnapmirrorLabel.IsNull() {
 		request.SnapmirrorLabel = data.SnapmirrorLabel.ValueString()
 	}
@@ -217,14 +229,25 @@ func (r *StorageVolumeSnapshotResource) Read(ctx context.Context, req resource.R
 		data.Name = types.StringValue(snapshot.Name)
 	}
 
-	if snapshot.Comment != "" {
-		data.Comment = types.StringValue(snapshot.Comment)
+	comment, tags := interfaces.StorageVolumeSnapshotTagsFromComment(snapshot.Comment)
+	if comment != "" {
+		data.Comment = types.StringValue(comment)
+	} else if data.Comment.ValueString() != "" {
+		// comment was removed outside of Terraform
+		data.Comment = types.StringNull()
+	}
+	if len(tags) != 0 {
+		data.Tags = flattenTypesStringMap(tags)
+	} else if len(data.Tags) != 0 {
+		data.Tags = nil
 	}
 	if snapshot.ExpiryTime != "" {
 		data.ExpiryTime = types.StringValue(snapshot.ExpiryTime)
 	}
 	if snapshot.SnapmirrorLabel != "" {
 		data.SnapmirrorLabel = types.StringValue(snapshot.SnapmirrorLabel)
+	} else if data.SnapmirrorLabel.ValueString() != "" {
+		data.SnapmirrorLabel = types.StringNull()
 	}
 	if snapshot.SnaplockExpiryTime != "" {
 		data.SnaplockExpiryTime = types.StringValue(snapshot.SnaplockExpiryTime)
@@ -233,6 +256,7 @@ func (r *StorageVolumeSnapshotResource) Read(ctx context.Context, req resource.R
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a snapshot data source: %#v", data))
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, fmt.Sprintf("storage/volumes/%s/snapshots/%s", volume.UUID, data.ID.ValueString()))...)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -255,6 +279,7 @@ func (r *StorageVolumeSnapshotResource) Update(ctx context.Context, req resource
 		// error reporting done inside NewClient
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
 	if err != nil {
 		return
@@ -264,6 +289,7 @@ func (r *StorageVolumeSnapshotResource) Update(ctx context.Context, req resource
 		return
 	}
 	var request interfaces.StorageVolumeSnapshotResourceModel
+	var clearFields []string
 	if !data.Name.Equal(state.Name) {
 		// rename snapshot
 		request.Name = data.Name.ValueString()
@@ -282,26 +308,33 @@ func (r *StorageVolumeSnapshotResource) Update(ctx context.Context, req resource
 		}
 		request.SnaplockExpiryTime = data.SnaplockExpiryTime.ValueString()
 	}
-	if !data.Comment.Equal(state.Comment) {
-		if data.Comment.ValueString() == "" {
-			errorHandler.MakeAndReportError("update comment", "comment cannot be updated with empty string")
+	tags := expandTypesStringMap(data.Tags)
+	if !data.Comment.Equal(state.Comment) || !reflect.DeepEqual(tags, expandTypesStringMap(state.Tags)) {
+		if err := interfaces.ValidateStorageVolumeSnapshotComment(errorHandler, data.Comment.ValueString()); err != nil {
 			return
 		}
-		request.Comment = data.Comment.ValueString()
-	}
-	if !data.SnapmirrorLabel.Equal(state.SnapmirrorLabel) {
-		if data.SnapmirrorLabel.ValueString() == "" {
-			errorHandler.MakeAndReportError("update snapmirror_label", "snapmirror_label cannot be updated with empty string")
+		if err := interfaces.ValidateStorageVolumeSnapshotTags(errorHandler, tags); err != nil {
 			return
 		}
+		request.Comment = interfaces.StorageVolumeSnapshotCommentWithTags(data.Comment.ValueString(), tags)
+		if request.Comment == "" {
+			// comment and tags were removed
+			clearFields = append(clearFields, "comment")
+		}
+	}
+	if !data.SnapmirrorLabel.Equal(state.SnapmirrorLabel) {
 		request.SnapmirrorLabel = data.SnapmirrorLabel.ValueString()
+		if request.SnapmirrorLabel == "" {
+			clearFields = append(clearFields, "snapmirror_label")
+		}
 	}
-	tflog.Debug(ctx, fmt.Sprintf("update a resource %s: %#v", state.ID.ValueString(), request))
-	err = interfaces.UpdateStorageVolumeSnapshot(errorHandler, *client, request, volume.UUID, state.ID.ValueString())
+	tflog.Debug(ctx, fmt.Sprintf("update a resource %s: %#v, clear %v", state.ID.ValueString(), request, clearFields))
+	err = interfaces.UpdateStorageVolumeSnapshot(errorHandler, *client, request, clearFields, volume.UUID, state.ID.ValueString())
 	if err != nil {
 		return
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, fmt.Sprintf("storage/volumes/%s/snapshots/%s", volume.UUID, state.ID.ValueString()))...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
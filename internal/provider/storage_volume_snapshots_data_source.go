@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -38,9 +39,10 @@ type StorageVolumeSnapshotsDataSourceModel struct {
 
 // StorageVolumeSnapshotDataSourceFilterModel describes the data source data model for queries.
 type StorageVolumeSnapshotDataSourceFilterModel struct {
-	Name       types.String `tfsdk:"name"`
-	SVMName    types.String `tfsdk:"svm_name"`
-	VolumeName types.String `tfsdk:"volume_name"`
+	Name       types.String            `tfsdk:"name"`
+	SVMName    types.String            `tfsdk:"svm_name"`
+	VolumeName types.String            `tfsdk:"volume_name"`
+	Tags       map[string]types.String `tfsdk:"tags"`
 }
 
 // Metadata returns the data source type name.
@@ -57,22 +59,27 @@ func (d *StorageVolumeSnapshotsDataSource) Schema(ctx context.Context, req datas
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"name": schema.StringAttribute{
 						MarkdownDescription: "StorageVolumeSnapshot name",
-						Required:            true,
+						Optional:            true,
 					},
 					"volume_name": schema.StringAttribute{
-						MarkdownDescription: "StorageVolumeSnapshot volume name",
+						MarkdownDescription: "StorageVolumeSnapshot volume name, wildcards such as vol* read the snapshots of all matching volumes",
 						Required:            true,
 					},
 					"svm_name": schema.StringAttribute{
 						MarkdownDescription: "StorageVolumeSnapshot svm name",
 						Required:            true,
 					},
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Only return snapshots with all these tags. Use an empty value or * to match any value for a key",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
 				},
 				Required: true,
 			},
@@ -115,6 +122,11 @@ func (d *StorageVolumeSnapshotsDataSource) Schema(ctx context.Context, req datas
 							MarkdownDescription: "Comment",
 							Computed:            true,
 						},
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "Tags decoded from the end of the comment",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
 						"snapmirror_label": schema.StringAttribute{
 							MarkdownDescription: "Snapmirror Label",
 							Computed:            true,
@@ -167,8 +179,8 @@ func (d *StorageVolumeSnapshotsDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	if data.Filter.Name.IsNull() {
-		errorHandler.MakeAndReportError("error reading snapshot", "filter.name is required")
+	if data.Filter.Name.IsNull() && len(data.Filter.Tags) == 0 {
+		errorHandler.MakeAndReportError("error reading snapshot", "filter.name or filter.tags is required")
 		return
 	}
 	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.Filter.SVMName.ValueString())
@@ -176,20 +188,37 @@ func (d *StorageVolumeSnapshotsDataSource) Read(ctx context.Context, req datasou
 		// error reporting done inside GetStorageVolumeSnapshots
 		return
 	}
-	volume, err := interfaces.GetStorageVolumeByName(errorHandler, *client, data.Filter.VolumeName.ValueString(), svm.Name)
-	if err != nil {
-		// error reporting done inside GetStorageVolumeSnapshots
-		return
+	var volumeUUIDs []string
+	if strings.ContainsAny(data.Filter.VolumeName.ValueString(), "*|!") {
+		volumes, err := interfaces.GetStorageVolumes(errorHandler, *client, &interfaces.StorageVolumeDataSourceFilterModel{
+			Name:    data.Filter.VolumeName.ValueString(),
+			SVMName: svm.Name,
+		})
+		if err != nil {
+			// error reporting done inside GetStorageVolumes
+			return
+		}
+		for _, volume := range volumes {
+			volumeUUIDs = append(volumeUUIDs, volume.UUID)
+		}
+	} else {
+		volume, err := interfaces.GetStorageVolumeByName(errorHandler, *client, data.Filter.VolumeName.ValueString(), svm.Name)
+		if err != nil {
+			// error reporting done inside GetStorageVolumeSnapshots
+			return
+		}
+		volumeUUIDs = append(volumeUUIDs, volume.UUID)
 	}
 
 	var filter *interfaces.StorageVolumeSnapshotDataSourceFilterModel = nil
 	if data.Filter != nil {
 		filter = &interfaces.StorageVolumeSnapshotDataSourceFilterModel{
 			Name: data.Filter.Name.ValueString(),
+			Tags: expandTypesStringMap(data.Filter.Tags),
 		}
 	}
 
-	restInfo, err := interfaces.GetListStorageVolumeSnapshots(errorHandler, *client, volume.UUID, filter)
+	restInfo, err := interfaces.GetListStorageVolumesSnapshots(errorHandler, *client, volumeUUIDs, filter)
 	if err != nil {
 		// error reporting done inside GetStorageVolumeSnapshots
 		return
@@ -197,11 +226,14 @@ func (d *StorageVolumeSnapshotsDataSource) Read(ctx context.Context, req datasou
 
 	data.StorageVolumeSnapshots = make([]StorageVolumeSnapshotDataSourceModel, len(restInfo))
 	for index, record := range restInfo {
+		comment, tags := interfaces.StorageVolumeSnapshotTagsFromComment(record.Comment)
 		data.StorageVolumeSnapshots[index] = StorageVolumeSnapshotDataSourceModel{
 			CxProfileName:   types.String(data.CxProfileName),
 			Name:            types.StringValue(record.Name),
 			SVMName:         types.StringValue(record.SVM.Name),
 			CreateTime:      types.StringValue(record.CreateTime),
+			Comment:         types.StringValue(comment),
+			Tags:            flattenTypesStringMap(tags),
 			ExpiryTime:      types.StringValue(record.ExpiryTime),
 			Size:            types.Float64Value(record.Size),
 			SnapmirrorLabel: types.StringValue(record.SnapmirrorLabel),
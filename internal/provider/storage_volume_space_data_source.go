@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageVolumeSpaceDataSource{}
+
+// NewStorageVolumeSpaceDataSource is a helper function to simplify the provider implementation.
+func NewStorageVolumeSpaceDataSource() datasource.DataSource {
+	return &StorageVolumeSpaceDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "storage_volume_space_data_source",
+		},
+	}
+}
+
+// StorageVolumeSpaceDataSource defines the data source implementation.
+type StorageVolumeSpaceDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// StorageVolumeSpaceDataSourceModel describes the data source data model.
+type StorageVolumeSpaceDataSourceModel struct {
+	CxProfileName          types.String `tfsdk:"cx_profile_name"`
+	Name                   types.String `tfsdk:"name"`
+	SVMName                types.String `tfsdk:"svm_name"`
+	UUID                   types.String `tfsdk:"uuid"`
+	Size                   types.Int64  `tfsdk:"size"`
+	Available              types.Int64  `tfsdk:"available"`
+	Used                   types.Int64  `tfsdk:"used"`
+	UsedPercent            types.Int64  `tfsdk:"used_percent"`
+	Footprint              types.Int64  `tfsdk:"footprint"`
+	SnapshotReservePercent types.Int64  `tfsdk:"snapshot_reserve_percent"`
+	SnapshotReserveSize    types.Int64  `tfsdk:"snapshot_reserve_size"`
+	SnapshotUsed           types.Int64  `tfsdk:"snapshot_used"`
+	LogicalUsed            types.Int64  `tfsdk:"logical_used"`
+	LogicalAvailable       types.Int64  `tfsdk:"logical_available"`
+	LogicalEnforcement     types.Bool   `tfsdk:"logical_enforcement"`
+	LogicalReporting       types.Bool   `tfsdk:"logical_reporting"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageVolumeSpaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageVolumeSpaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Volume space data source, to read the current space usage of a volume. All sizes are in bytes",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Volume name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "Volume UUID",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Total provisioned size, including the snapshot reserve",
+				Computed:            true,
+			},
+			"available": schema.Int64Attribute{
+				MarkdownDescription: "Available space",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "Space used by the data in the active file system",
+				Computed:            true,
+			},
+			"used_percent": schema.Int64Attribute{
+				MarkdownDescription: "Percentage of the space used by the active file system, used / (used + available)",
+				Computed:            true,
+			},
+			"footprint": schema.Int64Attribute{
+				MarkdownDescription: "Space used by the volume in its aggregate, including its metadata",
+				Computed:            true,
+			},
+			"snapshot_reserve_percent": schema.Int64Attribute{
+				MarkdownDescription: "Percentage of the size reserved for snapshot copies",
+				Computed:            true,
+			},
+			"snapshot_reserve_size": schema.Int64Attribute{
+				MarkdownDescription: "Space reserved for snapshot copies",
+				Computed:            true,
+			},
+			"snapshot_used": schema.Int64Attribute{
+				MarkdownDescription: "Space used by snapshot copies, it can exceed the snapshot reserve",
+				Computed:            true,
+			},
+			"logical_used": schema.Int64Attribute{
+				MarkdownDescription: "Logical space used, before storage efficiency savings",
+				Computed:            true,
+			},
+			"logical_available": schema.Int64Attribute{
+				MarkdownDescription: "Logical space available",
+				Computed:            true,
+			},
+			"logical_enforcement": schema.BoolAttribute{
+				MarkdownDescription: "Whether space accounting for operations is done using the logical space",
+				Computed:            true,
+			},
+			"logical_reporting": schema.BoolAttribute{
+				MarkdownDescription: "Whether the logical space is reported to clients",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageVolumeSpaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageVolumeSpaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageVolumeSpaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetStorageVolumeSpace(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetStorageVolumeSpace
+		return
+	}
+
+	space := restInfo.Space
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.Size = types.Int64Value(space.Size)
+	data.Available = types.Int64Value(space.Available)
+	data.Used = types.Int64Value(space.Used)
+	data.UsedPercent = types.Int64Value(0)
+	if space.Used+space.Available > 0 {
+		data.UsedPercent = types.Int64Value(space.Used * 100 / (space.Used + space.Available))
+	}
+	data.Footprint = types.Int64Value(space.Footprint)
+	data.SnapshotReservePercent = types.Int64Value(space.Snapshot.ReservePercent)
+	data.SnapshotReserveSize = types.Int64Value(space.Snapshot.ReserveSize)
+	data.SnapshotUsed = types.Int64Value(space.Snapshot.Used)
+	data.LogicalUsed = types.Int64Value(space.LogicalSpace.Used)
+	data.LogicalAvailable = types.Int64Value(space.LogicalSpace.Available)
+	data.LogicalEnforcement = types.BoolValue(space.LogicalSpace.Enforcement)
+	data.LogicalReporting = types.BoolValue(space.LogicalSpace.Reporting)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
@@ -37,8 +40,10 @@ type StorageVolumesDataSourceModel struct {
 
 // StorageVolumeDataSourceFilterModel describes the data source data model for queries.
 type StorageVolumeDataSourceFilterModel struct {
-	Name    types.String `tfsdk:"name"`
-	SVMName types.String `tfsdk:"svm_name"`
+	Name          types.String `tfsdk:"name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	State         types.String `tfsdk:"state"`
+	TieringPolicy types.String `tfsdk:"tiering_policy"`
 }
 
 // Metadata returns the data source type name.
@@ -55,18 +60,32 @@ func (d *StorageVolumesDataSource) Schema(ctx context.Context, req datasource.Sc
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"name": schema.StringAttribute{
-						MarkdownDescription: "StorageVolume name",
+						MarkdownDescription: "StorageVolume name, wildcards are supported and several patterns can be separated with |, eg vol_*|data_*",
 						Optional:            true,
 					},
 					"svm_name": schema.StringAttribute{
 						MarkdownDescription: "StorageVolume svm name",
 						Optional:            true,
 					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "StorageVolume state, eg online, offline, restricted",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("online", "offline", "restricted", "error", "mixed"),
+						},
+					},
+					"tiering_policy": schema.StringAttribute{
+						MarkdownDescription: "StorageVolume tiering policy, eg all, auto, none, snapshot_only",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "auto", "backup", "none", "snapshot_only"),
+						},
+					},
 				},
 				Optional: true,
 			},
@@ -285,8 +304,10 @@ func (d *StorageVolumesDataSource) Read(ctx context.Context, req datasource.Read
 	var filter *interfaces.StorageVolumeDataSourceFilterModel = nil
 	if data.Filter != nil {
 		filter = &interfaces.StorageVolumeDataSourceFilterModel{
-			Name:    data.Filter.Name.ValueString(),
-			SVMName: data.Filter.SVMName.ValueString(),
+			Name:          data.Filter.Name.ValueString(),
+			SVMName:       data.Filter.SVMName.ValueString(),
+			State:         data.Filter.State.ValueString(),
+			TieringPolicy: data.Filter.TieringPolicy.ValueString(),
 		}
 	}
 	restInfo, err := interfaces.GetStorageVolumes(errorHandler, *client, filter)
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmsDestinationResource{}
+var _ resource.ResourceWithImportState = &EmsDestinationResource{}
+
+// NewEmsDestinationResource is a helper function to simplify the provider implementation.
+func NewEmsDestinationResource() resource.Resource {
+	return &EmsDestinationResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_ems_destination_resource",
+		},
+	}
+}
+
+// EmsDestinationResource defines the resource implementation.
+type EmsDestinationResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// EmsDestinationResourceModel describes the resource data model.
+type EmsDestinationResourceModel struct {
+	CxProfileName types.String               `tfsdk:"cx_profile_name"`
+	Name          types.String               `tfsdk:"name"`
+	Type          types.String               `tfsdk:"type"`
+	Destination   types.String               `tfsdk:"destination"`
+	Filters       []types.String             `tfsdk:"filters"`
+	Syslog        *EmsDestinationSyslogModel `tfsdk:"syslog"`
+	ID            types.String               `tfsdk:"id"`
+}
+
+// EmsDestinationSyslogModel describes the syslog data model.
+type EmsDestinationSyslogModel struct {
+	Port      types.Int64  `tfsdk:"port"`
+	Transport types.String `tfsdk:"transport"`
+}
+
+// Metadata returns the resource type name.
+func (r *EmsDestinationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *EmsDestinationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EMS destination resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "EMS destination name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "EMS destination type, one of email, syslog, rest_api, snmp",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("email", "syslog", "rest_api", "snmp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Email address, syslog host name or IP address, or webhook URL, depending on the type",
+				Required:            true,
+			},
+			"filters": schema.ListAttribute{
+				MarkdownDescription: "Names of the EMS filters selecting the events sent to this destination",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"syslog": schema.SingleNestedAttribute{
+				MarkdownDescription: "Syslog transport settings, for the syslog type",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Syslog server port",
+						Optional:            true,
+					},
+					"transport": schema.StringAttribute{
+						MarkdownDescription: "Syslog transport protocol, one of udp_unencrypted, tcp_unencrypted, tcp_encrypted",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("udp_unencrypted", "tcp_unencrypted", "tcp_encrypted"),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "EMS destination identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EmsDestinationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EmsDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmsDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsDestination(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetEmsDestination
+		return
+	}
+
+	imported := data.ID.IsNull()
+	data.Name = types.StringValue(restInfo.Name)
+	data.Type = types.StringValue(restInfo.Type)
+	data.Destination = types.StringValue(restInfo.Destination)
+	if imported || data.Filters != nil || len(restInfo.Filters) > 0 {
+		data.Filters = make([]types.String, len(restInfo.Filters))
+		for index, filter := range restInfo.Filters {
+			data.Filters[index] = types.StringValue(filter.Name)
+		}
+	}
+	// only report the syslog settings that are managed by terraform, or all of them on import
+	if restInfo.Syslog != nil && (imported || data.Syslog != nil) {
+		if data.Syslog == nil {
+			data.Syslog = &EmsDestinationSyslogModel{Port: types.Int64Null(), Transport: types.StringNull()}
+		}
+		if imported || !data.Syslog.Port.IsNull() {
+			data.Syslog.Port = types.Int64Value(restInfo.Syslog.Port)
+		}
+		if imported || !data.Syslog.Transport.IsNull() {
+			data.Syslog.Transport = types.StringValue(restInfo.Syslog.Transport)
+		}
+	}
+	data.ID = types.StringValue(restInfo.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource
+func (r *EmsDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmsDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.CreateEmsDestination(errorHandler, *client, r.buildBody(data)); err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *EmsDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmsDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateEmsDestination(errorHandler, *client, r.buildBody(data), data.Name.ValueString()); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildBody converts the plan to the ONTAP body
+func (r *EmsDestinationResource) buildBody(data *EmsDestinationResourceModel) interfaces.EmsDestinationResourceBodyDataModelONTAP {
+	body := interfaces.EmsDestinationResourceBodyDataModelONTAP{
+		Name:        data.Name.ValueString(),
+		Type:        data.Type.ValueString(),
+		Destination: data.Destination.ValueString(),
+		Filters:     []map[string]string{},
+	}
+	for _, filter := range data.Filters {
+		body.Filters = append(body.Filters, map[string]string{"name": filter.ValueString()})
+	}
+	if data.Syslog != nil {
+		body.Syslog = &interfaces.EmsDestinationSyslog{
+			Port:      data.Syslog.Port.ValueInt64(),
+			Transport: data.Syslog.Transport.ValueString(),
+		}
+	}
+	return body
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *EmsDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmsDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteEmsDestination(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *EmsDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an ems destination resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportEmsDestinationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportEmsDestinationResourceConfig("10.193.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_destination_resource.example", "name", "tf-acc-syslog"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_destination_resource.example", "destination", "10.193.0.10"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_destination_resource.example", "filters.0", "important-events"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSupportEmsDestinationResourceConfig("10.193.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_destination_resource.example", "destination", "10.193.0.11"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_support_ems_destination_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "tf-acc-syslog", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_destination_resource.example", "type", "syslog"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportEmsDestinationResourceConfig(destination string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_ems_destination_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "tf-acc-syslog"
+  type = "syslog"
+  destination = "%s"
+  filters = ["important-events"]
+}`, host, admin, password, destination)
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmsFilterResource{}
+var _ resource.ResourceWithImportState = &EmsFilterResource{}
+
+// NewEmsFilterResource is a helper function to simplify the provider implementation.
+func NewEmsFilterResource() resource.Resource {
+	return &EmsFilterResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_ems_filter_resource",
+		},
+	}
+}
+
+// EmsFilterResource defines the resource implementation.
+type EmsFilterResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// EmsFilterResourceModel describes the resource data model.
+type EmsFilterResourceModel struct {
+	CxProfileName types.String         `tfsdk:"cx_profile_name"`
+	Name          types.String         `tfsdk:"name"`
+	Rules         []EmsFilterRuleModel `tfsdk:"rules"`
+	ID            types.String         `tfsdk:"id"`
+}
+
+// EmsFilterRuleModel describes the rule data model.
+type EmsFilterRuleModel struct {
+	Type          types.String `tfsdk:"type"`
+	NamePattern   types.String `tfsdk:"name_pattern"`
+	Severities    types.String `tfsdk:"severities"`
+	SnmpTrapTypes types.String `tfsdk:"snmp_trap_types"`
+}
+
+// Metadata returns the resource type name.
+func (r *EmsFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *EmsFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EMS filter resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "EMS filter name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Rules, evaluated in order. ONTAP adds a final rule excluding all other events. When not set, the rules are not managed by this resource",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Rule type, include or exclude",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("include", "exclude"),
+							},
+						},
+						"name_pattern": schema.StringAttribute{
+							MarkdownDescription: "Event name pattern, eg callhome.*",
+							Required:            true,
+						},
+						"severities": schema.StringAttribute{
+							MarkdownDescription: "Comma separated list of severities, eg emergency,alert,error, or *",
+							Required:            true,
+						},
+						"snmp_trap_types": schema.StringAttribute{
+							MarkdownDescription: "Comma separated list of SNMP trap types, eg standard,built_in, or *",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "EMS filter identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EmsFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EmsFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmsFilterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsFilter(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetEmsFilter
+		return
+	}
+
+	// rules may be managed with netapp-ontap_support_ems_filter_rule_resource, only report them when configured or imported
+	if data.Rules != nil || data.ID.IsNull() {
+		data.Rules = flattenEmsFilterRules(restInfo.Rules)
+	}
+	data.Name = types.StringValue(restInfo.Name)
+	data.ID = types.StringValue(restInfo.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve the rules as reported by ONTAP
+func (r *EmsFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmsFilterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.EmsFilterResourceBodyDataModelONTAP{
+		Name:  data.Name.ValueString(),
+		Rules: expandEmsFilterRules(data.Rules),
+	}
+	if err = interfaces.CreateEmsFilter(errorHandler, *client, body); err != nil {
+		return
+	}
+
+	// read back the default values for snmp_trap_types
+	restInfo, err := interfaces.GetEmsFilter(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+	if data.Rules != nil {
+		data.Rules = flattenEmsFilterRules(restInfo.Rules)
+	}
+	data.ID = types.StringValue(restInfo.Name)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *EmsFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmsFilterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.Rules == nil {
+		// the rules are not managed by this resource
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	body := interfaces.EmsFilterResourceBodyDataModelONTAP{
+		Rules: expandEmsFilterRules(data.Rules),
+	}
+	if err = interfaces.UpdateEmsFilter(errorHandler, *client, body, data.Name.ValueString()); err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsFilter(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+	data.Rules = flattenEmsFilterRules(restInfo.Rules)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *EmsFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmsFilterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteEmsFilter(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *EmsFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an ems filter resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+// expandEmsFilterRules converts the rules to the ONTAP body, indexes start at 1
+func expandEmsFilterRules(rules []EmsFilterRuleModel) []map[string]interface{} {
+	body := make([]map[string]interface{}, len(rules))
+	for index, rule := range rules {
+		criteria := map[string]interface{}{
+			"name_pattern": rule.NamePattern.ValueString(),
+			"severities":   rule.Severities.ValueString(),
+		}
+		if !rule.SnmpTrapTypes.IsUnknown() && !rule.SnmpTrapTypes.IsNull() {
+			criteria["snmp_trap_types"] = rule.SnmpTrapTypes.ValueString()
+		}
+		body[index] = map[string]interface{}{
+			"index":            int64(index + 1),
+			"type":             rule.Type.ValueString(),
+			"message_criteria": criteria,
+		}
+	}
+	return body
+}
+
+// flattenEmsFilterRules converts the ONTAP rules, ignoring the exclude all rule added by ONTAP
+func flattenEmsFilterRules(rules []interfaces.EmsFilterRule) []EmsFilterRuleModel {
+	if len(rules) > 0 && interfaces.IsEmsFilterDefaultRule(rules[len(rules)-1]) {
+		rules = rules[:len(rules)-1]
+	}
+	data := make([]EmsFilterRuleModel, len(rules))
+	for index, rule := range rules {
+		data[index] = EmsFilterRuleModel{
+			Type:          types.StringValue(rule.Type),
+			NamePattern:   types.StringValue(rule.MessageCriteria.NamePattern),
+			Severities:    types.StringValue(rule.MessageCriteria.Severities),
+			SnmpTrapTypes: types.StringValue(rule.MessageCriteria.SnmpTrapTypes),
+		}
+	}
+	return data
+}
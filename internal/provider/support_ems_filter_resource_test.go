@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportEmsFilterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportEmsFilterResourceConfig("emergency,alert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_resource.example", "name", "tf-acc-critical"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_resource.example", "rules.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_resource.example", "rules.0.severities", "emergency,alert"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSupportEmsFilterResourceConfig("emergency,alert,error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_resource.example", "rules.0.severities", "emergency,alert,error"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_support_ems_filter_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "tf-acc-critical", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_resource.example", "name", "tf-acc-critical"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportEmsFilterResourceConfig(severities string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_ems_filter_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "tf-acc-critical"
+  rules = [
+    {
+      type = "exclude"
+      name_pattern = "callhome.*"
+      severities = "*"
+    },
+    {
+      type = "include"
+      name_pattern = "*"
+      severities = "%s"
+    },
+  ]
+}`, host, admin, password, severities)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmsFilterRuleResource{}
+var _ resource.ResourceWithImportState = &EmsFilterRuleResource{}
+
+// NewEmsFilterRuleResource is a helper function to simplify the provider implementation.
+func NewEmsFilterRuleResource() resource.Resource {
+	return &EmsFilterRuleResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_ems_filter_rule_resource",
+		},
+	}
+}
+
+// EmsFilterRuleResource defines the resource implementation.
+type EmsFilterRuleResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// EmsFilterRuleResourceModel describes the resource data model.
+type EmsFilterRuleResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	FilterName    types.String `tfsdk:"filter_name"`
+	Index         types.Int64  `tfsdk:"index"`
+	Type          types.String `tfsdk:"type"`
+	NamePattern   types.String `tfsdk:"name_pattern"`
+	Severities    types.String `tfsdk:"severities"`
+	SnmpTrapTypes types.String `tfsdk:"snmp_trap_types"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *EmsFilterRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *EmsFilterRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EMS filter rule resource, to manage a single rule of an EMS filter",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter_name": schema.StringAttribute{
+				MarkdownDescription: "EMS filter name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Rule position in the filter, starting at 1. When not set, the rule is added after the existing rules",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Rule type, include or exclude",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("include", "exclude"),
+				},
+			},
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Event name pattern, eg callhome.*",
+				Required:            true,
+			},
+			"severities": schema.StringAttribute{
+				MarkdownDescription: "Comma separated list of severities, eg emergency,alert,error, or *",
+				Required:            true,
+			},
+			"snmp_trap_types": schema.StringAttribute{
+				MarkdownDescription: "Comma separated list of SNMP trap types, eg standard,built_in, or *",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "EMS filter rule identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EmsFilterRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+// Rules move when other rules are added or deleted before them, so the rule is first searched at its index,
+// then with its type and criteria. When it is not found, the rule at its index is reported.
+func (r *EmsFilterRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmsFilterRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsFilter(errorHandler, *client, data.FilterName.ValueString())
+	if err != nil {
+		// error reporting done inside GetEmsFilter
+		return
+	}
+
+	var rule *interfaces.EmsFilterRule
+	for index := range restInfo.Rules {
+		if restInfo.Rules[index].Index == data.Index.ValueInt64() {
+			rule = &restInfo.Rules[index]
+			break
+		}
+	}
+	if !data.Type.IsNull() && (rule == nil || !data.matches(*rule)) {
+		for index := range restInfo.Rules {
+			if data.matches(restInfo.Rules[index]) {
+				rule = &restInfo.Rules[index]
+				break
+			}
+		}
+	}
+	if rule == nil || interfaces.IsEmsFilterDefaultRule(*rule) {
+		tflog.Debug(ctx, fmt.Sprintf("EMS filter rule %s not found, removing it from state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setModel(*rule)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve the index and the default values as reported by ONTAP
+func (r *EmsFilterRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmsFilterRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := data.expand()
+	if !data.Index.IsUnknown() && !data.Index.IsNull() {
+		body.Index = data.Index.ValueInt64()
+	}
+	rule, err := interfaces.CreateEmsFilterRule(errorHandler, *client, data.FilterName.ValueString(), body)
+	if err != nil {
+		return
+	}
+	data.setModel(*rule)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update modifies the rule in place, the other rules of the filter are left unchanged.
+func (r *EmsFilterRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmsFilterRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateEmsFilterRule(errorHandler, *client, data.FilterName.ValueString(), data.Index.ValueInt64(), data.expand()); err != nil {
+		return
+	}
+
+	// read back the default value for snmp_trap_types
+	restInfo, err := interfaces.GetEmsFilter(errorHandler, *client, data.FilterName.ValueString())
+	if err != nil {
+		return
+	}
+	for _, rule := range restInfo.Rules {
+		if rule.Index == data.Index.ValueInt64() {
+			data.setModel(rule)
+			break
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *EmsFilterRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmsFilterRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteEmsFilterRule(errorHandler, *client, data.FilterName.ValueString(), data.Index.ValueInt64())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *EmsFilterRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an ems filter rule resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: filter_name,index,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	index, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an integer index in import identifier filter_name,index,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filter_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// expand converts the rule type and criteria to the ONTAP body
+func (data *EmsFilterRuleResourceModel) expand() interfaces.EmsFilterRuleResourceBodyDataModelONTAP {
+	criteria := interfaces.EmsFilterMessageCriteria{
+		NamePattern: data.NamePattern.ValueString(),
+		Severities:  data.Severities.ValueString(),
+	}
+	if !data.SnmpTrapTypes.IsUnknown() && !data.SnmpTrapTypes.IsNull() {
+		criteria.SnmpTrapTypes = data.SnmpTrapTypes.ValueString()
+	}
+	return interfaces.EmsFilterRuleResourceBodyDataModelONTAP{
+		Type:            data.Type.ValueString(),
+		MessageCriteria: &criteria,
+	}
+}
+
+// matches returns true when the ONTAP rule has the same type and criteria
+func (data *EmsFilterRuleResourceModel) matches(rule interfaces.EmsFilterRule) bool {
+	return rule.Type == data.Type.ValueString() &&
+		rule.MessageCriteria.NamePattern == data.NamePattern.ValueString() &&
+		rule.MessageCriteria.Severities == data.Severities.ValueString() &&
+		(data.SnmpTrapTypes.IsNull() || rule.MessageCriteria.SnmpTrapTypes == data.SnmpTrapTypes.ValueString())
+}
+
+// setModel sets the rule attributes from the ONTAP rule
+func (data *EmsFilterRuleResourceModel) setModel(rule interfaces.EmsFilterRule) {
+	data.Index = types.Int64Value(rule.Index)
+	data.Type = types.StringValue(rule.Type)
+	data.NamePattern = types.StringValue(rule.MessageCriteria.NamePattern)
+	data.Severities = types.StringValue(rule.MessageCriteria.Severities)
+	data.SnmpTrapTypes = types.StringValue(rule.MessageCriteria.SnmpTrapTypes)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", data.FilterName.ValueString(), rule.Index))
+}
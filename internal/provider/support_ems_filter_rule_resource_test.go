@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportEmsFilterRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportEmsFilterRuleResourceConfig("emergency,alert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "filter_name", "tf-acc-rules"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "index", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "severities", "emergency,alert"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSupportEmsFilterRuleResourceConfig("emergency,alert,error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "index", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "severities", "emergency,alert,error"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_support_ems_filter_rule_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "tf-acc-rules", "1", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_filter_rule_resource.example", "name_pattern", "wafl.*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportEmsFilterRuleResourceConfig(severities string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_ems_filter_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "tf-acc-rules"
+}
+
+resource "netapp-ontap_support_ems_filter_rule_resource" "example" {
+  cx_profile_name = "cluster4"
+  filter_name = netapp-ontap_support_ems_filter_resource.example.name
+  type = "include"
+  name_pattern = "wafl.*"
+  severities = "%s"
+}`, host, admin, password, severities)
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmsRoleConfigResource{}
+var _ resource.ResourceWithImportState = &EmsRoleConfigResource{}
+
+// NewEmsRoleConfigResource is a helper function to simplify the provider implementation.
+func NewEmsRoleConfigResource() resource.Resource {
+	return &EmsRoleConfigResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_ems_role_config_resource",
+		},
+	}
+}
+
+// EmsRoleConfigResource defines the resource implementation.
+type EmsRoleConfigResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// EmsRoleConfigResourceModel describes the resource data model.
+type EmsRoleConfigResourceModel struct {
+	CxProfileName              types.String `tfsdk:"cx_profile_name"`
+	RoleName                   types.String `tfsdk:"role_name"`
+	FilterName                 types.String `tfsdk:"filter_name"`
+	LimitAccessToGlobalConfigs types.Bool   `tfsdk:"limit_access_to_global_configs"`
+	ID                         types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *EmsRoleConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *EmsRoleConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EMS role configuration resource, to limit the events, filters, and destinations an access control role can see. Requires ONTAP 9.13 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"role_name": schema.StringAttribute{
+				MarkdownDescription: "Access control role name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter_name": schema.StringAttribute{
+				MarkdownDescription: "EMS filter name, only the events matching the filter are visible to the role",
+				Required:            true,
+			},
+			"limit_access_to_global_configs": schema.BoolAttribute{
+				MarkdownDescription: "Whether the role is restricted from viewing and modifying the filters and destinations of other roles",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "EMS role configuration identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EmsRoleConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EmsRoleConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmsRoleConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsRoleConfig(errorHandler, *client, data.RoleName.ValueString())
+	if err != nil {
+		// error reporting done inside GetEmsRoleConfig
+		return
+	}
+
+	data.RoleName = types.StringValue(restInfo.AccessControlRole.Name)
+	data.FilterName = types.StringValue(restInfo.EventFilter.Name)
+	data.LimitAccessToGlobalConfigs = types.BoolValue(restInfo.LimitAccessToGlobalConfigs)
+	data.ID = types.StringValue(restInfo.AccessControlRole.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve the default value of limit_access_to_global_configs
+func (r *EmsRoleConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EmsRoleConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.CreateEmsRoleConfig(errorHandler, *client, r.buildBody(data)); err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetEmsRoleConfig(errorHandler, *client, data.RoleName.ValueString())
+	if err != nil {
+		return
+	}
+	data.LimitAccessToGlobalConfigs = types.BoolValue(restInfo.LimitAccessToGlobalConfigs)
+	data.ID = types.StringValue(data.RoleName.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *EmsRoleConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EmsRoleConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateEmsRoleConfig(errorHandler, *client, r.buildBody(data), data.RoleName.ValueString()); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildBody converts the plan to the ONTAP body
+func (r *EmsRoleConfigResource) buildBody(data *EmsRoleConfigResourceModel) interfaces.EmsRoleConfigResourceBodyDataModelONTAP {
+	body := interfaces.EmsRoleConfigResourceBodyDataModelONTAP{
+		AccessControlRole: &interfaces.EmsRoleConfigName{Name: data.RoleName.ValueString()},
+		EventFilter:       &interfaces.EmsRoleConfigName{Name: data.FilterName.ValueString()},
+	}
+	if !data.LimitAccessToGlobalConfigs.IsUnknown() {
+		body.LimitAccessToGlobalConfigs = data.LimitAccessToGlobalConfigs.ValueBoolPointer()
+	}
+	return body
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *EmsRoleConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EmsRoleConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteEmsRoleConfig(errorHandler, *client, data.RoleName.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *EmsRoleConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an ems role config resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: role_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
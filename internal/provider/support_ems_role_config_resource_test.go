@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportEmsRoleConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportEmsRoleConfigResourceConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_role_config_resource.example", "role_name", "tf-acc-ems-role"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_role_config_resource.example", "filter_name", "tf-acc-role-events"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_role_config_resource.example", "limit_access_to_global_configs", "false"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSupportEmsRoleConfigResourceConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_role_config_resource.example", "limit_access_to_global_configs", "true"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_support_ems_role_config_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "tf-acc-ems-role", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_ems_role_config_resource.example", "filter_name", "tf-acc-role-events"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportEmsRoleConfigResourceConfig(limitAccess bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_ems_filter_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "tf-acc-role-events"
+  rules = [
+    {
+      type = "include"
+      name_pattern = "wafl.*"
+      severities = "*"
+    },
+  ]
+}
+
+# the tf-acc-ems-role role is expected to exist on the cluster
+resource "netapp-ontap_support_ems_role_config_resource" "example" {
+  cx_profile_name = "cluster4"
+  role_name = "tf-acc-ems-role"
+  filter_name = netapp-ontap_support_ems_filter_resource.example.name
+  limit_access_to_global_configs = %t
+}`, host, admin, password, limitAccess)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &PerformanceArchiveResource{}
+
+// NewPerformanceArchiveResource is a helper function to simplify the provider implementation.
+func NewPerformanceArchiveResource() resource.Resource {
+	return &PerformanceArchiveResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_performance_archive_resource",
+		},
+	}
+}
+
+// PerformanceArchiveResource defines the resource implementation.
+type PerformanceArchiveResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// PerformanceArchiveResourceModel describes the resource data model.
+type PerformanceArchiveResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Message       types.String `tfsdk:"message"`
+	Trigger       types.String `tfsdk:"trigger"`
+	CollectionID  types.String `tfsdk:"collection_id"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *PerformanceArchiveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *PerformanceArchiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Upload the performance archive of a node with an AutoSupport performance message. The collection is started on create, any change recreates the resource and starts a new collection",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Node name. Wildcards are not allowed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Text added to the AutoSupport subject, eg a support case number",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value. Changing it starts a new collection",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				MarkdownDescription: "Collection identifier to reference in support cases, node name and AutoSupport sequence number",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Performance archive identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PerformanceArchiveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read keeps the Terraform state, as the collection is a one time action.
+func (r *PerformanceArchiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PerformanceArchiveResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create starts the collection on a single node
+func (r *PerformanceArchiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PerformanceArchiveResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	nodeName := data.NodeName.ValueString()
+	if strings.ContainsAny(nodeName, "*?|") {
+		errorHandler.MakeAndReportError("invalid node_name", fmt.Sprintf("wildcards are not allowed in node_name %s", nodeName))
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// only collect from a node that is part of the cluster
+	nodes, err := interfaces.GetClusterNodes(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	found := false
+	for _, node := range nodes {
+		if node.Name == nodeName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		errorHandler.MakeAndReportError("invalid node_name", fmt.Sprintf("node %s not found in the cluster", nodeName))
+		return
+	}
+
+	restInfo, err := interfaces.CreatePerformanceArchive(errorHandler, *client, nodeName, data.Message.ValueString())
+	if err != nil {
+		return
+	}
+
+	data.CollectionID = types.StringValue(fmt.Sprintf("%s:%d", restInfo.Node.Name, restInfo.Index))
+	data.ID = data.CollectionID
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not expected, as all the attributes require a replacement.
+func (r *PerformanceArchiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PerformanceArchiveResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *PerformanceArchiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PerformanceArchiveResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("performance archive %s removed from state", data.ID.ValueString()))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportPerformanceArchiveResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// wildcards are rejected
+			{
+				Config:      testAccSupportPerformanceArchiveResourceConfig("swenjun-*"),
+				ExpectError: regexp.MustCompile("wildcards are not allowed"),
+			},
+			{
+				Config:      testAccSupportPerformanceArchiveResourceConfig("non-existant_node"),
+				ExpectError: regexp.MustCompile("not found in the cluster"),
+			},
+			// Create and read testing
+			{
+				Config: testAccSupportPerformanceArchiveResourceConfig("swenjun-vsim1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_performance_archive_resource.example", "node_name", "swenjun-vsim1"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_support_performance_archive_resource.example", "collection_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportPerformanceArchiveResourceConfig(nodeName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_performance_archive_resource" "example" {
+  cx_profile_name = "cluster4"
+  node_name = "%s"
+  message = "terraform acceptance test"
+}`, host, admin, password, nodeName)
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SnmpTraphostResource{}
+var _ resource.ResourceWithImportState = &SnmpTraphostResource{}
+
+// NewSnmpTraphostResource is a helper function to simplify the provider implementation.
+func NewSnmpTraphostResource() resource.Resource {
+	return &SnmpTraphostResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_snmp_traphost_resource",
+		},
+	}
+}
+
+// SnmpTraphostResource defines the resource implementation.
+type SnmpTraphostResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SnmpTraphostResourceModel describes the resource data model.
+type SnmpTraphostResourceModel struct {
+	CxProfileName types.String      `tfsdk:"cx_profile_name"`
+	Host          types.String      `tfsdk:"host"`
+	IPAddress     types.String      `tfsdk:"ip_address"`
+	CommunityName types.String      `tfsdk:"community_name"`
+	UsmUser       *SnmpUsmUserModel `tfsdk:"usm_user"`
+	ID            types.String      `tfsdk:"id"`
+}
+
+// SnmpUsmUserModel describes the SNMPv3 USM user data model.
+type SnmpUsmUserModel struct {
+	Name                   types.String `tfsdk:"name"`
+	AuthenticationProtocol types.String `tfsdk:"authentication_protocol"`
+	AuthenticationPassword types.String `tfsdk:"authentication_password"`
+	PrivacyProtocol        types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassword        types.String `tfsdk:"privacy_password"`
+	EngineID               types.String `tfsdk:"engine_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SnmpTraphostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SnmpTraphostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SNMP traphost resource, to send SNMP traps with an SNMPv1/v2c community or an SNMPv3 USM user",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Name or IP address of the traphost, an IP address is required with usm_user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IP address of the traphost",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"community_name": schema.StringAttribute{
+				MarkdownDescription: "SNMPv1/v2c community, the community must exist on the cluster",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("usm_user"),
+					}...),
+				},
+			},
+			"usm_user": schema.SingleNestedAttribute{
+				MarkdownDescription: "SNMPv3 USM user, created for the traphost and deleted with it",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "User name",
+						Required:            true,
+					},
+					"authentication_protocol": schema.StringAttribute{
+						MarkdownDescription: "Authentication protocol, one of none, md5, sha, sha2_256. Defaults to none",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("none"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "md5", "sha", "sha2_256"),
+						},
+					},
+					"authentication_password": schema.StringAttribute{
+						MarkdownDescription: "Authentication password, required when authentication_protocol is not none",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"privacy_protocol": schema.StringAttribute{
+						MarkdownDescription: "Privacy protocol, one of none, des, aes128. Defaults to none",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("none"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "des", "aes128"),
+						},
+					},
+					"privacy_password": schema.StringAttribute{
+						MarkdownDescription: "Privacy password, required when privacy_protocol is not none",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"engine_id": schema.StringAttribute{
+						MarkdownDescription: "Engine ID of the traphost, as discovered by ONTAP",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SNMP traphost identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SnmpTraphostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SnmpTraphostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnmpTraphostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSnmpTraphost(errorHandler, *client, data.Host.ValueString())
+	if err != nil {
+		// error reporting done inside GetSnmpTraphost
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("SNMP traphost %s not found, removing it from state", data.Host.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.IPAddress = types.StringValue(restInfo.IPAddress)
+	if restInfo.Community != nil {
+		data.CommunityName = types.StringValue(restInfo.Community.Name)
+	}
+	// the passwords and protocols are not reported by ONTAP, they are kept from the state
+	if restInfo.User != nil {
+		if data.UsmUser == nil {
+			data.UsmUser = &SnmpUsmUserModel{
+				AuthenticationProtocol: types.StringNull(),
+				AuthenticationPassword: types.StringNull(),
+				PrivacyProtocol:        types.StringNull(),
+				PrivacyPassword:        types.StringNull(),
+				EngineID:               types.StringNull(),
+			}
+		}
+		data.UsmUser.Name = types.StringValue(restInfo.User.Name)
+	}
+	data.ID = types.StringValue(data.Host.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the USM user if needed, then the traphost
+func (r *SnmpTraphostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpTraphostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SnmpTraphostResourceBodyDataModelONTAP{
+		Host: data.Host.ValueString(),
+	}
+	if !data.CommunityName.IsNull() {
+		body.Community = &interfaces.SnmpName{Name: data.CommunityName.ValueString()}
+	}
+	if data.UsmUser != nil {
+		userBody, err := expandSnmpUsmUser(errorHandler, data.UsmUser)
+		if err != nil {
+			return
+		}
+		// the user is created for the remote engine of the traphost
+		userBody.SwitchAddress = data.Host.ValueString()
+		user, err := interfaces.CreateSnmpUser(errorHandler, *client, userBody)
+		if err != nil {
+			return
+		}
+		data.UsmUser.EngineID = types.StringValue(user.EngineID)
+		body.User = &interfaces.SnmpName{Name: user.Name}
+	}
+
+	if err = interfaces.CreateSnmpTraphost(errorHandler, *client, body); err != nil {
+		if data.UsmUser != nil {
+			// do not leave an unused user behind, errors are reported by DeleteSnmpUser
+			_ = interfaces.DeleteSnmpUser(errorHandler, *client, data.UsmUser.EngineID.ValueString(), data.UsmUser.Name.ValueString())
+		}
+		return
+	}
+
+	restInfo, err := interfaces.GetSnmpTraphost(errorHandler, *client, data.Host.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error creating snmp_traphost", fmt.Sprintf("traphost %s not found after creation", data.Host.ValueString()))
+		return
+	}
+	data.IPAddress = types.StringValue(restInfo.IPAddress)
+	data.ID = types.StringValue(data.Host.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not supported, as every attribute requires replacement.
+func (r *SnmpTraphostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpTraphostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the traphost, then the USM user created for it.
+func (r *SnmpTraphostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpTraphostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteSnmpTraphost(errorHandler, *client, data.Host.ValueString()); err != nil {
+		return
+	}
+	// the engine ID is unknown when the traphost was imported, the user is left unchanged
+	if data.UsmUser != nil && data.UsmUser.EngineID.ValueString() != "" {
+		if err = interfaces.DeleteSnmpUser(errorHandler, *client, data.UsmUser.EngineID.ValueString(), data.UsmUser.Name.ValueString()); err != nil {
+			return
+		}
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SnmpTraphostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an snmp traphost resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: host,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+// expandSnmpUsmUser converts the USM user to the ONTAP body, and checks a password is set for each protocol
+func expandSnmpUsmUser(errorHandler *utils.ErrorHandler, user *SnmpUsmUserModel) (interfaces.SnmpUserResourceBodyDataModelONTAP, error) {
+	body := interfaces.SnmpUserResourceBodyDataModelONTAP{
+		Name:                 user.Name.ValueString(),
+		AuthenticationMethod: "usm",
+		Snmpv3: &interfaces.SnmpUserV3{
+			AuthenticationProtocol: user.AuthenticationProtocol.ValueString(),
+			PrivacyProtocol:        user.PrivacyProtocol.ValueString(),
+		},
+	}
+	// the passwords are only sent with their protocol
+	if body.Snmpv3.AuthenticationProtocol != "none" {
+		body.Snmpv3.AuthenticationPassword = user.AuthenticationPassword.ValueString()
+	}
+	if body.Snmpv3.PrivacyProtocol != "none" {
+		body.Snmpv3.PrivacyPassword = user.PrivacyPassword.ValueString()
+	}
+	if body.Snmpv3.AuthenticationProtocol != "none" && body.Snmpv3.AuthenticationPassword == "" {
+		return body, errorHandler.MakeAndReportError("missing authentication_password", fmt.Sprintf("authentication_password is required with authentication_protocol %s", body.Snmpv3.AuthenticationProtocol))
+	}
+	if body.Snmpv3.PrivacyProtocol != "none" {
+		if body.Snmpv3.PrivacyPassword == "" {
+			return body, errorHandler.MakeAndReportError("missing privacy_password", fmt.Sprintf("privacy_password is required with privacy_protocol %s", body.Snmpv3.PrivacyProtocol))
+		}
+		if body.Snmpv3.AuthenticationProtocol == "none" {
+			return body, errorHandler.MakeAndReportError("invalid privacy_protocol", "privacy_protocol requires an authentication_protocol")
+		}
+	}
+	return body, nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportSnmpTraphostResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportSnmpTraphostResourceConfig("none"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_traphost_resource.example", "host", "10.193.180.250"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_traphost_resource.example", "usm_user.name", "tf-acc-traps"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_support_snmp_traphost_resource.example", "usm_user.engine_id"),
+				),
+			},
+			// Replace testing
+			{
+				Config: testAccSupportSnmpTraphostResourceConfig("aes128"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_traphost_resource.example", "usm_user.privacy_protocol", "aes128"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_support_snmp_traphost_resource.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "10.193.180.250", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_traphost_resource.example", "usm_user.name", "tf-acc-traps"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportSnmpTraphostResourceConfig(privacyProtocol string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_snmp_traphost_resource" "example" {
+  cx_profile_name = "cluster4"
+  host = "10.193.180.250"
+  usm_user = {
+    name = "tf-acc-traps"
+    authentication_protocol = "sha"
+    authentication_password = "tf-acc-auth-pass1"
+    privacy_protocol = "%s"
+    privacy_password = "tf-acc-priv-pass1"
+  }
+}`, host, admin, password, privacyProtocol)
+}
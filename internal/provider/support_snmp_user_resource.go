@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SnmpUserResource{}
+var _ resource.ResourceWithImportState = &SnmpUserResource{}
+
+// NewSnmpUserResource is a helper function to simplify the provider implementation.
+func NewSnmpUserResource() resource.Resource {
+	return &SnmpUserResource{
+		config: resourceOrDataSourceConfig{
+			name: "support_snmp_user_resource",
+		},
+	}
+}
+
+// SnmpUserResource defines the resource implementation.
+type SnmpUserResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SnmpUserResourceModel describes the resource data model.
+type SnmpUserResourceModel struct {
+	CxProfileName          types.String `tfsdk:"cx_profile_name"`
+	Name                   types.String `tfsdk:"name"`
+	SVMName                types.String `tfsdk:"svm_name"`
+	EngineID               types.String `tfsdk:"engine_id"`
+	SwitchAddress          types.String `tfsdk:"switch_address"`
+	AuthenticationProtocol types.String `tfsdk:"authentication_protocol"`
+	AuthenticationPassword types.String `tfsdk:"authentication_password"`
+	PrivacyProtocol        types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassword        types.String `tfsdk:"privacy_password"`
+	Comment                types.String `tfsdk:"comment"`
+	ID                     types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *SnmpUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SnmpUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SNMPv3 user resource, to let SNMP managers poll the cluster or an SVM with a USM user",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "User name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name, the user is created for the cluster when not set",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engine_id": schema.StringAttribute{
+				MarkdownDescription: "Engine ID of the user, defaults to the local engine ID of the cluster or SVM, or to the engine ID of the remote switch",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"switch_address": schema.StringAttribute{
+				MarkdownDescription: "IP address of a remote switch, for a user authenticating to the switch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication_protocol": schema.StringAttribute{
+				MarkdownDescription: "Authentication protocol, one of none, md5, sha, sha2_256. Defaults to none",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "md5", "sha", "sha2_256"),
+				},
+			},
+			"authentication_password": schema.StringAttribute{
+				MarkdownDescription: "Authentication password, required when authentication_protocol is not none",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privacy_protocol": schema.StringAttribute{
+				MarkdownDescription: "Privacy protocol, one of none, des, aes128. Defaults to none",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "des", "aes128"),
+				},
+			},
+			"privacy_password": schema.StringAttribute{
+				MarkdownDescription: "Privacy password, required when privacy_protocol is not none",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SNMP user identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SnmpUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SnmpUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnmpUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSnmpUser(errorHandler, *client, data.EngineID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSnmpUser
+		return
+	}
+	if restInfo == nil {
+		// the local engine ID changes when the cluster or SVM is renamed or recreated
+		tflog.Debug(ctx, fmt.Sprintf("SNMP user %s not found, removing it from state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	imported := data.ID.IsNull()
+	if restInfo.Scope == "svm" {
+		data.SVMName = types.StringValue(restInfo.Owner.Name)
+	}
+	if restInfo.SwitchAddress != "" || !data.SwitchAddress.IsNull() {
+		data.SwitchAddress = types.StringValue(restInfo.SwitchAddress)
+	}
+	// the passwords are not reported by ONTAP, they are kept from the state
+	if restInfo.Snmpv3 != nil {
+		data.AuthenticationProtocol = types.StringValue(restInfo.Snmpv3.AuthenticationProtocol)
+		data.PrivacyProtocol = types.StringValue(restInfo.Snmpv3.PrivacyProtocol)
+	}
+	if imported || !data.Comment.IsNull() {
+		data.Comment = types.StringValue(restInfo.Comment)
+	}
+	data.EngineID = types.StringValue(restInfo.EngineID)
+	data.ID = types.StringValue(restInfo.EngineID + "/" + restInfo.Name)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve the engine ID
+func (r *SnmpUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body, err := expandSnmpUsmUser(errorHandler, &SnmpUsmUserModel{
+		Name:                   data.Name,
+		AuthenticationProtocol: data.AuthenticationProtocol,
+		AuthenticationPassword: data.AuthenticationPassword,
+		PrivacyProtocol:        data.PrivacyProtocol,
+		PrivacyPassword:        data.PrivacyPassword,
+	})
+	if err != nil {
+		return
+	}
+	if !data.EngineID.IsUnknown() && !data.EngineID.IsNull() {
+		body.EngineID = data.EngineID.ValueString()
+	}
+	if !data.SVMName.IsNull() {
+		body.Owner = &interfaces.SnmpName{Name: data.SVMName.ValueString()}
+	}
+	body.SwitchAddress = data.SwitchAddress.ValueString()
+	body.Comment = data.Comment.ValueString()
+
+	user, err := interfaces.CreateSnmpUser(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+	data.EngineID = types.StringValue(user.EngineID)
+	data.ID = types.StringValue(user.EngineID + "/" + user.Name)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the comment, the other attributes require replacement.
+func (r *SnmpUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SnmpUserUpdateBodyDataModelONTAP{
+		Comment: data.Comment.ValueString(),
+	}
+	if err = interfaces.UpdateSnmpUser(errorHandler, *client, data.EngineID.ValueString(), data.Name.ValueString(), body); err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SnmpUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.DeleteSnmpUser(errorHandler, *client, data.EngineID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SnmpUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req an snmp user resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,engine_id,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("engine_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSupportSnmpUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccSupportSnmpUserResourceConfig("read-only poller"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_user_resource.example", "name", "tf-acc-poller"),
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_user_resource.example", "authentication_protocol", "sha"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_support_snmp_user_resource.example", "engine_id"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: testAccSupportSnmpUserResourceConfig("monitoring poller"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_support_snmp_user_resource.example", "comment", "monitoring poller"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportSnmpUserResourceConfig(comment string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_support_snmp_user_resource" "example" {
+  cx_profile_name = "cluster4"
+  name = "tf-acc-poller"
+  authentication_protocol = "sha"
+  authentication_password = "tf-acc-auth-pass1"
+  comment = "%s"
+}`, host, admin, password, comment)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SvmMetricsDataSource{}
+
+// NewSvmMetricsDataSource is a helper function to simplify the provider implementation.
+func NewSvmMetricsDataSource() datasource.DataSource {
+	return &SvmMetricsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "svm_metrics_data_source",
+		},
+	}
+}
+
+// SvmMetricsDataSource defines the data source implementation.
+type SvmMetricsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SvmMetricsDataSourceModel describes the data source data model.
+type SvmMetricsDataSourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	SVMName       types.String                       `tfsdk:"svm_name"`
+	Protocol      types.String                       `tfsdk:"protocol"`
+	Interval      types.String                       `tfsdk:"interval"`
+	Metrics       []PerformanceMetricDataSourceModel `tfsdk:"metrics"`
+}
+
+// Metadata returns the data source type name.
+func (d *SvmMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *SvmMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SVM metrics data source, to read the latency, IOPS, and throughput of a protocol service of a SVM",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol service, one of cifs, fcp, iscsi, or nvme",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cifs", "fcp", "iscsi", "nvme"),
+				},
+			},
+			"interval": metricsIntervalAttribute(),
+			"metrics":  metricsAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SvmMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SvmMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SvmMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+
+	restInfo, err := interfaces.GetSvmProtocolMetrics(errorHandler, *client, data.Protocol.ValueString(), svm.UUID, metricsInterval(data.Interval))
+	if err != nil {
+		// error reporting done inside GetSvmProtocolMetrics
+		return
+	}
+	data.Metrics = flattenPerformanceMetrics(restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %s/%s, %d samples", data.SVMName.ValueString(), data.Protocol.ValueString(), len(data.Metrics)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SvmPeersDataSource{}
+
+// NewSvmPeersDataSource is a helper function to simplify the provider implementation.
+func NewSvmPeersDataSource() datasource.DataSource {
+	return &SvmPeersDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "svm_peers_data_source",
+		},
+	}
+}
+
+// SvmPeersDataSource defines the data source implementation.
+type SvmPeersDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SvmPeersDataSourceModel describes the data source data model.
+type SvmPeersDataSourceModel struct {
+	CxProfileName types.String                  `tfsdk:"cx_profile_name"`
+	SvmPeers      []SvmPeerDataSourceModel      `tfsdk:"svm_peers"`
+	Filter        *SvmPeerDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// SvmPeerDataSourceModel describes the data model for a SVM peer relationship.
+type SvmPeerDataSourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	SVMName         types.String   `tfsdk:"svm_name"`
+	PeerSVMName     types.String   `tfsdk:"peer_svm_name"`
+	PeerClusterName types.String   `tfsdk:"peer_cluster_name"`
+	State           types.String   `tfsdk:"state"`
+	Applications    []types.String `tfsdk:"applications"`
+}
+
+// SvmPeerDataSourceFilterModel describes the data source data model for queries.
+type SvmPeerDataSourceFilterModel struct {
+	SVMName         types.String `tfsdk:"svm_name"`
+	PeerSVMName     types.String `tfsdk:"peer_svm_name"`
+	PeerClusterName types.String `tfsdk:"peer_cluster_name"`
+	State           types.String `tfsdk:"state"`
+}
+
+// Metadata returns the data source type name.
+func (d *SvmPeersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *SvmPeersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SVM peers data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Local svm name",
+						Optional:            true,
+					},
+					"peer_svm_name": schema.StringAttribute{
+						MarkdownDescription: "Peer svm name",
+						Optional:            true,
+					},
+					"peer_cluster_name": schema.StringAttribute{
+						MarkdownDescription: "Peer cluster name",
+						Optional:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Peer relationship state, eg peered, pending, initializing, rejected, suspended",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"svm_peers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Peer relationship UUID",
+							Computed:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Local svm name",
+							Computed:            true,
+						},
+						"peer_svm_name": schema.StringAttribute{
+							MarkdownDescription: "Peer svm name",
+							Computed:            true,
+						},
+						"peer_cluster_name": schema.StringAttribute{
+							MarkdownDescription: "Peer cluster name",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Peer relationship state",
+							Computed:            true,
+						},
+						"applications": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Applications allowed to use the peer relationship, eg snapmirror, flexcache",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SvmPeersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SvmPeersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SvmPeersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.SvmPeerDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.SvmPeerDataSourceFilterModel{
+			SVMName:         data.Filter.SVMName.ValueString(),
+			PeerSVMName:     data.Filter.PeerSVMName.ValueString(),
+			PeerClusterName: data.Filter.PeerClusterName.ValueString(),
+			State:           data.Filter.State.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetListSvmPeers(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetListSvmPeers
+		return
+	}
+
+	data.SvmPeers = make([]SvmPeerDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.SvmPeers[index] = SvmPeerDataSourceModel{
+			ID:              types.StringValue(record.UUID),
+			SVMName:         types.StringValue(record.SVM.Name),
+			PeerSVMName:     types.StringValue(record.Peer.SVM.Name),
+			PeerClusterName: types.StringValue(record.Peer.Cluster.Name),
+			State:           types.StringValue(record.State),
+			Applications:    flattenTypesStringList(record.Applications),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -67,7 +69,7 @@ func (r *SvmResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 		Attributes: map[string]schema.Attribute{
 			"cx_profile_name": schema.StringAttribute{
 				MarkdownDescription: "Connection profile name",
-				Required:            true,
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the svm to manage",
@@ -82,8 +84,11 @@ func (r *SvmResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:            true,
 			},
 			"subtype": schema.StringAttribute{
-				MarkdownDescription: "The subtype for svm to be created",
+				MarkdownDescription: "The subtype for svm to be created, use dp_destination for the destination of a SVM DR relationship",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "dp_destination", "sync_source", "sync_destination"),
+				},
 			},
 			"comment": schema.StringAttribute{
 				MarkdownDescription: "Comment for svm to be created",
@@ -285,6 +290,7 @@ func (r *SvmResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		data.MaxVolumes = types.StringValue(svm.MaxVolumes)
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "svm/svms/"+data.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -311,6 +317,7 @@ func (r *SvmResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if err != nil {
 		return
 	}
+	resp.Diagnostics.Append(restoreETag(ctx, client, req.Private)...)
 
 	var request interfaces.SvmResourceModel
 	if !data.Name.Equal(state.Name) {
@@ -390,6 +397,7 @@ func (r *SvmResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(saveETag(ctx, client, resp.Private, "svm/svms/"+state.ID.ValueString())...)
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -1,11 +1,16 @@
 package httpclient
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,8 +31,25 @@ type HTTPProfile struct {
 	Username      string
 	Password      string
 	ValidateCerts bool
+	// PEM encoded client certificate and private key, used for mutual TLS authentication instead of Username and Password
+	ClientCertificate string
+	ClientPrivateKey  string
+	// OAuth 2.0 access token, sent as a bearer token instead of Username and Password
+	AccessToken string
+	// PEM encoded CA certificates, trusted in addition to the system roots
+	CACertificates string
+	// verify the certificate chain, but not that it is issued for Hostname, eg when connecting by IP address
+	SkipHostnameVerification bool
+	// timeouts in seconds, 0 for the defaults
+	ConnectTimeout int
+	ReadTimeout    int
+	// if set, requests in flight are cancelled when the operation deadline is reached
+	Deadline time.Time
 }
 
+// defaultReadTimeout applies to each request, including reading the response
+const defaultReadTimeout = 120 * time.Second
+
 // Do sends the API Request, parses the response as JSON, and returns the HTTP status code as int, the "result" value as byte
 // possible errors:
 //
@@ -37,19 +59,43 @@ type HTTPProfile struct {
 //		failed to read HTTP response body - statusCode from response if present, otherwise -1
 //		empty response body (check with POST/PATCH/DELETE if this is really a problem)  - statusCode from response if present, otherwise -1
 func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
+	statusCode, body, _, err := c.DoWithHeaders(baseURL, req)
+	return statusCode, body, err
+}
+
+// requestContext returns the context for a request, derived from the client context so that requests are canceled with
+// the Terraform operation, eg on Ctrl-C, and bounded by the deadline of the connection profile if set
+func (c *HTTPClient) requestContext() (context.Context, context.CancelFunc) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if c.cxProfile.Deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, c.cxProfile.Deadline)
+}
+
+// DoWithHeaders is identical to Do, but also returns the HTTP response headers, if a response was received.
+// This is used to retrieve concurrency identifiers such as ETag.
+func (c *HTTPClient) DoWithHeaders(baseURL string, req *Request) (int, []byte, http.Header, error) {
 	httpReq, err := req.BuildHTTPReq(c, baseURL)
 	statusCode := -1
 	if err != nil {
-		return statusCode, nil, err
+		return statusCode, nil, nil, err
 	}
-	tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"body": req.Body})
+	ctx, cancel := c.requestContext()
+	defer cancel()
+	httpReq = httpReq.WithContext(ctx)
+	tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"body": redactRequestBody(req.Body)})
+	tflog.Trace(c.ctx, fmt.Sprintf("request headers: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"headers": redactHeaders(httpReq.Header)})
 	httpRes, err := c.httpClient.Do(httpReq)
 	if httpRes != nil {
 		statusCode = httpRes.StatusCode
 	}
 	if err != nil {
 		tflog.Error(c.ctx, fmt.Sprintf("HTTP request failed: %s, statusCode: %d, err raw:%#v", err, statusCode, err))
-		return statusCode, nil, err
+		return statusCode, nil, nil, err
 	}
 
 	defer httpRes.Body.Close()
@@ -57,16 +103,60 @@ func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
 	body, err := io.ReadAll(httpRes.Body)
 	if err != nil {
 		tflog.Error(c.ctx, fmt.Sprintf("HTTP response read failed: %s, statusCode: %d", err, statusCode))
-		return statusCode, nil, err
+		return statusCode, nil, httpRes.Header, err
 	}
 
 	if body == nil {
-		return httpRes.StatusCode, nil, fmt.Errorf("no result returned in REST response.  statusCode %d", statusCode)
+		return httpRes.StatusCode, nil, httpRes.Header, fmt.Errorf("no result returned in REST response.  statusCode %d", statusCode)
 	}
 
-	tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"res": string(body)})
+	tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"res": redactJSON(body)})
+	tflog.Trace(c.ctx, fmt.Sprintf("response headers: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"headers": redactHeaders(httpRes.Header)})
 
-	return httpRes.StatusCode, body, nil
+	return httpRes.StatusCode, body, httpRes.Header, nil
+}
+
+// DoRaw sends a POST request with a raw body to a path outside of APIRoot, and returns the HTTP status code and response body.
+// This is used for the ONTAPI (ZAPI) XML interface.
+func (c *HTTPClient) DoRaw(path string, contentType string, body []byte) (int, []byte, error) {
+	statusCode := -1
+	if c.cxProfile.Hostname == "" {
+		return statusCode, nil, errors.New("error in DoRaw, Hostname is required")
+	}
+	u := &url.URL{
+		Scheme: "https",
+		Host:   c.cxProfile.Hostname,
+		Path:   path,
+	}
+	httpReq, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return statusCode, nil, err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	c.setAuthentication(httpReq)
+	httpReq.Header.Set("X-Dot-Client-App", c.tag)
+	ctx, cancel := c.requestContext()
+	defer cancel()
+	httpReq = httpReq.WithContext(ctx)
+	tflog.Debug(c.ctx, fmt.Sprintf("sending: POST %s", u.String()), map[string]any{"body": redactXML(body)})
+	tflog.Trace(c.ctx, fmt.Sprintf("request headers: POST %s", u.String()), map[string]any{"headers": redactHeaders(httpReq.Header)})
+	httpRes, err := c.httpClient.Do(httpReq)
+	if httpRes != nil {
+		statusCode = httpRes.StatusCode
+	}
+	if err != nil {
+		tflog.Error(c.ctx, fmt.Sprintf("HTTP request failed: %s, statusCode: %d", err, statusCode))
+		return statusCode, nil, err
+	}
+	defer httpRes.Body.Close()
+	resBody, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		tflog.Error(c.ctx, fmt.Sprintf("HTTP response read failed: %s, statusCode: %d", err, statusCode))
+		return statusCode, nil, err
+	}
+	tflog.Debug(c.ctx, fmt.Sprintf("received: POST %s %d", u.String(), statusCode), map[string]any{"res": redactXML(resBody)})
+	tflog.Trace(c.ctx, fmt.Sprintf("response headers: POST %s %d", u.String(), statusCode), map[string]any{"headers": redactHeaders(httpRes.Header)})
+	return statusCode, resBody, nil
 }
 
 // NewClient creates a new HTTP client
@@ -80,10 +170,111 @@ func NewClient(ctx context.Context, cxProfile HTTPProfile, tag string) HTTPClien
 	return client
 }
 
+// setAuthentication adds the credentials of the profile to a request
+// With certificate authentication, the user is identified by the client certificate during the TLS handshake
+func (c *HTTPClient) setAuthentication(req *http.Request) {
+	if c.cxProfile.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cxProfile.AccessToken)
+		return
+	}
+	if c.cxProfile.ClientCertificate == "" {
+		req.SetBasicAuth(c.cxProfile.Username, c.cxProfile.Password)
+	}
+}
+
+// LoadClientCertificate parses the client certificate and private key of a profile
+// It returns nil if the profile does not use certificate authentication
+func LoadClientCertificate(cxProfile HTTPProfile) (*tls.Certificate, error) {
+	if cxProfile.ClientCertificate == "" && cxProfile.ClientPrivateKey == "" {
+		return nil, nil
+	}
+	certificate, err := tls.X509KeyPair([]byte(cxProfile.ClientCertificate), []byte(cxProfile.ClientPrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate or private key: %s", err)
+	}
+	return &certificate, nil
+}
+
+// LoadCACertificates returns the system roots with the CA certificates of a profile added
+// It returns nil if the profile does not define CA certificates
+func LoadCACertificates(cxProfile HTTPProfile) (*x509.CertPool, error) {
+	if cxProfile.CACertificates == "" {
+		return nil, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(cxProfile.CACertificates)) {
+		return nil, errors.New("invalid CA certificates, no PEM encoded certificate found")
+	}
+	return pool, nil
+}
+
+// verifyChainOnly returns a VerifyPeerCertificate function checking the server certificate chain against roots, ignoring the hostname
+func verifyChainOnly(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no server certificate received")
+		}
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return fmt.Errorf("failed to parse server certificate: %s", err)
+			}
+			certs = append(certs, cert)
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+		return err
+	}
+}
+
+// tlsConfig returns the TLS settings for this profile
+func (c HTTPClient) tlsConfig(certificate *tls.Certificate, roots *x509.CertPool) *tls.Config {
+	config := &tls.Config{InsecureSkipVerify: !c.cxProfile.ValidateCerts}
+	if certificate != nil {
+		config.Certificates = []tls.Certificate{*certificate}
+	}
+	if !c.cxProfile.ValidateCerts {
+		return config
+	}
+	config.RootCAs = roots
+	if c.cxProfile.SkipHostnameVerification {
+		// the standard verification always checks the hostname, so the chain is verified separately
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = verifyChainOnly(roots)
+	}
+	return config
+}
+
 // create configures and creates the http client
+// The TLS settings are specific to this profile, so each client gets its own transport and the shared default transport is never changed
 func (c HTTPClient) create() http.Client {
-	if !c.cxProfile.ValidateCerts {
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	client := http.Client{Timeout: defaultReadTimeout}
+	if c.cxProfile.ReadTimeout > 0 {
+		client.Timeout = time.Duration(c.cxProfile.ReadTimeout) * time.Second
+	}
+	certificate, err := LoadClientCertificate(c.cxProfile)
+	if err != nil {
+		tflog.Error(c.ctx, err.Error())
 	}
-	return http.Client{Timeout: 120 * time.Second}
+	roots, err := LoadCACertificates(c.cxProfile)
+	if err != nil {
+		tflog.Error(c.ctx, err.Error())
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = c.tlsConfig(certificate, roots)
+	if c.cxProfile.ConnectTimeout > 0 {
+		connectTimeout := time.Duration(c.cxProfile.ConnectTimeout) * time.Second
+		dialer := &net.Dialer{Timeout: connectTimeout, KeepAlive: 30 * time.Second}
+		transport.DialContext = dialer.DialContext
+		transport.TLSHandshakeTimeout = connectTimeout
+	}
+	client.Transport = transport
+	return client
 }
@@ -2,10 +2,21 @@ package httpclient
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestHTTPClient_Do(t *testing.T) {
@@ -95,3 +106,194 @@ func TestHTTPClient_Do(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClientValidateCertsPerProfile(t *testing.T) {
+	// the test server uses a self-signed certificate
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	hostname := strings.TrimPrefix(server.URL, "https://")
+	request := Request{Method: "GET", Body: map[string]any{}, Query: map[string][]string{}}
+
+	// a profile skipping validation must not change the behavior of another profile
+	insecure := NewClient(context.Background(), HTTPProfile{APIRoot: "api", Hostname: hostname, ValidateCerts: false}, "test")
+	secure := NewClient(context.Background(), HTTPProfile{APIRoot: "api", Hostname: hostname, ValidateCerts: true}, "test")
+
+	statusCode, _, err := insecure.Do("cluster", &request)
+	if err != nil || statusCode != 200 {
+		t.Errorf("insecure client: statusCode = %d, err = %v, want 200 and no error", statusCode, err)
+	}
+	_, _, err = secure.Do("cluster", &request)
+	if err == nil {
+		t.Errorf("secure client: expected a certificate error with a self-signed server")
+	}
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && config.InsecureSkipVerify {
+		t.Errorf("the default transport skips certificate verification")
+	}
+}
+
+func TestHTTPClientCanceledContext(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	hostname := strings.TrimPrefix(server.URL, "https://")
+	request := Request{Method: "GET", Body: map[string]any{}, Query: map[string][]string{}}
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+	}{
+		{name: "no_deadline"},
+		{name: "deadline", deadline: time.Now().Add(time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the Terraform operation is canceled, eg with Ctrl-C
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			c := NewClient(ctx, HTTPProfile{APIRoot: "api", Hostname: hostname, ValidateCerts: false, Deadline: tt.deadline}, "test")
+			if _, _, err := c.Do("cluster", &request); !errors.Is(err, context.Canceled) {
+				t.Errorf("Do() error = %v, want context.Canceled", err)
+			}
+			if _, _, err := c.DoRaw("servlets/netapp.servlets.admin.XMLrequest_filer", "text/xml", []byte("<netapp/>")); !errors.Is(err, context.Canceled) {
+				t.Errorf("DoRaw() error = %v, want context.Canceled", err)
+			}
+		})
+	}
+}
+
+// newTestCertificate creates a certificate signed by parent, or self-signed if parent is nil
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.NotBefore = time.Now()
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key, der
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	_, key, der := newTestCertificate(t, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "admin"}}, nil, nil)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+
+	tests := []struct {
+		name     string
+		profile  HTTPProfile
+		wantCert bool
+		wantErr  bool
+	}{
+		{name: "no certificate", profile: HTTPProfile{Username: "admin", Password: "netapp1!"}, wantCert: false, wantErr: false},
+		{name: "valid certificate", profile: HTTPProfile{ClientCertificate: certPEM, ClientPrivateKey: keyPEM}, wantCert: true, wantErr: false},
+		{name: "missing key", profile: HTTPProfile{ClientCertificate: certPEM}, wantCert: false, wantErr: true},
+		{name: "invalid pem", profile: HTTPProfile{ClientCertificate: "cert", ClientPrivateKey: "key"}, wantCert: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadClientCertificate(tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadClientCertificate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got != nil) != tt.wantCert {
+				t.Errorf("LoadClientCertificate() = %v, wantCert %v", got, tt.wantCert)
+			}
+		})
+	}
+}
+
+func TestSetAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile HTTPProfile
+		want    string
+	}{
+		{name: "basic", profile: HTTPProfile{Username: "admin", Password: "netapp1!"}, want: "Basic YWRtaW46bmV0YXBwMSE="},
+		{name: "certificate", profile: HTTPProfile{ClientCertificate: "cert", ClientPrivateKey: "key"}, want: ""},
+		{name: "bearer", profile: HTTPProfile{AccessToken: "token"}, want: "Bearer token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &HTTPClient{cxProfile: tt.profile}
+			req, err := http.NewRequest("GET", "https://host/api/cluster", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.setAuthentication(req)
+			if got := req.Header.Get("Authorization"); got != tt.want {
+				t.Errorf("setAuthentication() Authorization = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadCACertificates(t *testing.T) {
+	_, _, der := newTestCertificate(t, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true}, nil, nil)
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	tests := []struct {
+		name     string
+		profile  HTTPProfile
+		wantPool bool
+		wantErr  bool
+	}{
+		{name: "no ca", profile: HTTPProfile{}, wantPool: false, wantErr: false},
+		{name: "valid ca", profile: HTTPProfile{CACertificates: caPEM}, wantPool: true, wantErr: false},
+		{name: "invalid pem", profile: HTTPProfile{CACertificates: "ca"}, wantPool: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadCACertificates(tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadCACertificates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got != nil) != tt.wantPool {
+				t.Errorf("LoadCACertificates() = %v, wantPool %v", got, tt.wantPool)
+			}
+		})
+	}
+}
+
+func TestVerifyChainOnly(t *testing.T) {
+	ca, caKey, _ := newTestCertificate(t, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil, nil)
+	_, _, serverDer := newTestCertificate(t, &x509.Certificate{SerialNumber: big.NewInt(2), Subject: pkix.Name{CommonName: "cluster1"}, DNSNames: []string{"cluster1"}}, ca, caKey)
+	_, _, otherDer := newTestCertificate(t, &x509.Certificate{SerialNumber: big.NewInt(3), Subject: pkix.Name{CommonName: "cluster2"}}, nil, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	tests := []struct {
+		name     string
+		rawCerts [][]byte
+		wantErr  bool
+	}{
+		{name: "signed by ca", rawCerts: [][]byte{serverDer}, wantErr: false},
+		{name: "not signed by ca", rawCerts: [][]byte{otherDer}, wantErr: true},
+		{name: "no certificate", rawCerts: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the hostname is not checked, cluster1 is only in the certificate
+			err := verifyChainOnly(roots)(tt.rawCerts, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyChainOnly() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
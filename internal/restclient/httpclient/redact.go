@@ -0,0 +1,98 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces secrets in logs
+const redacted = "********"
+
+// sensitiveHeaders are never logged
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveXMLRegexp matches ZAPI elements holding secrets, eg <password>...</password>
+var sensitiveXMLRegexp = regexp.MustCompile(`<([\w-]*(?:password|passphrase|secret|private-key|token)[\w-]*)>[^<]*<`)
+
+// isSensitiveKey reports whether a JSON attribute holds a secret, eg password, private_key, authentication_key, or an SNMP community
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range []string{"password", "passphrase", "secret", "token", "community"} {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return key == "key" || strings.HasSuffix(key, "_key")
+}
+
+// redactValue returns a copy of a JSON value, with the values of sensitive attributes replaced, at any depth
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			if isSensitiveKey(key) {
+				copied[key] = redacted
+			} else {
+				copied[key] = redactValue(item)
+			}
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(typed))
+		for index, item := range typed {
+			copied[index] = redactValue(item)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+// redactJSON returns a JSON body as a string for logging, with the values of sensitive attributes replaced
+func redactJSON(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		// not JSON, eg an HTML error page
+		return string(body)
+	}
+	redactedBody, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(redactedBody)
+}
+
+// redactRequestBody returns a request body as a string for logging, with the values of sensitive attributes replaced
+func redactRequestBody(body map[string]interface{}) string {
+	if len(body) == 0 {
+		return ""
+	}
+	// the body may hold typed maps and slices, encoding normalizes them
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return "unable to encode body for logging: " + err.Error()
+	}
+	return redactJSON(bodyJSON)
+}
+
+// redactXML returns a ZAPI body as a string for logging, with the values of sensitive elements replaced
+func redactXML(body []byte) string {
+	return sensitiveXMLRegexp.ReplaceAllString(string(body), "<$1>"+redacted+"<")
+}
+
+// redactHeaders returns HTTP headers for logging, with credentials replaced
+func redactHeaders(headers http.Header) map[string]string {
+	redactedHeaders := make(map[string]string, len(headers))
+	for key := range headers {
+		redactedHeaders[key] = headers.Get(key)
+	}
+	for _, key := range sensitiveHeaders {
+		if headers.Get(key) != "" {
+			redactedHeaders[http.CanonicalHeaderKey(key)] = redacted
+		}
+	}
+	return redactedHeaders
+}
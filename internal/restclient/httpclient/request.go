@@ -14,6 +14,8 @@ type Request struct {
 	Method string                 `json:"method"`
 	Body   map[string]interface{} `json:"body"`
 	Query  url.Values             `json:"query"`
+	// optional headers, eg If-Match
+	Headers map[string]string `json:"headers"`
 	// uuid   string
 }
 
@@ -40,9 +42,12 @@ func (r *Request) BuildHTTPReq(c *HTTPClient, baseURL string) (*http.Request, er
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(c.cxProfile.Username, c.cxProfile.Password)
+	c.setAuthentication(req)
 	// telemetry header
 	req.Header.Set("X-Dot-Client-App", c.tag)
+	for key, value := range r.Headers {
+		req.Header.Set(key, value)
+	}
 	// TODO: low pty: add support for form data (require to create a file)
 
 	return req, err
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,13 +16,25 @@ import (
 
 // ConnectionProfile describes out to reach a cluster or svm
 type ConnectionProfile struct {
-	// TODO: add certs in addition to basic authentication
-	// TODO: Add Timeout (currently hardcoded to 10 seconds)
 	Hostname              string
 	Username              string
 	Password              string
 	ValidateCerts         bool
 	MaxConcurrentRequests int
+	// PEM encoded, used for mutual TLS authentication instead of Username and Password
+	ClientCertificate string
+	ClientPrivateKey  string
+	// OAuth 2.0 access token, sent as a bearer token instead of Username and Password
+	AccessToken string
+	// PEM encoded CA certificates to trust, and whether to only verify the certificate chain, not the hostname
+	CACertificates           string
+	SkipHostnameVerification bool
+	// Amazon FSx for NetApp ONTAP, Hostname is the management endpoint of the file system or SVM
+	FSx bool
+	// in seconds, 0 for the defaults. OperationDeadline bounds a whole operation, including retries and job polling
+	ConnectTimeout    int
+	ReadTimeout       int
+	OperationDeadline int
 }
 
 // RestClient to interact with the ONTAP REST API
@@ -30,11 +43,96 @@ type RestClient struct {
 	ctx                   context.Context
 	maxConcurrentRequests int
 	httpClient            httpclient.HTTPClient
-	requestSlots          chan int
+	requestSlots          RequestSlots
 	mode                  string
 	responses             []MockResponse
 	jobCompletionTimeOut  int
 	tag                   string
+	etags                 *etagCache
+	clusterCache          *ClusterCache
+	deadline              time.Time
+}
+
+// ErrConcurrentModification is reported when ONTAP rejects a PATCH because the ETag sent with If-Match no longer matches,
+// indicating the object was modified out of band since it was last read.
+var ErrConcurrentModification = errors.New("object was modified outside of Terraform since it was last read")
+
+// ErrOperationDeadlineExceeded is reported when the operation_deadline of the connection profile is reached.
+var ErrOperationDeadlineExceeded = errors.New("operation deadline exceeded")
+
+// etagCache records the last ETag returned by a GET on the API path of an object, eg storage/volumes/<uuid>.
+// GETs on a collection, eg storage/volumes?name=vol1, are not recorded, as the ETag does not apply to the record.
+// RestClient is passed by value, so the cache is shared through a pointer.
+// A client is created for each Terraform operation, so resources carry the ETag from Read to Update, see ETag and SetETags.
+type etagCache struct {
+	mutex sync.Mutex
+	tags  map[string]string
+}
+
+func (e *etagCache) get(baseURL string) string {
+	if e == nil {
+		return ""
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.tags[strings.Trim(baseURL, "/")]
+}
+
+func (e *etagCache) set(baseURL string, etag string) {
+	if e == nil {
+		return
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if etag == "" {
+		delete(e.tags, strings.Trim(baseURL, "/"))
+		return
+	}
+	e.tags[strings.Trim(baseURL, "/")] = etag
+}
+
+// ETag returns the ETag recorded by the last GET on the object path, eg storage/volumes/<uuid>, or "" if there is none
+func (r *RestClient) ETag(path string) string {
+	return r.etags.get(path)
+}
+
+// SetETags records ETags read by another client, so that a PATCH on one of these objects is only applied if the object is unchanged
+func (r *RestClient) SetETags(tags map[string]string) {
+	for path, etag := range tags {
+		r.etags.set(path, etag)
+	}
+}
+
+// ClusterCache records the GET cluster record for a connection profile.
+// A client is created for each Terraform operation, so the provider shares the cache with all clients for the same profile.
+type ClusterCache struct {
+	mutex  sync.Mutex
+	record map[string]interface{}
+}
+
+// SetClusterCache sets the cache used by GetCachedClusterRecord and SetCachedClusterRecord
+func (r *RestClient) SetClusterCache(cache *ClusterCache) {
+	r.clusterCache = cache
+}
+
+// GetCachedClusterRecord returns the cached GET cluster record, or nil if it was not read yet or no cache is set
+func (r *RestClient) GetCachedClusterRecord() map[string]interface{} {
+	if r.clusterCache == nil {
+		return nil
+	}
+	r.clusterCache.mutex.Lock()
+	defer r.clusterCache.mutex.Unlock()
+	return r.clusterCache.record
+}
+
+// SetCachedClusterRecord records the GET cluster record, if a cache is set
+func (r *RestClient) SetCachedClusterRecord(record map[string]interface{}) {
+	if r.clusterCache == nil {
+		return
+	}
+	r.clusterCache.mutex.Lock()
+	defer r.clusterCache.mutex.Unlock()
+	r.clusterCache.record = record
 }
 
 // CallCreateMethod returns response from POST results.  An error is reported if an error is received.
@@ -73,7 +171,7 @@ func (r *RestClient) CallUpdateMethod(baseURL string, query *RestQuery, body map
 	}
 	// TODO: make this a connection parameter ?
 	query.Set("return_timeout", "60")
-	statusCode, response, err := r.callAPIMethod("PATCH", baseURL, query, body)
+	statusCode, response, err := r.callAPIMethodWithIfMatch("PATCH", baseURL, query, body)
 	if err != nil {
 		tflog.Debug(r.ctx, fmt.Sprintf("CallUpdateMethod request failed %#v", statusCode))
 		return statusCode, RestResponse{}, err
@@ -112,6 +210,22 @@ func (r *RestClient) CallDeleteMethod(baseURL string, query *RestQuery, body map
 	return statusCode, response, err
 }
 
+// CallAsyncMethod returns response from POST, PATCH, or DELETE results as soon as ONTAP accepts the request.
+// Unlike CallCreateMethod or CallUpdateMethod, it does not wait for the jobs in the response to complete,
+// the caller is responsible for polling them, see interfaces.WaitForJobs.
+func (r *RestClient) CallAsyncMethod(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	if query == nil {
+		query = r.NewQuery()
+	}
+	query.Set("return_timeout", "0")
+	statusCode, response, err := r.callAPIMethodWithIfMatch(method, baseURL, query, body)
+	if err != nil {
+		tflog.Debug(r.ctx, fmt.Sprintf("CallAsyncMethod %s request failed %#v", method, statusCode))
+		return statusCode, RestResponse{}, err
+	}
+	return statusCode, response, err
+}
+
 // GetNilOrOneRecord returns nil if no record is found or a single record.  An error is reported if multiple records are received.
 func (r *RestClient) GetNilOrOneRecord(baseURL string, query *RestQuery, body map[string]interface{}) (int, map[string]interface{}, error) {
 	statusCode, response, err := r.callAPIMethod("GET", baseURL, query, body)
@@ -124,22 +238,92 @@ func (r *RestClient) GetNilOrOneRecord(baseURL string, query *RestQuery, body ma
 		return statusCode, nil, errors.New(msg)
 	}
 	if response.NumRecords == 1 {
+		if !response.Collection {
+			r.etags.set(baseURL, response.ETag)
+		}
 		return statusCode, response.Records[0], err
 	}
 	return statusCode, nil, err
 }
 
 // GetZeroOrMoreRecords returns a list of records.
+// When ONTAP returns the records in several pages, because of max_records or a timeout, all pages are read.
 func (r *RestClient) GetZeroOrMoreRecords(baseURL string, query *RestQuery, body map[string]interface{}) (int, []map[string]interface{}, error) {
 	statusCode, response, err := r.callAPIMethod("GET", baseURL, query, body)
 	if err != nil {
 		return statusCode, nil, err
 	}
-	return statusCode, response.Records, err
+	records := response.Records
+	visited := map[string]bool{}
+	for response.NextLink != "" {
+		if visited[response.NextLink] {
+			msg := fmt.Sprintf("pagination loop on GET %s, next link %s was already read", baseURL, response.NextLink)
+			tflog.Error(r.ctx, msg)
+			return statusCode, nil, errors.New(msg)
+		}
+		visited[response.NextLink] = true
+		nextURL, nextQuery, err := r.parseNextLink(response.NextLink)
+		if err != nil {
+			return statusCode, nil, err
+		}
+		tflog.Debug(r.ctx, fmt.Sprintf("GET %s returned %d records, reading next page", baseURL, len(records)))
+		statusCode, response, err = r.callAPIMethod("GET", nextURL, nextQuery, body)
+		if err != nil {
+			return statusCode, nil, err
+		}
+		records = append(records, response.Records...)
+	}
+	return statusCode, records, err
+}
+
+// parseNextLink splits a _links.next href, eg /api/storage/volumes?start.uuid=...&max_records=100, into a path relative to /api and a query
+func (r *RestClient) parseNextLink(href string) (string, *RestQuery, error) {
+	nextURL, err := url.Parse(href)
+	if err != nil {
+		msg := fmt.Sprintf("unable to parse next link %s: %s", href, err)
+		tflog.Error(r.ctx, msg)
+		return "", nil, errors.New(msg)
+	}
+	query := r.NewQuery()
+	query.Values = nextURL.Query()
+	return strings.TrimPrefix(strings.TrimPrefix(nextURL.Path, "/"), "api/"), query, nil
 }
 
 // callAPIMethod can be used to make a request to any REST API method, receiving response as bytes
 func (r *RestClient) callAPIMethod(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	return r.callAPIMethodWithHeaders(method, baseURL, query, body, nil)
+}
+
+// callAPIMethodWithIfMatch is identical to callAPIMethod, with the If-Match header on PATCH.
+// When ONTAP provided an ETag on the last GET, the change is only applied if the object is unchanged.
+func (r *RestClient) callAPIMethodWithIfMatch(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	var headers map[string]string
+	if method == "PATCH" {
+		if etag := r.etags.get(baseURL); etag != "" {
+			headers = map[string]string{"If-Match": etag}
+		}
+	}
+	statusCode, response, err := r.callAPIMethodWithHeaders(method, baseURL, query, body, headers)
+	// the object is changing, the ETag is stale whether or not the request succeeded
+	if method != "GET" {
+		r.etags.set(baseURL, "")
+	}
+	if statusCode == 412 && headers != nil {
+		err = fmt.Errorf("%w: %s %s rejected with If-Match %s, statusCode %d, err: %v", ErrConcurrentModification, method, baseURL, headers["If-Match"], statusCode, err)
+	}
+	return statusCode, response, err
+}
+
+// callAPIMethodWithHeaders is identical to callAPIMethod, with additional request headers
+func (r *RestClient) callAPIMethodWithHeaders(method string, baseURL string, query *RestQuery, body map[string]interface{}, headers map[string]string) (int, RestResponse, error) {
+	if err := r.checkFSxSupport(method, baseURL); err != nil {
+		tflog.Error(r.ctx, err.Error())
+		return 0, RestResponse{}, err
+	}
+	if err := r.checkDeadline(); err != nil {
+		tflog.Error(r.ctx, err.Error())
+		return 0, RestResponse{}, err
+	}
 	if r.mode == "mock" {
 		return r.mockCallAPIMethod(method, baseURL, query, body)
 	}
@@ -150,15 +334,20 @@ func (r *RestClient) callAPIMethod(method string, baseURL string, query *RestQue
 	if query != nil {
 		values = query.Values
 	}
-	statusCode, response, httpClientErr := r.httpClient.Do(baseURL, &httpclient.Request{
-		Method: method,
-		Body:   body,
-		Query:  values,
+	statusCode, response, responseHeaders, httpClientErr := r.httpClient.DoWithHeaders(baseURL, &httpclient.Request{
+		Method:  method,
+		Body:    body,
+		Query:   values,
+		Headers: headers,
 	})
 
 	// TODO: error handling for HTTTP status code >=300
 	// TODO: handle async calls (job in response)
-	return r.unmarshalResponse(statusCode, response, httpClientErr)
+	statusCode, restResponse, err := r.unmarshalResponse(statusCode, response, httpClientErr)
+	if responseHeaders != nil {
+		restResponse.ETag = responseHeaders.Get("ETag")
+	}
+	return statusCode, restResponse, err
 }
 
 // NewClient creates a new REST client and a supporting HTTP client
@@ -166,28 +355,67 @@ func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, job
 	var httpProfile httpclient.HTTPProfile
 	err := mapstructure.Decode(cxProfile, &httpProfile)
 	if err != nil {
-		msg := fmt.Sprintf("decode error on ConnectionProfile %#v to HTTPProfile", cxProfile)
+		msg := fmt.Sprintf("decode error on ConnectionProfile for %s to HTTPProfile: %s", cxProfile.Hostname, err)
 		tflog.Error(ctx, msg)
 		return nil, errors.New(msg)
 	}
 	httpProfile.APIRoot = "api"
-	maxConcurrentRequests := cxProfile.MaxConcurrentRequests
-	if maxConcurrentRequests == 0 {
-		maxConcurrentRequests = 6
+	// a client is created for each Terraform operation, so the deadline starts now
+	var deadline time.Time
+	if cxProfile.OperationDeadline > 0 {
+		deadline = time.Now().Add(time.Duration(cxProfile.OperationDeadline) * time.Second)
+		httpProfile.Deadline = deadline
 	}
+	if _, err := httpclient.LoadClientCertificate(httpProfile); err != nil {
+		tflog.Error(ctx, err.Error())
+		return nil, err
+	}
+	if _, err := httpclient.LoadCACertificates(httpProfile); err != nil {
+		tflog.Error(ctx, err.Error())
+		return nil, err
+	}
+	requestSlots := NewRequestSlots(cxProfile.MaxConcurrentRequests)
 	client := RestClient{
 		connectionProfile:     cxProfile,
 		ctx:                   ctx,
 		httpClient:            httpclient.NewClient(ctx, httpProfile, tag),
-		maxConcurrentRequests: maxConcurrentRequests,
+		maxConcurrentRequests: cap(requestSlots),
 		mode:                  "prod",
-		requestSlots:          make(chan int, maxConcurrentRequests),
+		requestSlots:          requestSlots,
 		jobCompletionTimeOut:  jobCompletionTimeOut,
 		tag:                   tag,
+		etags:                 &etagCache{tags: map[string]string{}},
+		deadline:              deadline,
 	}
 	return &client, nil
 }
 
+// checkDeadline returns an error wrapping ErrOperationDeadlineExceeded if the operation deadline is reached
+func (r *RestClient) checkDeadline() error {
+	if r.deadline.IsZero() || time.Now().Before(r.deadline) {
+		return nil
+	}
+	return fmt.Errorf("%w: %d seconds for %s", ErrOperationDeadlineExceeded, r.connectionProfile.OperationDeadline, r.connectionProfile.Hostname)
+}
+
+// RequestSlots limits the number of requests sent at the same time.
+// A client is created for each Terraform operation, so the provider shares the slots with all clients for the same connection profile.
+type RequestSlots chan int
+
+// NewRequestSlots returns request slots for maxConcurrentRequests requests, or the default of 6 if maxConcurrentRequests is 0
+func NewRequestSlots(maxConcurrentRequests int) RequestSlots {
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = 6
+	}
+	return make(RequestSlots, maxConcurrentRequests)
+}
+
+// SetRequestSlots replaces the request slots of the client, to share them with other clients
+func (r *RestClient) SetRequestSlots(slots RequestSlots) {
+	r.requestSlots = slots
+	r.maxConcurrentRequests = cap(slots)
+}
+
 func (r *RestClient) waitForAvailableSlot() {
 	r.requestSlots <- 1
 }
@@ -231,7 +459,7 @@ func (r *RestClient) Wait(uuid string) (int, RestResponse, error) {
 	for timeRemaining > 0 {
 		statusCode, response, err := r.GetNilOrOneRecord("cluster/jobs/"+uuid, nil, nil)
 		if err != nil {
-			if errorRetries <= 0 {
+			if errorRetries <= 0 || errors.Is(err, ErrOperationDeadlineExceeded) {
 				return statusCode, RestResponse{}, err
 			}
 			time.Sleep(10 * time.Second)
@@ -0,0 +1,46 @@
+package restclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotSupportedOnFSx is reported when a request targets an API that Amazon FSx for NetApp ONTAP does not expose.
+// FSx manages the underlying cluster, so storage and node level APIs are either read-only or not available to fsxadmin.
+var ErrNotSupportedOnFSx = errors.New("not supported with Amazon FSx for NetApp ONTAP")
+
+// fsxRestrictedAPIs lists the API paths that are managed by AWS on FSx.
+// The value indicates whether GET is still allowed, only changes are rejected in that case.
+var fsxRestrictedAPIs = map[string]bool{
+	"storage/aggregates":           true,
+	"storage/disks":                false,
+	"storage/pools":                false,
+	"storage/shelves":              false,
+	"cluster/nodes":                true,
+	"cluster/licensing":            true,
+	"cluster/metrocluster":         false,
+	"cluster/ntp":                  true,
+	"network/ethernet":             true,
+	"network/fc":                   true,
+	"support/autosupport":          true,
+	"support/configuration-backup": false,
+}
+
+// checkFSxSupport returns an error wrapping ErrNotSupportedOnFSx if the profile is for FSx and the API is restricted
+func (r *RestClient) checkFSxSupport(method string, baseURL string) error {
+	if !r.connectionProfile.FSx {
+		return nil
+	}
+	path := strings.Trim(baseURL, "/")
+	for prefix, readAllowed := range fsxRestrictedAPIs {
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		if readAllowed && method == "GET" {
+			return nil
+		}
+		return fmt.Errorf("%w: %s %s is managed by AWS, use the FSx console or API instead", ErrNotSupportedOnFSx, method, path)
+	}
+	return nil
+}
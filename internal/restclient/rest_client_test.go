@@ -1,8 +1,11 @@
 package restclient
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestRestClient_GetNilOrOneRecord(t *testing.T) {
@@ -69,3 +72,317 @@ func TestRestClient_GetNilOrOneRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestRestClient_GetZeroOrMoreRecordsPagination(t *testing.T) {
+	record1 := map[string]any{"name": "vol1"}
+	record2 := map[string]any{"name": "vol2"}
+	record3 := map[string]any{"name": "vol3"}
+	nextLink := "/api/storage/volumes?max_records=2&start.uuid=5678"
+	firstPage := RestResponse{NumRecords: 2, Records: []map[string]any{record1, record2}, NextLink: nextLink}
+	lastPage := RestResponse{NumRecords: 1, Records: []map[string]any{record3}}
+
+	responses := map[string][]MockResponse{
+		"test_one_page": {
+			{"GET", "storage/volumes", 200, lastPage, nil},
+		},
+		"test_two_pages": {
+			{"GET", "storage/volumes", 200, firstPage, nil},
+			{"GET", "storage/volumes", 200, lastPage, nil},
+		},
+		"test_error_on_next_page": {
+			{"GET", "storage/volumes", 200, firstPage, nil},
+			{"GET", "storage/volumes", 500, RestResponse{}, errors.New("statusCode indicates error, without details: 500")},
+		},
+		"test_loop": {
+			{"GET", "storage/volumes", 200, firstPage, nil},
+			{"GET", "storage/volumes", 200, firstPage, nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []MockResponse
+		want      []map[string]any
+		wantErr   bool
+	}{
+		{name: "test_one_page", responses: responses["test_one_page"], want: []map[string]any{record3}, wantErr: false},
+		{name: "test_two_pages", responses: responses["test_two_pages"], want: []map[string]any{record1, record2, record3}, wantErr: false},
+		{name: "test_error_on_next_page", responses: responses["test_error_on_next_page"], want: nil, wantErr: true},
+		{name: "test_loop", responses: responses["test_loop"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			query := c.NewQuery()
+			query.Set("max_records", "2")
+			_, got, err := c.GetZeroOrMoreRecords("storage/volumes", query, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.GetZeroOrMoreRecords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestClient.GetZeroOrMoreRecords() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestClient_parseNextLink(t *testing.T) {
+	c, err := NewMockedRestClient(nil)
+	if err != nil {
+		panic(err)
+	}
+	baseURL, query, err := c.parseNextLink("/api/storage/volumes?fields=name%2Cuuid&max_records=2&start.uuid=5678")
+	if err != nil {
+		t.Fatalf("RestClient.parseNextLink() unexpected error = %v", err)
+	}
+	if baseURL != "storage/volumes" {
+		t.Errorf("RestClient.parseNextLink() baseURL = %s, want storage/volumes", baseURL)
+	}
+	if query.Get("fields") != "name,uuid" || query.Get("max_records") != "2" || query.Get("start.uuid") != "5678" {
+		t.Errorf("RestClient.parseNextLink() query = %v", query.Values)
+	}
+}
+
+func TestRestClient_CallUpdateMethodETag(t *testing.T) {
+	record := map[string]any{
+		"option": "value",
+	}
+	oneRecordWithETag := RestResponse{NumRecords: 1, Records: []map[string]any{record}, ETag: "\"1234\""}
+	preconditionFailed := errors.New("statusCode indicates error, without details: 412")
+
+	responses := map[string][]MockResponse{
+		"test_etag_match": {
+			{"GET", "cluster", 200, oneRecordWithETag, nil},
+			{"PATCH", "cluster", 200, RestResponse{}, nil},
+		},
+		"test_etag_mismatch": {
+			{"GET", "cluster", 200, oneRecordWithETag, nil},
+			{"PATCH", "cluster", 412, RestResponse{}, preconditionFailed},
+		},
+		"test_no_etag": {
+			{"GET", "cluster", 200, RestResponse{NumRecords: 1, Records: []map[string]any{record}}, nil},
+			{"PATCH", "cluster", 412, RestResponse{}, preconditionFailed},
+		},
+	}
+	tests := []struct {
+		name         string
+		responses    []MockResponse
+		wantConflict bool
+		wantErr      bool
+	}{
+		{name: "test_etag_match", responses: responses["test_etag_match"], wantConflict: false, wantErr: false},
+		{name: "test_etag_mismatch", responses: responses["test_etag_mismatch"], wantConflict: true, wantErr: true},
+		{name: "test_no_etag", responses: responses["test_no_etag"], wantConflict: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			if _, _, err := c.GetNilOrOneRecord("cluster", nil, nil); err != nil {
+				t.Errorf("RestClient.GetNilOrOneRecord() unexpected error = %v", err)
+				return
+			}
+			_, _, err = c.CallUpdateMethod("cluster", nil, map[string]any{"option": "new"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.CallUpdateMethod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrConcurrentModification) != tt.wantConflict {
+				t.Errorf("RestClient.CallUpdateMethod() error = %v, wantConflict %v", err, tt.wantConflict)
+			}
+			if etag := c.etags.get("cluster"); etag != "" {
+				t.Errorf("RestClient.CallUpdateMethod() expected ETag to be cleared, got %s", etag)
+			}
+		})
+	}
+}
+
+func TestRestClient_ETagsAcrossClients(t *testing.T) {
+	record := map[string]any{
+		"name": "vol1",
+		"uuid": "1234",
+	}
+	oneRecordWithETag := RestResponse{NumRecords: 1, Records: []map[string]any{record}, ETag: "\"1\""}
+	collectionWithETag := RestResponse{NumRecords: 1, Records: []map[string]any{record}, ETag: "\"2\"", Collection: true}
+	preconditionFailed := errors.New("statusCode indicates error, without details: 412")
+
+	// Read and Update use different clients, the ETag is carried in the resource private state
+	reader, err := NewMockedRestClient([]MockResponse{
+		{"GET", "storage/volumes", 200, collectionWithETag, nil},
+		{"GET", "storage/volumes/1234", 200, oneRecordWithETag, nil},
+	})
+	if err != nil {
+		panic(err)
+	}
+	query := reader.NewQuery()
+	query.Set("name", "vol1")
+	if _, _, err := reader.GetNilOrOneRecord("storage/volumes", query, nil); err != nil {
+		t.Fatalf("RestClient.GetNilOrOneRecord() unexpected error = %v", err)
+	}
+	if etag := reader.ETag("storage/volumes/1234"); etag != "" {
+		t.Fatalf("RestClient.ETag() = %s, want no ETag for a GET on the collection", etag)
+	}
+	if etag := reader.ETag("storage/volumes"); etag != "" {
+		t.Fatalf("RestClient.ETag() = %s, want no ETag for a GET on the collection", etag)
+	}
+	if _, _, err := reader.GetNilOrOneRecord("storage/volumes/1234", nil, nil); err != nil {
+		t.Fatalf("RestClient.GetNilOrOneRecord() unexpected error = %v", err)
+	}
+	etag := reader.ETag("storage/volumes/1234")
+	if etag != "\"1\"" {
+		t.Fatalf("RestClient.ETag() = %s, want the ETag for storage/volumes/1234", etag)
+	}
+
+	writer, err := NewMockedRestClient([]MockResponse{
+		{"PATCH", "storage/volumes/1234", 412, RestResponse{}, preconditionFailed},
+	})
+	if err != nil {
+		panic(err)
+	}
+	writer.SetETags(map[string]string{"storage/volumes/1234": etag})
+	_, _, err = writer.CallUpdateMethod("storage/volumes/1234", nil, map[string]any{"comment": "new"})
+	if !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("RestClient.CallUpdateMethod() error = %v, want ErrConcurrentModification", err)
+	}
+	if etag := writer.ETag("storage/volumes/1234"); etag != "" {
+		t.Errorf("RestClient.CallUpdateMethod() expected ETag to be cleared, got %s", etag)
+	}
+}
+
+func TestRestClient_CallAsyncMethodETag(t *testing.T) {
+	preconditionFailed := errors.New("statusCode indicates error, without details: 412")
+	tests := []struct {
+		name         string
+		method       string
+		wantConflict bool
+	}{
+		{name: "test_patch", method: "PATCH", wantConflict: true},
+		{name: "test_post", method: "POST", wantConflict: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient([]MockResponse{
+				{tt.method, "storage/aggregates/1234", 412, RestResponse{}, preconditionFailed},
+			})
+			if err != nil {
+				panic(err)
+			}
+			c.SetETags(map[string]string{"storage/aggregates/1234": "\"1\""})
+			_, _, err = c.CallAsyncMethod(tt.method, "storage/aggregates/1234", nil, map[string]any{"state": "online"})
+			if err == nil {
+				t.Errorf("RestClient.CallAsyncMethod() expected an error")
+			}
+			if errors.Is(err, ErrConcurrentModification) != tt.wantConflict {
+				t.Errorf("RestClient.CallAsyncMethod() error = %v, wantConflict %v", err, tt.wantConflict)
+			}
+			if etag := c.ETag("storage/aggregates/1234"); etag != "" {
+				t.Errorf("RestClient.CallAsyncMethod() expected ETag to be cleared, got %s", etag)
+			}
+		})
+	}
+}
+
+func TestRestClient_FSxRestrictedAPIs(t *testing.T) {
+	record := map[string]any{
+		"option": "value",
+	}
+	oneRecord := RestResponse{NumRecords: 1, Records: []map[string]any{record}}
+	tests := []struct {
+		name      string
+		fsx       bool
+		method    string
+		baseURL   string
+		responses []MockResponse
+		wantErr   bool
+	}{
+		{name: "not_fsx", fsx: false, method: "POST", baseURL: "storage/aggregates", responses: []MockResponse{{"POST", "storage/aggregates", 200, RestResponse{}, nil}}, wantErr: false},
+		{name: "fsx_read_allowed", fsx: true, method: "GET", baseURL: "storage/aggregates", responses: []MockResponse{{"GET", "storage/aggregates", 200, oneRecord, nil}}, wantErr: false},
+		{name: "fsx_create_rejected", fsx: true, method: "POST", baseURL: "storage/aggregates", wantErr: true},
+		{name: "fsx_update_rejected", fsx: true, method: "PATCH", baseURL: "cluster/nodes/1234", wantErr: true},
+		{name: "fsx_read_rejected", fsx: true, method: "GET", baseURL: "storage/disks", wantErr: true},
+		{name: "fsx_supported", fsx: true, method: "POST", baseURL: "storage/volumes", responses: []MockResponse{{"POST", "storage/volumes", 200, RestResponse{}, nil}}, wantErr: false},
+		{name: "fsx_prefix_only", fsx: true, method: "POST", baseURL: "storage/disks-other", responses: []MockResponse{{"POST", "storage/disks-other", 200, RestResponse{}, nil}}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			c.connectionProfile.FSx = tt.fsx
+			_, _, err = c.callAPIMethod(tt.method, tt.baseURL, nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.callAPIMethod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, ErrNotSupportedOnFSx) {
+				t.Errorf("RestClient.callAPIMethod() error = %v, want ErrNotSupportedOnFSx", err)
+			}
+		})
+	}
+}
+
+func TestRestClient_OperationDeadline(t *testing.T) {
+	tests := []struct {
+		name      string
+		deadline  time.Time
+		responses []MockResponse
+		wantErr   bool
+	}{
+		{name: "no_deadline", responses: []MockResponse{{"GET", "cluster", 200, RestResponse{}, nil}}, wantErr: false},
+		{name: "deadline_not_reached", deadline: time.Now().Add(time.Minute), responses: []MockResponse{{"GET", "cluster", 200, RestResponse{}, nil}}, wantErr: false},
+		{name: "deadline_exceeded", deadline: time.Now().Add(-time.Second), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			c.deadline = tt.deadline
+			_, _, err = c.GetNilOrOneRecord("cluster", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.GetNilOrOneRecord() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, ErrOperationDeadlineExceeded) {
+				t.Errorf("RestClient.GetNilOrOneRecord() error = %v, want ErrOperationDeadlineExceeded", err)
+			}
+		})
+	}
+}
+
+func TestRestClient_SetRequestSlots(t *testing.T) {
+	// two profiles for the same host keep their own limit
+	profile1 := NewRequestSlots(2)
+	profile2 := NewRequestSlots(0)
+	c1, err := NewClient(context.Background(), ConnectionProfile{Hostname: "slots-host1", MaxConcurrentRequests: 2}, "resource/version", 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := NewClient(context.Background(), ConnectionProfile{Hostname: "slots-host1", MaxConcurrentRequests: 2}, "resource/version", 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c3, err := NewClient(context.Background(), ConnectionProfile{Hostname: "slots-host1"}, "resource/version", 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1.SetRequestSlots(profile1)
+	c2.SetRequestSlots(profile1)
+	c3.SetRequestSlots(profile2)
+	if c1.requestSlots != c2.requestSlots {
+		t.Errorf("SetRequestSlots() expected clients for the same profile to share request slots")
+	}
+	if c1.requestSlots == c3.requestSlots {
+		t.Errorf("SetRequestSlots() expected clients for different profiles to use different request slots")
+	}
+	if cap(c1.requestSlots) != 2 || cap(c3.requestSlots) != 6 {
+		t.Errorf("SetRequestSlots() got capacities %d and %d, want 2 and 6", cap(c1.requestSlots), cap(c3.requestSlots))
+	}
+}
@@ -15,6 +15,21 @@ type RestError struct {
 	Target  string
 }
 
+// ONTAPError is returned when ONTAP reports an error in the response body
+// Its message starts with "ONTAP error code <code>", which utils.ErrorHandler uses to report actionable diagnostics
+type ONTAPError struct {
+	RestError
+	StatusCode int
+}
+
+func (e *ONTAPError) Error() string {
+	msg := fmt.Sprintf("ONTAP error code %s: %s", e.Code, e.Message)
+	if e.Target != "" {
+		msg += fmt.Sprintf(", target: %s", e.Target)
+	}
+	return fmt.Sprintf("%s, statusCode: %d", msg, e.StatusCode)
+}
+
 // RestResponse to return a list of records (can be empty) and/or errors.
 type RestResponse struct {
 	NumRecords int `mapstructure:"num_records"`
@@ -25,6 +40,12 @@ type RestResponse struct {
 	ErrorType  string
 	Job        map[string]interface{}
 	Jobs       []map[string]interface{}
+	// ETag is set from the HTTP response header, when ONTAP provides one
+	ETag string `mapstructure:"-"`
+	// NextLink is set from _links.next, when ONTAP returns the records in several pages
+	NextLink string `mapstructure:"-"`
+	// Collection is set when the response lists records, rather than returning a single object
+	Collection bool `mapstructure:"-"`
 }
 
 // unmarshalResponse converts the REST response into a structure with a list of 0 or more records.
@@ -94,6 +115,9 @@ func (c *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 		return statusCode, emptyResponse, err
 	}
 
+	finalResponse.NextLink = getNextLink(rawResponse.Other)
+	_, finalResponse.Collection = dataMap["records"]
+
 	// If we reached this point, the only possible errors are a bad HTTP status code and/or a REST error encoded in the paybload
 	finalResponse.StatusCode = statusCode
 	finalResponse, err := c.checkRestErrors(statusCode, finalResponse)
@@ -101,12 +125,27 @@ func (c *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 	return statusCode, finalResponse, err
 }
 
+// getNextLink returns the href of _links.next, or "" if this is the last page
+// {_links:map[next:map[href:/api/storage/volumes?start.uuid=...&max_records=100] self:map[href:/api/storage/volumes?max_records=100]]}
+func getNextLink(other map[string]interface{}) string {
+	links, ok := other["_links"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	next, ok := links["next"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, _ := next["href"].(string)
+	return href
+}
+
 // check for statusCode and RestError
 func (c *RestClient) checkRestErrors(statusCode int, response RestResponse) (RestResponse, error) {
 	var err error
 	if response.RestError.Code != "0" && response.RestError.Code != "" {
 		response.ErrorType = "rest_error"
-		err = fmt.Errorf("REST reported error %#v, statusCode: %d", response.RestError, statusCode)
+		err = &ONTAPError{RestError: response.RestError, StatusCode: statusCode}
 	} else if err = c.checkStatusCode(statusCode); err != nil {
 		response.ErrorType = "statuscode_error"
 	}
@@ -28,7 +28,8 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 		Records: []map[string]any{
 			{"option": "value"},
 		},
-		StatusCode: 200}
+		StatusCode: 200,
+		Collection: true}
 	responseOthers := RestResponse{
 		NumRecords: 1,
 		Records: []map[string]any{
@@ -124,3 +125,48 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestRestClient_unmarshalResponse_NextLink(t *testing.T) {
+	c, err := NewMockedRestClient(nil)
+	if err != nil {
+		panic(err)
+	}
+	responseJSON := []byte(`{"records":[{"name":"vol1"}],"num_records":1,"_links":{"self":{"href":"/api/storage/volumes?max_records=1"},"next":{"href":"/api/storage/volumes?max_records=1&start.uuid=5678"}}}`)
+	_, response, err := c.unmarshalResponse(200, responseJSON, nil)
+	if err != nil {
+		t.Fatalf("RestClient.unmarshalResponse() unexpected error = %v", err)
+	}
+	if response.NextLink != "/api/storage/volumes?max_records=1&start.uuid=5678" {
+		t.Errorf("RestClient.unmarshalResponse() NextLink = %s", response.NextLink)
+	}
+
+	responseJSON = []byte(`{"records":[{"name":"vol1"}],"num_records":1,"_links":{"self":{"href":"/api/storage/volumes"}}}`)
+	_, response, err = c.unmarshalResponse(200, responseJSON, nil)
+	if err != nil {
+		t.Fatalf("RestClient.unmarshalResponse() unexpected error = %v", err)
+	}
+	if response.NextLink != "" {
+		t.Errorf("RestClient.unmarshalResponse() NextLink = %s, want empty", response.NextLink)
+	}
+}
+
+func TestRestClient_checkRestErrors_ONTAPError(t *testing.T) {
+	c := &RestClient{
+		ctx: context.Background(),
+	}
+	restError := RestError{Code: "4", Message: "entry doesn't exist", Target: "uuid"}
+	response, err := c.checkRestErrors(404, RestResponse{RestError: restError})
+	var ontapError *ONTAPError
+	if !errors.As(err, &ontapError) {
+		t.Fatalf("checkRestErrors() error = %v, expecting ONTAPError", err)
+	}
+	if ontapError.RestError != restError || ontapError.StatusCode != 404 {
+		t.Errorf("checkRestErrors() error = %#v", ontapError)
+	}
+	if err.Error() != "ONTAP error code 4: entry doesn't exist, target: uuid, statusCode: 404" {
+		t.Errorf("checkRestErrors() error = %s", err)
+	}
+	if response.ErrorType != "rest_error" {
+		t.Errorf("checkRestErrors() ErrorType = %s", response.ErrorType)
+	}
+}
@@ -38,14 +38,25 @@ func (e *ErrorHandler) MakeAndLogError(msg string) error {
 
 // MakeAndReportError builds an error using message and logs the error with tflog
 // The error is added to the diagnostic and will be reported by Terraform
+// When msg reports a common ONTAP error, the diagnostic explains the likely cause and how to fix it
 func (e *ErrorHandler) MakeAndReportError(summary string, msg string) error {
 	e.validate()
 	fullMsg := fmt.Sprintf("HERE  %s: %s", summary, msg)
 	tflog.SubsystemError(e.subCtx, e.name, msg)
-	e.diags.AddError(summary, msg)
+	if hint := getONTAPErrorHint(msg); hint != nil {
+		e.diags.AddError(summary, fmt.Sprintf("%s. %s\n\n%s", hint.summary, hint.detail, msg))
+	} else {
+		e.diags.AddError(summary, msg)
+	}
 	return errors.New(fullMsg)
 }
 
+// AddDiagnostics adds diagnostics reported through another error handler, eg by concurrent workers
+func (e *ErrorHandler) AddDiagnostics(diags diag.Diagnostics) {
+	e.validate()
+	e.diags.Append(diags...)
+}
+
 func (e *ErrorHandler) validate() {
 	if e == nil {
 		panic("Error handler is not set")
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"net"
+	"strconv"
+)
+
+// NetmaskLength converts an IPv4 mask, eg 255.255.0.0, to its length, as ONTAP reports netmasks as a length
+// A length, or an invalid mask, is returned as is
+func NetmaskLength(netmask string) string {
+	ip := net.ParseIP(netmask).To4()
+	if ip == nil {
+		return netmask
+	}
+	ones, bits := net.IPMask(ip).Size()
+	if bits == 0 {
+		return netmask
+	}
+	return strconv.Itoa(ones)
+}
@@ -0,0 +1,19 @@
+package utils
+
+import "testing"
+
+func TestNetmaskLength(t *testing.T) {
+	tests := map[string]string{
+		"24":            "24",
+		"255.255.255.0": "24",
+		"255.255.0.0":   "16",
+		"0.0.0.0":       "0",
+		"64":            "64",
+		"255.0.255.0":   "255.0.255.0",
+	}
+	for netmask, want := range tests {
+		if got := NetmaskLength(netmask); got != want {
+			t.Errorf("NetmaskLength(%s) = %s, want %s", netmask, got, want)
+		}
+	}
+}
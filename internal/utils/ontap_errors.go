@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ontapErrorHint explains a common ONTAP error, and how to fix it
+type ontapErrorHint struct {
+	summary string
+	detail  string
+}
+
+var (
+	notFoundHint = ontapErrorHint{
+		summary: "The object was not found on ONTAP",
+		detail:  "Check the names in the configuration, and the SVM. If the object was deleted outside of Terraform, remove it from the state or create it again.",
+	}
+	duplicateHint = ontapErrorHint{
+		summary: "The object already exists on ONTAP",
+		detail:  "Use a different name, or import the existing object with terraform import.",
+	}
+	privilegesHint = ontapErrorHint{
+		summary: "The user does not have the privileges required for this operation",
+		detail:  "Check the role assigned to the user in the connection profile, or use a cluster administrator. SVM administrators can not manage cluster scoped objects.",
+	}
+	authenticationHint = ontapErrorHint{
+		summary: "Authentication failed",
+		detail:  "Check the username and password, or the client certificate, in the connection profile, and that the user is enabled for the http application.",
+	}
+)
+
+// ontapErrorHintsByCode maps ONTAP REST error codes
+var ontapErrorHintsByCode = map[string]ontapErrorHint{
+	"1": duplicateHint,
+	"4": notFoundHint,
+	"6": privilegesHint,
+}
+
+// ontapErrorHintsByMessage maps ONTAP error messages, for codes specific to an API
+var ontapErrorHintsByMessage = map[string]ontapErrorHint{
+	"entry doesn't exist":     notFoundHint,
+	"duplicate entry":         duplicateHint,
+	"already exists":          duplicateHint,
+	"insufficient privileges": privilegesHint,
+	"not authorized":          privilegesHint,
+}
+
+// ontapErrorHintsByStatusCode maps HTTP status codes, when ONTAP does not report an error code
+var ontapErrorHintsByStatusCode = map[int]ontapErrorHint{
+	401: authenticationHint,
+	403: privilegesHint,
+}
+
+var (
+	ontapErrorCodeRegexp = regexp.MustCompile(`ONTAP error code (\d+): ([^,]*)`)
+	statusCodeRegexp     = regexp.MustCompile(`(?:statusCode:?|without details:) (\d{3})\b`)
+)
+
+// getONTAPErrorHint returns the hint for the ONTAP error reported in msg, or nil if the error is not known
+func getONTAPErrorHint(msg string) *ontapErrorHint {
+	if matches := ontapErrorCodeRegexp.FindStringSubmatch(msg); matches != nil {
+		if hint, ok := ontapErrorHintsByCode[matches[1]]; ok {
+			return &hint
+		}
+		message := strings.ToLower(matches[2])
+		for text, hint := range ontapErrorHintsByMessage {
+			if strings.Contains(message, text) {
+				return &hint
+			}
+		}
+		return nil
+	}
+	if matches := statusCodeRegexp.FindStringSubmatch(msg); matches != nil {
+		statusCode, _ := strconv.Atoi(matches[1])
+		if hint, ok := ontapErrorHintsByStatusCode[statusCode]; ok {
+			return &hint
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestGetONTAPErrorHint(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want *ontapErrorHint
+	}{
+		{name: "test_not_found_code", msg: "error on GET storage/volumes/uuid: ONTAP error code 4: entry doesn't exist, target: uuid, statusCode: 404, statusCode 404", want: &notFoundHint},
+		{name: "test_duplicate_code", msg: "error on POST svm/svms: ONTAP error code 1: duplicate entry, statusCode: 409", want: &duplicateHint},
+		{name: "test_privileges_code", msg: "error on PATCH cluster: ONTAP error code 6: not authorized for that command, statusCode: 403", want: &privilegesHint},
+		{name: "test_duplicate_message", msg: "error on POST storage/volumes: ONTAP error code 917536: Volume vol1 already exists in Vserver svm1, statusCode: 400", want: &duplicateHint},
+		{name: "test_unknown_code", msg: "error on POST storage/volumes: ONTAP error code 917927: Aggregate aggr1 is offline, statusCode: 403", want: nil},
+		{name: "test_authentication_status_code", msg: "error on GET cluster: statusCode indicates error, without details: 401, statusCode 401", want: &authenticationHint},
+		{name: "test_other_status_code", msg: "error on GET cluster: statusCode indicates error, without details: 500, statusCode 500", want: nil},
+		{name: "test_no_error", msg: "no response for GET cluster", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getONTAPErrorHint(tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getONTAPErrorHint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeAndReportError_ONTAPErrorHint(t *testing.T) {
+	diags := diag.Diagnostics{}
+	errorHandler := NewErrorHandler(context.Background(), &diags)
+	msg := "error on GET storage/volumes/uuid: ONTAP error code 4: entry doesn't exist, statusCode: 404"
+	if err := errorHandler.MakeAndReportError("error reading volume", msg); err == nil {
+		t.Fatal("MakeAndReportError() expected an error")
+	}
+	if len(diags) != 1 {
+		t.Fatalf("MakeAndReportError() expected 1 diagnostic, got %d", len(diags))
+	}
+	if diags[0].Summary() != "error reading volume" {
+		t.Errorf("MakeAndReportError() summary = %s", diags[0].Summary())
+	}
+	if !strings.HasPrefix(diags[0].Detail(), notFoundHint.summary) || !strings.HasSuffix(diags[0].Detail(), msg) {
+		t.Errorf("MakeAndReportError() detail = %s", diags[0].Detail())
+	}
+}
@@ -0,0 +1,108 @@
+package zapiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
+)
+
+// zapiPath is the servlet receiving ONTAPI (ZAPI) requests
+const zapiPath = "servlets/netapp.servlets.admin.XMLrequest_filer"
+
+// zapiVersion is the ONTAPI version sent with requests, ONTAP 9.0 or later accepts 1.100 and above
+const zapiVersion = "1.100"
+
+// ZapiClient to interact with the ONTAPI (ZAPI) XML interface, for clusters or features not covered by the REST API
+type ZapiClient struct {
+	ctx        context.Context
+	httpClient httpclient.HTTPClient
+	mode       string
+	responses  []MockResponse
+}
+
+// ZapiError is reported when ONTAP returns a failed status
+type ZapiError struct {
+	API    string
+	Errno  string
+	Reason string
+}
+
+func (e *ZapiError) Error() string {
+	return fmt.Sprintf("ZAPI %s failed, errno %s: %s", e.API, e.Errno, e.Reason)
+}
+
+type zapiEnvelope struct {
+	XMLName xml.Name    `xml:"netapp"`
+	Results zapiResults `xml:"results"`
+}
+
+type zapiResults struct {
+	Status string `xml:"status,attr"`
+	Reason string `xml:"reason,attr"`
+	Errno  string `xml:"errno,attr"`
+	Inner  []byte `xml:",innerxml"`
+}
+
+// NewClient creates a new ZAPI client, sharing the connection settings of the REST client
+func NewClient(ctx context.Context, cxProfile httpclient.HTTPProfile, tag string) *ZapiClient {
+	return &ZapiClient{
+		ctx:        ctx,
+		httpClient: httpclient.NewClient(ctx, cxProfile, tag),
+		mode:       "prod",
+	}
+}
+
+// Invoke sends the api request with args encoded as its inputs, and decodes the content of the results element into result.
+// args can be nil for APIs without input.
+func (z *ZapiClient) Invoke(api string, args interface{}, result interface{}) error {
+	var inputs bytes.Buffer
+	start := xml.StartElement{Name: xml.Name{Local: api}}
+	if args == nil {
+		inputs.WriteString(fmt.Sprintf("<%s/>", api))
+	} else if err := xml.NewEncoder(&inputs).EncodeElement(args, start); err != nil {
+		return fmt.Errorf("failed to encode ZAPI %s: %s", api, err)
+	}
+	request := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><netapp version="%s" xmlns="http://www.netapp.com/filer/admin">%s</netapp>`, zapiVersion, inputs.String())
+
+	var statusCode int
+	var response []byte
+	var err error
+	if z.mode == "mock" {
+		statusCode, response, err = z.mockInvoke(api)
+	} else {
+		statusCode, response, err = z.httpClient.DoRaw(zapiPath, "text/xml", []byte(request))
+	}
+	if err != nil {
+		return fmt.Errorf("ZAPI %s request failed: %s, statusCode %d", api, err, statusCode)
+	}
+	if statusCode >= 300 {
+		return fmt.Errorf("ZAPI %s request failed, statusCode %d", api, statusCode)
+	}
+
+	var envelope zapiEnvelope
+	if err := xml.Unmarshal(response, &envelope); err != nil {
+		tflog.Error(z.ctx, fmt.Sprintf("unable to unmarshall ZAPI %s response: %s, response=%s", api, err, response))
+		return fmt.Errorf("failed to decode ZAPI %s response: %s", api, err)
+	}
+	if envelope.Results.Status != "passed" {
+		return &ZapiError{API: api, Errno: envelope.Results.Errno, Reason: envelope.Results.Reason}
+	}
+	if result == nil {
+		return nil
+	}
+	if err := xml.Unmarshal(append(append([]byte("<results>"), envelope.Results.Inner...), []byte("</results>")...), result); err != nil {
+		return fmt.Errorf("failed to decode ZAPI %s results: %s", api, err)
+	}
+	return nil
+}
+
+// IsZapiError returns true if err reports a failed ZAPI status with errno
+func IsZapiError(err error, errno string) bool {
+	var zapiErr *ZapiError
+	return errors.As(err, &zapiErr) && zapiErr.Errno == errno
+}
@@ -0,0 +1,37 @@
+package zapiclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// MockResponse is used in Unit Testing to mock expected ZAPI responses.
+// It validates that the request matches ExpectedAPI, to return the other elements.
+type MockResponse struct {
+	ExpectedAPI string
+	StatusCode  int
+	Response    string
+	Err         error
+}
+
+// NewMockedZapiClient is used in Unit Testing to mock expected ZAPI responses.
+func NewMockedZapiClient(responses []MockResponse) *ZapiClient {
+	return &ZapiClient{
+		ctx:       context.Background(),
+		mode:      "mock",
+		responses: responses,
+	}
+}
+
+func (z *ZapiClient) mockInvoke(api string) (int, []byte, error) {
+	if len(z.responses) == 0 {
+		panic(fmt.Sprintf("Unexpected request: %s", api))
+	}
+	expectedResponse := z.responses[0]
+	if expectedResponse.ExpectedAPI != api {
+		panic(fmt.Sprintf("Unexpected request: %s, expecting %s", api, expectedResponse.ExpectedAPI))
+	}
+	// remove element now that we know it is consumed
+	z.responses = z.responses[1:]
+	return expectedResponse.StatusCode, []byte(expectedResponse.Response), expectedResponse.Err
+}
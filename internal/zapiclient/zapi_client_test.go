@@ -0,0 +1,52 @@
+package zapiclient
+
+import (
+	"errors"
+	"testing"
+)
+
+type testVersion struct {
+	Version    string `xml:"version"`
+	Generation int    `xml:"version-tuple>system-version-tuple>generation"`
+}
+
+type testIterArgs struct {
+	MaxRecords int `xml:"max-records"`
+}
+
+func TestZapiClient_Invoke(t *testing.T) {
+	passed := `<?xml version='1.0' encoding='UTF-8' ?><netapp version='1.140' xmlns='http://www.netapp.com/filer/admin'><results status="passed"><version>NetApp Release 9.5P1</version><version-tuple><system-version-tuple><generation>9</generation><major>5</major><minor>0</minor></system-version-tuple></version-tuple></results></netapp>`
+	failed := `<?xml version='1.0' encoding='UTF-8' ?><netapp version='1.140' xmlns='http://www.netapp.com/filer/admin'><results status="failed" errno="13005" reason="Unable to find API: system-get-version"></results></netapp>`
+	tests := []struct {
+		name      string
+		responses []MockResponse
+		args      interface{}
+		want      testVersion
+		wantErrno string
+		wantErr   bool
+	}{
+		{name: "test_passed", responses: []MockResponse{{"system-get-version", 200, passed, nil}}, want: testVersion{Version: "NetApp Release 9.5P1", Generation: 9}, wantErr: false},
+		{name: "test_passed_args", responses: []MockResponse{{"system-get-version", 200, passed, nil}}, args: testIterArgs{MaxRecords: 10}, want: testVersion{Version: "NetApp Release 9.5P1", Generation: 9}, wantErr: false},
+		{name: "test_failed", responses: []MockResponse{{"system-get-version", 200, failed, nil}}, wantErrno: "13005", wantErr: true},
+		{name: "test_http_status", responses: []MockResponse{{"system-get-version", 401, "", nil}}, wantErr: true},
+		{name: "test_error", responses: []MockResponse{{"system-get-version", -1, "", errors.New("generic error for UT")}}, wantErr: true},
+		{name: "test_decode_error", responses: []MockResponse{{"system-get-version", 200, "<netapp", nil}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := NewMockedZapiClient(tt.responses)
+			var got testVersion
+			err := z.Invoke("system-get-version", tt.args, &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ZapiClient.Invoke() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrno != "" && !IsZapiError(err, tt.wantErrno) {
+				t.Errorf("ZapiClient.Invoke() error = %v, want errno %s", err, tt.wantErrno)
+			}
+			if got != tt.want {
+				t.Errorf("ZapiClient.Invoke() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}